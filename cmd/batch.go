@@ -0,0 +1,34 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import "fmt"
+
+// batchFailuresError reports a batch command in which every item failed.
+// A single failure is returned unchanged; failures that share an error code
+// keep it.
+func batchFailuresError(operation string, failures []error) error {
+	if len(failures) == 1 {
+		return failures[0]
+	}
+	code := jsonErrorCode(failures[0])
+	for _, failure := range failures[1:] {
+		if jsonErrorCode(failure) != code {
+			code = jsonErrorCodeCommandFailed
+			break
+		}
+	}
+	return newCodedError(code, fmt.Errorf("%d operations failed: %v", len(failures), failures[0]), operationErrorDetails(operation))
+}
@@ -35,14 +35,37 @@ import (
 // silently skip one of the conventions.
 var dryRunCommands = []string{
 	"cp",
+	"file-request close",
+	"file-request create",
+	"file-request delete",
+	"file-request update",
 	"mkdir",
 	"mv",
+	"props remove",
+	"props set",
+	"props update",
 	"put",
 	"restore",
 	"rm",
+	"save-url",
+	"share file invite",
+	"share file remove",
+	"share file unshare",
+	"share folder create",
+	"share folder invite",
+	"share folder leave",
+	"share folder mount",
+	"share folder remove",
+	"share folder set-access",
+	"share folder unmount",
+	"share folder unshare",
+	"share folder update-policy",
 	"share-link create",
 	"share-link revoke",
 	"share-link update",
+	"tag add",
+	"tag remove",
+	"undelete",
 }
 
 // TestDryRunRegistryMatchesRegisteredFlags asserts the registry and the set of
@@ -0,0 +1,613 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+// Encrypted files start with a header naming the key source, followed by
+// AES-256-GCM chunks. Each chunk seals encryptionChunkSize bytes of plaintext
+// except the last, which is shorter (possibly empty) and sealed with a final
+// flag in its nonce, so truncation and reordering fail authentication. The
+// header is the additional data of every chunk.
+//
+//	magic "DBXE" | version | key mode | chunk size (uint32) | file salt (32)
+//	passphrase: PBKDF2 salt (16) | PBKDF2-SHA256 iterations (uint32)
+//	recipient:  ephemeral X25519 public key (32)
+const (
+	encryptedFileExt        = ".dbxenc"
+	encryptionPassphraseEnv = "DBXCLI_ENCRYPTION_PASSPHRASE"
+
+	encryptionMagic          = "DBXE"
+	encryptionVersion        = 1
+	encryptionModePassphrase = 1
+	encryptionModeRecipient  = 2
+	encryptionChunkSize      = 64 << 10
+	encryptionMaxChunkSize   = 16 << 20
+	encryptionHeaderPrefix   = 4 + 1 + 1 + 4 + 32
+	encryptionKDFSaltSize    = 16
+	encryptionMaxIterations  = 10_000_000
+	encryptionKeyInfo        = "dbxcli encrypted file v1"
+)
+
+// encryptionPBKDF2Iterations is a variable so tests can keep key derivation
+// cheap; decryption reads the count from each file's header.
+var encryptionPBKDF2Iterations = 600_000
+
+var (
+	errNotEncryptedFile  = errors.New("not a dbxcli encrypted file")
+	errDecryptionFailed  = errors.New("decryption failed: wrong key or the file was modified")
+	errEncryptedTruncate = errors.New("decryption failed: encrypted file is truncated")
+)
+
+// encryptionKeys holds the key material for one command. Passphrase keys are
+// stretched once per PBKDF2 salt and cached, so a recursive transfer pays
+// for key stretching once rather than once per file.
+type encryptionKeys struct {
+	passphrase string
+	recipient  *ecdh.PublicKey
+	identity   *ecdh.PrivateKey
+
+	mu      sync.Mutex
+	kdfSalt []byte
+	masters map[string][]byte
+}
+
+// parseEncryptFlags reads put --encrypt and its key flags. It returns nil
+// when --encrypt is not set.
+func parseEncryptFlags(cmd *cobra.Command) (*encryptionKeys, error) {
+	encrypt, err := localBoolFlag(cmd, "encrypt")
+	if err != nil {
+		return nil, err
+	}
+	passphraseFile, err := localStringFlag(cmd, "passphrase-file")
+	if err != nil {
+		return nil, err
+	}
+	recipientFile, err := localStringFlag(cmd, "recipient")
+	if err != nil {
+		return nil, err
+	}
+	if !encrypt {
+		if passphraseFile != "" || recipientFile != "" {
+			return nil, invalidArgumentsErrorWithDetails("`--passphrase-file` and `--recipient` require --encrypt", flagsErrorDetails("encrypt", "passphrase-file", "recipient"))
+		}
+		return nil, nil
+	}
+	if passphraseFile != "" && recipientFile != "" {
+		return nil, invalidArgumentsErrorWithDetails("use only one of `--passphrase-file` or `--recipient`", flagsErrorDetails("passphrase-file", "recipient"))
+	}
+	if recipientFile != "" {
+		recipient, err := readRecipientKey(recipientFile)
+		if err != nil {
+			return nil, err
+		}
+		return &encryptionKeys{recipient: recipient}, nil
+	}
+	passphrase, err := encryptionPassphrase(passphraseFile, true)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptionKeys{passphrase: passphrase}, nil
+}
+
+// parseDecryptFlags reads get --decrypt and its key flags. It returns nil
+// when --decrypt is not set.
+func parseDecryptFlags(cmd *cobra.Command) (*encryptionKeys, error) {
+	decrypt, err := localBoolFlag(cmd, "decrypt")
+	if err != nil {
+		return nil, err
+	}
+	passphraseFile, err := localStringFlag(cmd, "passphrase-file")
+	if err != nil {
+		return nil, err
+	}
+	identityFile, err := localStringFlag(cmd, "identity")
+	if err != nil {
+		return nil, err
+	}
+	if !decrypt {
+		if passphraseFile != "" || identityFile != "" {
+			return nil, invalidArgumentsErrorWithDetails("`--passphrase-file` and `--identity` require --decrypt", flagsErrorDetails("decrypt", "passphrase-file", "identity"))
+		}
+		return nil, nil
+	}
+
+	keys := &encryptionKeys{}
+	if identityFile != "" {
+		keys.identity, err = readIdentityKey(identityFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	keys.passphrase, err = encryptionPassphrase(passphraseFile, identityFile == "")
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// encryptionPassphrase reads the passphrase from filePath, or from
+// DBXCLI_ENCRYPTION_PASSPHRASE when no file is given.
+func encryptionPassphrase(filePath string, required bool) (string, error) {
+	if filePath == "" {
+		passphrase := os.Getenv(encryptionPassphraseEnv)
+		if passphrase == "" && required {
+			return "", invalidArgumentsErrorfWithDetails("no encryption key: use --passphrase-file, set %s, or use a key file", flagsErrorDetails("passphrase-file"), encryptionPassphraseEnv)
+		}
+		return passphrase, nil
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", withJSONErrorDetails(err, flagValueErrorDetails("passphrase-file", filePath))
+	}
+	passphrase := strings.TrimRight(string(data), "\r\n")
+	if passphrase == "" {
+		return "", invalidArgumentsErrorfWithDetails("passphrase file %s is empty", flagValueErrorDetails("passphrase-file", filePath), filePath)
+	}
+	return passphrase, nil
+}
+
+// readRecipientKey loads a PEM X25519 public key, as written by
+// `openssl pkey -pubout` for a key from `openssl genpkey -algorithm X25519`.
+func readRecipientKey(filePath string) (*ecdh.PublicKey, error) {
+	details := flagValueErrorDetails("recipient", filePath)
+	der, err := readPEMFile(filePath, "PUBLIC KEY", details)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --recipient key %s: %v", details, filePath, err)
+	}
+	key, ok := parsed.(*ecdh.PublicKey)
+	if !ok || key.Curve() != ecdh.X25519() {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --recipient key %s: want an X25519 public key", details, filePath)
+	}
+	return key, nil
+}
+
+// readIdentityKey loads a PEM PKCS #8 X25519 private key.
+func readIdentityKey(filePath string) (*ecdh.PrivateKey, error) {
+	details := flagValueErrorDetails("identity", filePath)
+	der, err := readPEMFile(filePath, "PRIVATE KEY", details)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --identity key %s: %v", details, filePath, err)
+	}
+	key, ok := parsed.(*ecdh.PrivateKey)
+	if !ok || key.Curve() != ecdh.X25519() {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --identity key %s: want an X25519 private key", details, filePath)
+	}
+	return key, nil
+}
+
+func readPEMFile(filePath, blockType string, details map[string]any) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, withJSONErrorDetails(err, details)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, invalidArgumentsErrorfWithDetails("%s does not contain a PEM %s block", details, filePath, blockType)
+	}
+	return block.Bytes, nil
+}
+
+// encryptedSize returns the size of the encrypted form of size plaintext
+// bytes, so chunked uploads know the total before reading the file.
+func (k *encryptionKeys) encryptedSize(size int64) int64 {
+	chunks := size/encryptionChunkSize + 1
+	return int64(k.headerSize()) + size + chunks*16
+}
+
+func (k *encryptionKeys) headerSize() int {
+	if k.recipient != nil {
+		return encryptionHeaderPrefix + 32
+	}
+	return encryptionHeaderPrefix + encryptionKDFSaltSize + 4
+}
+
+// newHeader builds a header with a fresh file salt and returns it with the
+// file key it commits to.
+func (k *encryptionKeys) newHeader() ([]byte, []byte, error) {
+	fileSalt := make([]byte, 32)
+	if _, err := rand.Read(fileSalt); err != nil {
+		return nil, nil, err
+	}
+	header := make([]byte, 0, k.headerSize())
+	header = append(header, encryptionMagic...)
+	header = append(header, encryptionVersion)
+
+	var secret []byte
+	if k.recipient != nil {
+		ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		secret, err = ephemeral.ECDH(k.recipient)
+		if err != nil {
+			return nil, nil, err
+		}
+		header = append(header, encryptionModeRecipient)
+		header = binary.BigEndian.AppendUint32(header, encryptionChunkSize)
+		header = append(header, fileSalt...)
+		header = append(header, ephemeral.PublicKey().Bytes()...)
+	} else {
+		kdfSalt, err := k.encryptionKDFSalt()
+		if err != nil {
+			return nil, nil, err
+		}
+		secret, err = k.passphraseMaster(kdfSalt, encryptionPBKDF2Iterations)
+		if err != nil {
+			return nil, nil, err
+		}
+		header = append(header, encryptionModePassphrase)
+		header = binary.BigEndian.AppendUint32(header, encryptionChunkSize)
+		header = append(header, fileSalt...)
+		header = append(header, kdfSalt...)
+		header = binary.BigEndian.AppendUint32(header, uint32(encryptionPBKDF2Iterations))
+	}
+
+	key, err := hkdf.Key(sha256.New, secret, fileSalt, encryptionKeyInfo, 32)
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, key, nil
+}
+
+func (k *encryptionKeys) encryptionKDFSalt() ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.kdfSalt == nil {
+		salt := make([]byte, encryptionKDFSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		k.kdfSalt = salt
+	}
+	return k.kdfSalt, nil
+}
+
+func (k *encryptionKeys) passphraseMaster(salt []byte, iterations int) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	cacheKey := fmt.Sprintf("%x/%d", salt, iterations)
+	if master, ok := k.masters[cacheKey]; ok {
+		return master, nil
+	}
+	master, err := pbkdf2.Key(sha256.New, k.passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	if k.masters == nil {
+		k.masters = make(map[string][]byte)
+	}
+	k.masters[cacheKey] = master
+	return master, nil
+}
+
+// readHeader reads and checks the header at the start of r, returning it with
+// the file key and the plaintext chunk size.
+func (k *encryptionKeys) readHeader(r io.Reader) ([]byte, []byte, int, error) {
+	header := make([]byte, encryptionHeaderPrefix, encryptionHeaderPrefix+32)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, nil, 0, errNotEncryptedFile
+		}
+		return nil, nil, 0, err
+	}
+	if string(header[:4]) != encryptionMagic {
+		return nil, nil, 0, errNotEncryptedFile
+	}
+	if header[4] != encryptionVersion {
+		return nil, nil, 0, fmt.Errorf("unsupported encrypted file version %d", header[4])
+	}
+	chunkSize := int(binary.BigEndian.Uint32(header[6:10]))
+	if chunkSize < 1 || chunkSize > encryptionMaxChunkSize {
+		return nil, nil, 0, fmt.Errorf("invalid encrypted file chunk size %d", chunkSize)
+	}
+	fileSalt := header[10:encryptionHeaderPrefix]
+
+	var secret []byte
+	switch header[5] {
+	case encryptionModePassphrase:
+		rest := make([]byte, encryptionKDFSaltSize+4)
+		if _, err := io.ReadFull(r, rest); err != nil {
+			return nil, nil, 0, errEncryptedTruncate
+		}
+		header = append(header, rest...)
+		iterations := int(binary.BigEndian.Uint32(rest[encryptionKDFSaltSize:]))
+		if iterations < 1 || iterations > encryptionMaxIterations {
+			return nil, nil, 0, fmt.Errorf("invalid encrypted file PBKDF2 iteration count %d", iterations)
+		}
+		if k.passphrase == "" {
+			return nil, nil, 0, fmt.Errorf("file is encrypted with a passphrase: use --passphrase-file or set %s", encryptionPassphraseEnv)
+		}
+		var err error
+		secret, err = k.passphraseMaster(rest[:encryptionKDFSaltSize], iterations)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	case encryptionModeRecipient:
+		rest := make([]byte, 32)
+		if _, err := io.ReadFull(r, rest); err != nil {
+			return nil, nil, 0, errEncryptedTruncate
+		}
+		header = append(header, rest...)
+		if k.identity == nil {
+			return nil, nil, 0, errors.New("file is encrypted to a public key: use --identity with the matching private key")
+		}
+		ephemeral, err := ecdh.X25519().NewPublicKey(rest)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("invalid encrypted file header: %w", err)
+		}
+		secret, err = k.identity.ECDH(ephemeral)
+		if err != nil {
+			return nil, nil, 0, errDecryptionFailed
+		}
+	default:
+		return nil, nil, 0, fmt.Errorf("unsupported encrypted file key mode %d", header[5])
+	}
+
+	key, err := hkdf.Key(sha256.New, secret, fileSalt, encryptionKeyInfo, 32)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return header, key, chunkSize, nil
+}
+
+func newChunkAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce is the chunk counter followed by a byte marking the final chunk.
+func chunkNonce(nonce []byte, counter uint64, final bool) []byte {
+	clear(nonce)
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], counter)
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptReader yields the header and then the sealed chunks of src. It
+// reads src one chunk at a time, so it can feed uploadChunked directly.
+type encryptReader struct {
+	src    io.Reader
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	plain  []byte
+	sealed []byte
+	out    []byte
+	count  uint64
+	done   bool
+}
+
+func newEncryptReader(src io.Reader, keys *encryptionKeys) (io.Reader, error) {
+	header, key, err := keys.newHeader()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newChunkAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		src:    src,
+		aead:   aead,
+		header: header,
+		nonce:  make([]byte, aead.NonceSize()),
+		plain:  make([]byte, encryptionChunkSize),
+		out:    header,
+	}, nil
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.plain)
+		final := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !final {
+			return 0, err
+		}
+		r.sealed = r.aead.Seal(r.sealed[:0], chunkNonce(r.nonce, r.count, final), r.plain[:n], r.header)
+		r.out = r.sealed
+		r.count++
+		r.done = final
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// encryptToMemory encrypts all of src, for uploads small enough to send in
+// one request.
+func encryptToMemory(src io.Reader, keys *encryptionKeys) (*bytes.Reader, error) {
+	r, err := newEncryptReader(src, keys)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// decryptReader verifies and decrypts an encrypted stream. Plaintext is only
+// returned after its chunk authenticates, and a stream that ends without its
+// final chunk is an error.
+type decryptReader struct {
+	src    io.Reader
+	keys   *encryptionKeys
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	sealed []byte
+	plain  []byte
+	out    []byte
+	count  uint64
+	done   bool
+}
+
+func newDecryptReader(src io.Reader, keys *encryptionKeys) io.Reader {
+	return &decryptReader{src: src, keys: keys}
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	if r.aead == nil {
+		if err := r.init(); err != nil {
+			return 0, err
+		}
+	}
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.sealed)
+		final := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !final {
+			return 0, err
+		}
+		if final && n < r.aead.Overhead() {
+			return 0, errEncryptedTruncate
+		}
+		r.plain, err = r.aead.Open(r.plain[:0], chunkNonce(r.nonce, r.count, final), r.sealed[:n], r.header)
+		if err != nil {
+			return 0, errDecryptionFailed
+		}
+		r.out = r.plain
+		r.count++
+		r.done = final
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *decryptReader) init() error {
+	header, key, chunkSize, err := r.keys.readHeader(r.src)
+	if err != nil {
+		return err
+	}
+	aead, err := newChunkAEAD(key)
+	if err != nil {
+		return err
+	}
+	r.aead = aead
+	r.header = header
+	r.nonce = make([]byte, aead.NonceSize())
+	r.sealed = make([]byte, chunkSize+aead.Overhead())
+	return nil
+}
+
+// encryptedPath adds the encrypted file extension to a Dropbox path.
+func encryptedPath(dst string) string {
+	if hasEncryptedExt(dst) {
+		return dst
+	}
+	return dst + encryptedFileExt
+}
+
+func hasEncryptedExt(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), encryptedFileExt)
+}
+
+// decryptedName strips the encrypted file extension, if present.
+func decryptedName(name string) string {
+	if !hasEncryptedExt(name) {
+		return name
+	}
+	return name[:len(name)-len(encryptedFileExt)]
+}
+
+// downloadDecryptedFile downloads the encrypted file src next to dst and then
+// decrypts it into dst. The encrypted download keeps the usual retry and
+// resume behavior, and dst is only replaced once every chunk authenticates.
+func downloadDecryptedFile(dbx filesClient, src, dst string, opts getOptions) (*files.FileMetadata, error) {
+	finalDst, err := downloadDestinationPath(dst)
+	if err != nil {
+		return nil, err
+	}
+	f, tmp, err := createDownloadTemp(finalDst)
+	if err != nil {
+		return nil, err
+	}
+	_ = f.Close()
+	defer func() { _ = os.Remove(tmp) }()
+
+	metadata, err := downloadFileOnce(dbx, src, tmp, getErrorOutput(opts))
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := os.Open(tmp)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = encrypted.Close() }()
+
+	if err := writeDownloadFile(dst, newDecryptReader(encrypted, opts.decrypt)); err != nil {
+		return nil, fmt.Errorf("decrypt %s: %w", src, err)
+	}
+	return metadata, nil
+}
+
+// downloadDecryptedToStdout streams the decrypted contents of src to w. A
+// chunk that fails to authenticate stops the stream before its plaintext is
+// written.
+func downloadDecryptedToStdout(dbx filesClient, src string, keys *encryptionKeys, w io.Writer) error {
+	arg := files.NewDownloadArg(src)
+	return streamToStdout(w, func() (io.ReadCloser, error) {
+		_, contents, err := dbx.DownloadContext(currentContext(), arg)
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{newDecryptReader(contents, keys), contents}, nil
+	})
+}
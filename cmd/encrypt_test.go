@@ -0,0 +1,364 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func stubEncryptionIterations(t *testing.T) {
+	t.Helper()
+	orig := encryptionPBKDF2Iterations
+	encryptionPBKDF2Iterations = 1000
+	t.Cleanup(func() { encryptionPBKDF2Iterations = orig })
+}
+
+func encryptForTest(t *testing.T, keys *encryptionKeys, plain []byte) []byte {
+	t.Helper()
+	r, err := newEncryptReader(bytes.NewReader(plain), keys)
+	if err != nil {
+		t.Fatalf("newEncryptReader: %v", err)
+	}
+	encrypted, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	return encrypted
+}
+
+func writeX25519KeyPair(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(key.PublicKey())
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "identity.pem")
+	pubPath := filepath.Join(dir, "recipient.pem")
+	if err := os.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return privPath, pubPath
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	stubEncryptionIterations(t)
+	identityPath, recipientPath := writeX25519KeyPair(t)
+	identity, err := readIdentityKey(identityPath)
+	if err != nil {
+		t.Fatalf("readIdentityKey: %v", err)
+	}
+	recipient, err := readRecipientKey(recipientPath)
+	if err != nil {
+		t.Fatalf("readRecipientKey: %v", err)
+	}
+
+	modes := []struct {
+		name    string
+		encrypt *encryptionKeys
+		decrypt *encryptionKeys
+	}{
+		{"passphrase", &encryptionKeys{passphrase: "correct horse"}, &encryptionKeys{passphrase: "correct horse"}},
+		{"recipient", &encryptionKeys{recipient: recipient}, &encryptionKeys{identity: identity}},
+	}
+	for _, mode := range modes {
+		for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, 3*encryptionChunkSize + 7} {
+			plain := bytes.Repeat([]byte("abcdefg"), size/7+1)[:size]
+			encrypted := encryptForTest(t, mode.encrypt, plain)
+			if int64(len(encrypted)) != mode.encrypt.encryptedSize(int64(size)) {
+				t.Fatalf("%s/%d: encrypted size = %d, want %d", mode.name, size, len(encrypted), mode.encrypt.encryptedSize(int64(size)))
+			}
+			if !bytes.HasPrefix(encrypted, []byte(encryptionMagic)) {
+				t.Fatalf("%s/%d: encrypted data does not start with the header magic", mode.name, size)
+			}
+			got, err := io.ReadAll(newDecryptReader(bytes.NewReader(encrypted), mode.decrypt))
+			if err != nil {
+				t.Fatalf("%s/%d: decrypt: %v", mode.name, size, err)
+			}
+			if !bytes.Equal(got, plain) {
+				t.Fatalf("%s/%d: round trip mismatch", mode.name, size)
+			}
+		}
+	}
+}
+
+func TestDecryptRejectsTamperingAndWrongKeys(t *testing.T) {
+	stubEncryptionIterations(t)
+	keys := &encryptionKeys{passphrase: "secret"}
+	plain := bytes.Repeat([]byte("x"), 2*encryptionChunkSize+10)
+	encrypted := encryptForTest(t, keys, plain)
+	headerSize := keys.headerSize()
+	sealedChunk := encryptionChunkSize + 16
+
+	flipped := bytes.Clone(encrypted)
+	flipped[headerSize+sealedChunk+5] ^= 1
+	droppedFinal := encrypted[:headerSize+2*sealedChunk]
+	swapped := bytes.Clone(encrypted)
+	copy(swapped[headerSize:], encrypted[headerSize+sealedChunk:headerSize+2*sealedChunk])
+	copy(swapped[headerSize+sealedChunk:], encrypted[headerSize:headerSize+sealedChunk])
+
+	tests := []struct {
+		name string
+		data []byte
+		keys *encryptionKeys
+		want error
+	}{
+		{"flipped byte", flipped, keys, errDecryptionFailed},
+		{"dropped final chunk", droppedFinal, keys, errEncryptedTruncate},
+		{"swapped chunks", swapped, keys, errDecryptionFailed},
+		{"wrong passphrase", encrypted, &encryptionKeys{passphrase: "guess"}, errDecryptionFailed},
+		{"plain file", []byte(strings.Repeat("plain text ", 10)), keys, errNotEncryptedFile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := io.ReadAll(newDecryptReader(bytes.NewReader(tt.data), tt.keys))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPutEncryptUploadsDecryptableFile(t *testing.T) {
+	stubEncryptionIterations(t)
+	t.Setenv(encryptionPassphraseEnv, "env secret")
+
+	src := filepath.Join(t.TempDir(), "taxes.pdf")
+	content := []byte("tax return contents")
+	if err := os.WriteFile(src, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var uploadedPath string
+	var uploaded []byte
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return nil, getMetadataNotFoundError()
+		},
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			uploadedPath = arg.Path
+			uploaded, _ = io.ReadAll(content)
+			return putFileMetadata(arg.Path, uint64(len(uploaded))), nil
+		},
+	})
+
+	var stdout, stderr bytes.Buffer
+	cmd := testPutJSONCmd(&stdout, &stderr)
+	addEncryptTestFlags(cmd)
+	_ = cmd.Flags().Set("encrypt", "true")
+	if err := put(cmd, []string{src, "/Private/taxes.pdf"}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if uploadedPath != "/Private/taxes.pdf.dbxenc" {
+		t.Fatalf("uploaded path = %q, want encrypted extension", uploadedPath)
+	}
+	got := decodePutOutput(t, &stdout)
+	if !got.Input.Encrypt || got.Input.Target != "/Private/taxes.pdf.dbxenc" {
+		t.Fatalf("input = %+v, want encrypt target", got.Input)
+	}
+	plain, err := io.ReadAll(newDecryptReader(bytes.NewReader(uploaded), &encryptionKeys{passphrase: "env secret"}))
+	if err != nil {
+		t.Fatalf("decrypt upload: %v", err)
+	}
+	if !bytes.Equal(plain, content) {
+		t.Fatalf("decrypted upload = %q, want %q", plain, content)
+	}
+}
+
+func TestUploadEncryptedUsesParallelSessionForLargeFiles(t *testing.T) {
+	stubEncryptionIterations(t)
+	keys := &encryptionKeys{passphrase: "secret"}
+	size := singleShotUploadSizeCutoff + 1
+	plain := bytes.Repeat([]byte{7}, int(size))
+
+	chunks := make(map[uint64][]byte)
+	var finishedAt uint64
+	mock := &mockFilesClient{
+		uploadSessionStartFn: func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error) {
+			if arg.SessionType == nil || arg.SessionType.Tag != files.UploadSessionTypeConcurrent {
+				t.Fatalf("session type = %+v, want concurrent", arg.SessionType)
+			}
+			return &files.UploadSessionStartResult{SessionId: "session"}, nil
+		},
+		uploadSessionAppendV2Fn: func(arg *files.UploadSessionAppendArg, content io.Reader) error {
+			data, _ := io.ReadAll(content)
+			chunks[arg.Cursor.Offset] = data
+			return nil
+		},
+		uploadSessionFinishFn: func(arg *files.UploadSessionFinishArg, content io.Reader) (*files.FileMetadata, error) {
+			finishedAt = arg.Cursor.Offset
+			return putFileMetadata(arg.Commit.Path, arg.Cursor.Offset), nil
+		},
+	}
+
+	opts := putOptions{chunkSize: 4 << 20, workers: 1, encrypt: keys, errOut: io.Discard}
+	if _, err := uploadEncrypted(mock, bytes.NewReader(plain), size, files.NewCommitInfo("/big.bin.dbxenc"), opts); err != nil {
+		t.Fatalf("uploadEncrypted: %v", err)
+	}
+	if int64(finishedAt) != keys.encryptedSize(size) {
+		t.Fatalf("finished at %d, want %d", finishedAt, keys.encryptedSize(size))
+	}
+	var encrypted []byte
+	for offset := uint64(0); offset < finishedAt; offset += uint64(len(chunks[offset])) {
+		if chunks[offset] == nil {
+			t.Fatalf("missing chunk at offset %d", offset)
+		}
+		encrypted = append(encrypted, chunks[offset]...)
+	}
+	got, err := io.ReadAll(newDecryptReader(bytes.NewReader(encrypted), keys))
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if !bytes.Equal(got, plain) {
+		t.Fatal("decrypted upload does not match source")
+	}
+}
+
+func TestGetDecryptWritesPlaintextWithoutExtension(t *testing.T) {
+	stubEncryptionIterations(t)
+	identityPath, recipientPath := writeX25519KeyPair(t)
+	recipient, err := readRecipientKey(recipientPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("private notes")
+	encrypted := encryptForTest(t, &encryptionKeys{recipient: recipient}, content)
+
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return &files.FileMetadata{Metadata: files.Metadata{Name: "notes.txt.dbxenc", PathDisplay: arg.Path}, Size: uint64(len(encrypted))}, nil
+		},
+		downloadFn: func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			meta := &files.FileMetadata{Metadata: files.Metadata{PathDisplay: arg.Path}, Size: uint64(len(encrypted))}
+			return meta, io.NopCloser(bytes.NewReader(encrypted)), nil
+		},
+	})
+
+	dir := t.TempDir()
+	cmd := testGetCmd()
+	addDecryptTestFlags(cmd)
+	_ = cmd.Flags().Set("decrypt", "true")
+	_ = cmd.Flags().Set("identity", identityPath)
+	cmd.SetErr(io.Discard)
+	if err := get(cmd, []string{"/notes.txt.dbxenc", dir}); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "notes.txt"))
+	if err != nil {
+		t.Fatalf("read decrypted file: %v", err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Fatalf("decrypted file = %q, want %q", got, content)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Fatalf("target dir has %d entries, want only the decrypted file", len(entries))
+	}
+}
+
+func TestGetDecryptToStdoutStopsOnTampering(t *testing.T) {
+	stubEncryptionIterations(t)
+	keys := &encryptionKeys{passphrase: "secret"}
+	encrypted := encryptForTest(t, keys, []byte("hello"))
+	encrypted[len(encrypted)-1] ^= 1
+
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return &files.FileMetadata{Metadata: files.Metadata{PathDisplay: arg.Path}}, nil
+		},
+		downloadFn: func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			return &files.FileMetadata{}, io.NopCloser(bytes.NewReader(encrypted)), nil
+		},
+	})
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphraseFile, []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var stdout bytes.Buffer
+	cmd := testGetCmd()
+	addDecryptTestFlags(cmd)
+	_ = cmd.Flags().Set("decrypt", "true")
+	_ = cmd.Flags().Set("passphrase-file", passphraseFile)
+	cmd.SetOut(&stdout)
+	err := get(cmd, []string{"/hello.txt.dbxenc", "-"})
+	if !errors.Is(err, errDecryptionFailed) {
+		t.Fatalf("err = %v, want %v", err, errDecryptionFailed)
+	}
+	if stdout.Len() != 0 {
+		t.Fatalf("stdout = %q, want no unauthenticated plaintext", stdout.String())
+	}
+}
+
+func TestEncryptionFlagValidation(t *testing.T) {
+	t.Setenv(encryptionPassphraseEnv, "")
+	_, recipientPath := writeX25519KeyPair(t)
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphraseFile, []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+	}{
+		{"key without encrypt", map[string]string{"passphrase-file": passphraseFile}},
+		{"no key source", map[string]string{"encrypt": "true"}},
+		{"two key sources", map[string]string{"encrypt": "true", "passphrase-file": passphraseFile, "recipient": recipientPath}},
+		{"private key as recipient", map[string]string{"encrypt": "true", "recipient": passphraseFile}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testPutCmd()
+			addEncryptTestFlags(cmd)
+			for name, value := range tt.flags {
+				_ = cmd.Flags().Set(name, value)
+			}
+			_, err := parseEncryptFlags(cmd)
+			if code := jsonErrorCodeOrEmpty(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("err = %v (code %q), want invalid_arguments", err, code)
+			}
+		})
+	}
+}
+
+func jsonErrorCodeOrEmpty(err error) string {
+	if err == nil {
+		return ""
+	}
+	return jsonErrorCode(err)
+}
+
+func addEncryptTestFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("encrypt", false, "")
+	cmd.Flags().String("passphrase-file", "", "")
+	cmd.Flags().String("recipient", "", "")
+}
+
+func addDecryptTestFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("decrypt", false, "")
+	cmd.Flags().String("passphrase-file", "", "")
+	cmd.Flags().String("identity", "", "")
+}
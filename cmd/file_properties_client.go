@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+type filePropertiesClient interface {
+	PropertiesAddContext(context.Context, *file_properties.AddPropertiesArg) error
+	PropertiesOverwriteContext(context.Context, *file_properties.OverwritePropertyGroupArg) error
+	PropertiesRemoveContext(context.Context, *file_properties.RemovePropertiesArg) error
+	PropertiesUpdateContext(context.Context, *file_properties.UpdatePropertiesArg) error
+	TemplatesAddForTeamContext(context.Context, *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error)
+	TemplatesAddForUserContext(context.Context, *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error)
+	TemplatesGetForTeamContext(context.Context, *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error)
+	TemplatesGetForUserContext(context.Context, *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error)
+	TemplatesListForTeamContext(context.Context) (*file_properties.ListTemplateResult, error)
+	TemplatesListForUserContext(context.Context) (*file_properties.ListTemplateResult, error)
+}
+
+var filePropertiesNewFunc = func(cfg dropbox.Config) filePropertiesClient {
+	return file_properties.NewContext(cfg)
+}
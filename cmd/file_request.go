@@ -0,0 +1,313 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileRequestStatusClosed  = "closed"
+	fileRequestStatusCreated = "created"
+	fileRequestStatusDeleted = "deleted"
+	fileRequestStatusFound   = "found"
+	fileRequestStatusListed  = "listed"
+	fileRequestStatusUpdated = "updated"
+	fileRequestKind          = "file_request"
+
+	fileRequestOperationClose  = "file_request_close"
+	fileRequestOperationCreate = "file_request_create"
+	fileRequestOperationDelete = "file_request_delete"
+	fileRequestOperationUpdate = "file_request_update"
+)
+
+// fileRequestGracePeriods lists the --grace values accepted on the command
+// line. Each maps to the Dropbox GracePeriod tag with "-" replaced by "_".
+var fileRequestGracePeriods = []string{"one-day", "two-days", "seven-days", "thirty-days", "always"}
+
+type jsonFileRequest struct {
+	ID               string  `json:"id,omitempty"`
+	URL              string  `json:"url,omitempty"`
+	Title            string  `json:"title"`
+	Destination      string  `json:"destination,omitempty"`
+	Description      string  `json:"description,omitempty"`
+	Created          *string `json:"created,omitempty"`
+	Deadline         *string `json:"deadline,omitempty"`
+	AllowLateUploads string  `json:"allow_late_uploads,omitempty"`
+	IsOpen           bool    `json:"is_open"`
+	FileCount        int64   `json:"file_count"`
+}
+
+type fileRequestInput struct {
+	ID     string `json:"id"`
+	DryRun bool   `json:"dry_run,omitempty"`
+}
+
+type fileRequestResultInput struct {
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+type fileRequestListInput struct {
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+func jsonFileRequestFromDropbox(request *file_requests.FileRequest) jsonFileRequest {
+	result := jsonFileRequest{
+		ID:          request.Id,
+		URL:         request.Url,
+		Title:       request.Title,
+		Destination: request.Destination,
+		Description: request.Description,
+		Created:     jsonTime(time.Time(request.Created)),
+		IsOpen:      request.IsOpen,
+		FileCount:   request.FileCount,
+	}
+	if request.Deadline != nil {
+		result.Deadline = jsonTime(time.Time(request.Deadline.Deadline))
+		if request.Deadline.AllowLateUploads != nil {
+			result.AllowLateUploads = request.Deadline.AllowLateUploads.Tag
+		}
+	}
+	return result
+}
+
+func fileRequestGet(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`file-request get` requires an `id` argument", argumentErrorDetails("id"))
+	}
+	request, err := getFileRequest(fileRequestsNewFunc(config), args[0])
+	if err != nil {
+		return err
+	}
+
+	results := []jsonOperationResult{newJSONOperationResult(fileRequestStatusFound, fileRequestKind, nil, request)}
+	return renderOperation(cmd, fileRequestInput{ID: args[0]}, results, nil, func(w io.Writer) error {
+		return renderFileRequest(w, request)
+	})
+}
+
+func fileRequestList(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return invalidArgumentsErrorWithDetails("`file-request list` does not accept arguments", argumentsErrorDetails(args...))
+	}
+	limit, _ := cmd.Flags().GetUint64("limit")
+	requests, err := listFileRequests(fileRequestsNewFunc(config), limit)
+	if err != nil {
+		return err
+	}
+
+	results := make([]jsonOperationResult, 0, len(requests))
+	for _, request := range requests {
+		results = append(results, newJSONOperationResult(fileRequestStatusListed, fileRequestKind, nil, request))
+	}
+	return renderOperation(cmd, fileRequestListInput{Limit: limit}, results, nil, func(w io.Writer) error {
+		return renderFileRequests(w, requests)
+	})
+}
+
+func getFileRequest(client fileRequestsClient, id string) (jsonFileRequest, error) {
+	res, err := client.GetContext(currentContext(), file_requests.NewGetFileRequestArgs(id))
+	if err != nil {
+		return jsonFileRequest{}, withJSONErrorDetails(err, map[string]any{"value": id})
+	}
+	return jsonFileRequestFromDropbox(res), nil
+}
+
+// listFileRequests pages through list_v2 until Dropbox reports no more
+// requests or limit requests have been collected. A zero limit lists all.
+func listFileRequests(client fileRequestsClient, limit uint64) ([]jsonFileRequest, error) {
+	arg := file_requests.NewListFileRequestsArg()
+	if limit > 0 && limit < arg.Limit {
+		arg.Limit = limit
+	}
+	res, err := client.ListV2Context(currentContext(), arg)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := appendFileRequests(nil, res.FileRequests, limit)
+	for res.HasMore && !fileRequestLimitReached(requests, limit) {
+		res, err = client.ListContinueContext(currentContext(), file_requests.NewListFileRequestsContinueArg(res.Cursor))
+		if err != nil {
+			return nil, err
+		}
+		requests = appendFileRequests(requests, res.FileRequests, limit)
+	}
+	if requests == nil {
+		requests = []jsonFileRequest{}
+	}
+	return requests, nil
+}
+
+func appendFileRequests(requests []jsonFileRequest, page []*file_requests.FileRequest, limit uint64) []jsonFileRequest {
+	for _, request := range page {
+		if fileRequestLimitReached(requests, limit) {
+			break
+		}
+		if request == nil {
+			continue
+		}
+		requests = append(requests, jsonFileRequestFromDropbox(request))
+	}
+	return requests
+}
+
+func fileRequestLimitReached(requests []jsonFileRequest, limit uint64) bool {
+	return limit > 0 && uint64(len(requests)) >= limit
+}
+
+func fileRequestState(request jsonFileRequest) string {
+	if request.IsOpen {
+		return "open"
+	}
+	return "closed"
+}
+
+func renderFileRequests(out io.Writer, requests []jsonFileRequest) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, request := range requests {
+		destination := request.Destination
+		if destination == "" {
+			destination = "-"
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", request.ID, fileRequestState(request), request.FileCount, destination, request.Title); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func renderFileRequest(out io.Writer, request jsonFileRequest) error {
+	lines := []string{
+		"Id:\t" + request.ID,
+		"Title:\t" + request.Title,
+		"Url:\t" + request.URL,
+	}
+	if request.Destination != "" {
+		lines = append(lines, "Destination:\t"+request.Destination)
+	}
+	lines = append(lines,
+		"Status:\t"+fileRequestState(request),
+		fmt.Sprintf("Files:\t%d", request.FileCount),
+	)
+	if request.Created != nil {
+		lines = append(lines, "Created:\t"+*request.Created)
+	}
+	if request.Deadline != nil {
+		lines = append(lines, "Deadline:\t"+*request.Deadline)
+	}
+	if request.AllowLateUploads != "" {
+		lines = append(lines, "Late Uploads:\t"+request.AllowLateUploads)
+	}
+	if request.Description != "" {
+		lines = append(lines, "Description:\t"+request.Description)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// fileRequestDeadlineFlags parses --deadline and --grace. Dropbox only accepts
+// a grace period alongside a deadline.
+func fileRequestDeadlineFlags(cmd *cobra.Command) (*file_requests.FileRequestDeadline, error) {
+	value, _ := cmd.Flags().GetString("deadline")
+	grace, _ := cmd.Flags().GetString("grace")
+	if value == "" {
+		if grace != "" {
+			return nil, invalidArgumentsErrorWithDetails("`--grace` requires `--deadline`", flagsErrorDetails("grace", "deadline"))
+		}
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --deadline %q: use RFC3339 timestamp", flagValueErrorDetails("deadline", value), value)
+	}
+	deadline := file_requests.NewFileRequestDeadline(dropbox.DBXTime(parsed.UTC().Truncate(time.Second)))
+	if grace != "" {
+		if !slices.Contains(fileRequestGracePeriods, grace) {
+			return nil, invalidArgumentsErrorfWithDetails("invalid --grace %q: use %s", flagValueErrorDetails("grace", grace), grace, strings.Join(fileRequestGracePeriods, ", "))
+		}
+		deadline.AllowLateUploads = &file_requests.GracePeriod{Tagged: dropbox.Tagged{Tag: strings.ReplaceAll(grace, "-", "_")}}
+	}
+	return deadline, nil
+}
+
+func fileRequestDeadlineInput(deadline *file_requests.FileRequestDeadline) (string, string) {
+	if deadline == nil {
+		return "", ""
+	}
+	value := time.Time(deadline.Deadline).UTC().Format(time.RFC3339)
+	if deadline.AllowLateUploads == nil {
+		return value, ""
+	}
+	return value, deadline.AllowLateUploads.Tag
+}
+
+func addFileRequestDeadlineFlags(cmd *cobra.Command) {
+	cmd.Flags().String("deadline", "", "Upload deadline as an RFC3339 timestamp")
+	cmd.Flags().String("grace", "", "Accept late uploads after the deadline: "+strings.Join(fileRequestGracePeriods, ", "))
+}
+
+var fileRequestCmd = &cobra.Command{
+	Use:   "file-request",
+	Short: "File request commands",
+	Long:  "Create, list, inspect, update, close, and delete Dropbox file requests.",
+}
+
+var fileRequestGetCmd = &cobra.Command{
+	Use:     "get [flags] <id>",
+	Short:   "Show a file request",
+	Example: `  dbxcli file-request get oaCAVmEyrqYnkZX9955Y`,
+	RunE:    fileRequestGet,
+}
+
+var fileRequestListCmd = &cobra.Command{
+	Use:   "list [flags]",
+	Short: "List file requests",
+	Long: `List file requests owned by the current account.
+
+Text output shows the request ID, open or closed state, uploaded file count,
+destination folder, and title.`,
+	Example: `  dbxcli file-request list
+  dbxcli file-request list --limit 20 --output json`,
+	RunE: fileRequestList,
+}
+
+func init() {
+	RootCmd.AddCommand(fileRequestCmd)
+	fileRequestCmd.AddCommand(fileRequestGetCmd)
+	fileRequestCmd.AddCommand(fileRequestListCmd)
+
+	fileRequestListCmd.Flags().Uint64("limit", 0, "Maximum number of file requests to return")
+
+	enableStructuredOutput(fileRequestGetCmd)
+	enableStructuredOutput(fileRequestListCmd)
+}
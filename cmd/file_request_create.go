@@ -0,0 +1,125 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+	"github.com/spf13/cobra"
+)
+
+type fileRequestCreateInput struct {
+	Destination      string `json:"destination"`
+	Title            string `json:"title"`
+	Description      string `json:"description,omitempty"`
+	Deadline         string `json:"deadline,omitempty"`
+	AllowLateUploads string `json:"allow_late_uploads,omitempty"`
+	Closed           bool   `json:"closed,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
+}
+
+func fileRequestCreate(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`file-request create` requires a `destination` argument", argumentErrorDetails("destination"))
+	}
+	destination, err := validatePath(args[0])
+	if err != nil {
+		return err
+	}
+	if destination == "" {
+		return invalidArgumentsErrorWithDetails("the Dropbox root cannot be a file request destination", mergeJSONErrorDetails(argumentErrorDetails("destination"), pathErrorDetails("/")))
+	}
+	title, _ := cmd.Flags().GetString("title")
+	if title == "" {
+		return invalidArgumentsErrorWithDetails("`file-request create` requires a non-empty `--title`", flagErrorDetails("title"))
+	}
+	description, _ := cmd.Flags().GetString("description")
+	closed, _ := cmd.Flags().GetBool("closed")
+	deadline, err := fileRequestDeadlineFlags(cmd)
+	if err != nil {
+		return err
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	arg := file_requests.NewCreateFileRequestArgs(title, destination)
+	arg.Description = description
+	arg.Deadline = deadline
+	arg.Open = !closed
+
+	input := fileRequestCreateInput{
+		Destination: destination,
+		Title:       title,
+		Description: description,
+		Closed:      closed,
+		DryRun:      dryRun,
+	}
+	input.Deadline, input.AllowLateUploads = fileRequestDeadlineInput(deadline)
+
+	var request jsonFileRequest
+	if dryRun {
+		request = jsonFileRequest{
+			Title:            title,
+			Destination:      destination,
+			Description:      description,
+			AllowLateUploads: input.AllowLateUploads,
+			IsOpen:           !closed,
+		}
+		if input.Deadline != "" {
+			request.Deadline = &input.Deadline
+		}
+	} else {
+		res, err := fileRequestsNewFunc(config).CreateContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationCreate), pathErrorDetails(destination))
+		}
+		request = jsonFileRequestFromDropbox(res)
+	}
+
+	results := []jsonOperationResult{newJSONOperationResult(plannedStatus(dryRun, fileRequestStatusCreated), fileRequestKind, fileRequestResultInput{DryRun: dryRun}, request)}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		if dryRun {
+			return writeDryRunLine(w, "create file request for", destination)
+		}
+		_, err := fmt.Fprintln(w, request.URL)
+		return err
+	})
+}
+
+var fileRequestCreateCmd = &cobra.Command{
+	Use:   "create [flags] <destination>",
+	Short: "Create a file request",
+	Long: `Create a file request that uploads into a Dropbox folder and print its URL.
+
+A deadline requires a Professional or Business account. --grace keeps the
+request accepting late uploads for the given period after the deadline.`,
+	Example: `  dbxcli file-request create /Vendors/Acme --title "Acme deliverables"
+  dbxcli file-request create /Vendors/Acme --title "Q3 report" --deadline 2026-07-01T17:00:00Z --grace seven-days`,
+	RunE: fileRequestCreate,
+}
+
+func init() {
+	fileRequestCmd.AddCommand(fileRequestCreateCmd)
+	fileRequestCreateCmd.Flags().String("title", "", "File request title (required)")
+	fileRequestCreateCmd.Flags().String("description", "", "File request description")
+	addFileRequestDeadlineFlags(fileRequestCreateCmd)
+	fileRequestCreateCmd.Flags().Bool("closed", false, "Create the file request closed")
+	addDryRunFlag(fileRequestCreateCmd)
+	enableStructuredOutput(fileRequestCreateCmd)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+func TestFileRequestCreateJSONOutputsRequest(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{
+		outputFlag: "json",
+		"title":    "Acme deliverables",
+		"deadline": "2026-07-01T10:00:00-07:00",
+		"grace":    "seven-days",
+	})
+	var created *file_requests.CreateFileRequestArgs
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		createFn: func(arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+			created = arg
+			request := testFileRequest(testFileRequestID, arg.Title, arg.Open)
+			request.Deadline = arg.Deadline
+			return request, nil
+		},
+	})
+
+	if err := fileRequestCreate(cmd, []string{"Vendors/Acme"}); err != nil {
+		t.Fatalf("file-request create error: %v", err)
+	}
+	if created == nil || created.Destination != "/Vendors/Acme" || created.Title != "Acme deliverables" || !created.Open {
+		t.Fatalf("create arg = %#v", created)
+	}
+	if created.Deadline == nil || !time.Time(created.Deadline.Deadline).Equal(time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC)) || created.Deadline.AllowLateUploads.Tag != file_requests.GracePeriodSevenDays {
+		t.Fatalf("deadline = %#v", created.Deadline)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if string(got.Input) != `{"destination":"/Vendors/Acme","title":"Acme deliverables","deadline":"2026-07-01T17:00:00Z","allow_late_uploads":"seven_days"}` {
+		t.Fatalf("input = %s", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != fileRequestStatusCreated || got.Results[0].Result.ID != testFileRequestID {
+		t.Fatalf("results = %#v, want one created file request", got.Results)
+	}
+}
+
+func TestFileRequestCreatePrintsURL(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"title": "Acme deliverables"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		createFn: func(arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+			return testFileRequest(testFileRequestID, arg.Title, arg.Open), nil
+		},
+	})
+
+	if err := fileRequestCreate(cmd, []string{"/Vendors/Acme"}); err != nil {
+		t.Fatalf("file-request create error: %v", err)
+	}
+	if got, want := stdout.String(), "https://www.dropbox.com/request/oaCAVmEyrqYnkZX9955Y\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestCreateDryRunSkipsCreate(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"title": "Acme deliverables", "closed": "true", dryRunFlagName: "true"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		createFn: func(arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+			t.Fatal("create called during dry-run")
+			return nil, nil
+		},
+	})
+
+	if err := fileRequestCreate(cmd, []string{"/Vendors/Acme"}); err != nil {
+		t.Fatalf("file-request create error: %v", err)
+	}
+	if got, want := stdout.String(), "Would create file request for /Vendors/Acme\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestCreateValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+		want  map[string]any
+	}{
+		{name: "missing destination", args: nil, flags: map[string]string{"title": "Acme"}, want: map[string]any{"argument": "destination"}},
+		{name: "root destination", args: []string{"/"}, flags: map[string]string{"title": "Acme"}, want: map[string]any{"argument": "destination", "path": "/"}},
+		{name: "missing title", args: []string{"/Vendors/Acme"}, flags: nil, want: map[string]any{"flag": "title"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testFileRequestCmd()
+			setFileRequestFlags(t, cmd, tt.flags)
+			stubFileRequestsClient(t, &mockFileRequestsClient{})
+
+			err := fileRequestCreate(cmd, tt.args)
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+			details := jsonErrorDetails(err)
+			for key, value := range tt.want {
+				if details[key] != value {
+					t.Fatalf("details = %#v, want %s=%v", details, key, value)
+				}
+			}
+		})
+	}
+}
+
+func TestFileRequestCreatePropagatesAPIError(t *testing.T) {
+	cmd, _ := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"title": "Acme deliverables"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		createFn: func(arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+			return nil, errors.New("create failed")
+		},
+	})
+
+	err := fileRequestCreate(cmd, []string{"/Vendors/Acme"})
+	if err == nil || err.Error() != "create failed" {
+		t.Fatalf("err = %v, want create failed", err)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != fileRequestOperationCreate || details["path"] != "/Vendors/Acme" {
+		t.Fatalf("details = %#v", details)
+	}
+}
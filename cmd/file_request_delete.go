@@ -0,0 +1,138 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+	"github.com/spf13/cobra"
+)
+
+type fileRequestDeleteInput struct {
+	IDs       []string `json:"ids"`
+	AllClosed bool     `json:"all_closed"`
+}
+
+func fileRequestDelete(cmd *cobra.Command, args []string) error {
+	allClosed, _ := cmd.Flags().GetBool("all-closed")
+	if allClosed && len(args) > 0 {
+		return invalidArgumentsErrorWithDetails("`--all-closed` cannot be combined with `id` arguments", mergeJSONErrorDetails(flagErrorDetails("all-closed"), argumentErrorDetails("id")))
+	}
+	if !allClosed && len(args) == 0 {
+		return invalidArgumentsErrorWithDetails("`file-request delete` requires at least one `id` argument or `--all-closed`", mergeJSONErrorDetails(flagErrorDetails("all-closed"), argumentErrorDetails("id")))
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	client := fileRequestsNewFunc(config)
+	var requests []jsonFileRequest
+	switch {
+	case dryRun && allClosed:
+		requests, err = closedFileRequests(client)
+	case dryRun:
+		requests, err = getFileRequests(client, args)
+	case allClosed:
+		var res *file_requests.DeleteAllClosedFileRequestsResult
+		res, err = client.DeleteAllClosedContext(currentContext())
+		if err == nil {
+			requests = appendFileRequests(nil, res.FileRequests, 0)
+		}
+	default:
+		var res *file_requests.DeleteFileRequestsResult
+		res, err = client.DeleteContext(currentContext(), file_requests.NewDeleteFileRequestArgs(args))
+		if err == nil {
+			requests = appendFileRequests(nil, res.FileRequests, 0)
+		}
+	}
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationDelete))
+	}
+
+	results := make([]jsonOperationResult, 0, len(requests))
+	for _, request := range requests {
+		results = append(results, newJSONOperationResult(plannedStatus(dryRun, fileRequestStatusDeleted), fileRequestKind, fileRequestInput{ID: request.ID, DryRun: dryRun}, request))
+	}
+	input := fileRequestDeleteInput{IDs: args, AllClosed: allClosed}
+	if input.IDs == nil {
+		input.IDs = []string{}
+	}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		if !dryRun && !verbose {
+			return nil
+		}
+		for _, request := range requests {
+			if dryRun {
+				if err := writeDryRunLine(w, "delete file request", request.ID); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "Deleted file request %s\n", request.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getFileRequests(client fileRequestsClient, ids []string) ([]jsonFileRequest, error) {
+	requests := make([]jsonFileRequest, 0, len(ids))
+	for _, id := range ids {
+		request, err := getFileRequest(client, id)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// closedFileRequests lists the requests delete_all_closed would remove.
+func closedFileRequests(client fileRequestsClient) ([]jsonFileRequest, error) {
+	requests, err := listFileRequests(client, 0)
+	if err != nil {
+		return nil, err
+	}
+	closed := make([]jsonFileRequest, 0, len(requests))
+	for _, request := range requests {
+		if !request.IsOpen {
+			closed = append(closed, request)
+		}
+	}
+	return closed, nil
+}
+
+var fileRequestDeleteCmd = &cobra.Command{
+	Use:   "delete [flags] [<id>...]",
+	Short: "Delete file requests",
+	Long: `Delete file requests by ID, or every closed file request with --all-closed.
+
+Dropbox only deletes closed file requests; close open requests first.`,
+	Example: `  dbxcli file-request delete oaCAVmEyrqYnkZX9955Y
+  dbxcli file-request delete --all-closed --dry-run`,
+	RunE: fileRequestDelete,
+}
+
+func init() {
+	fileRequestCmd.AddCommand(fileRequestDeleteCmd)
+	fileRequestDeleteCmd.Flags().Bool("all-closed", false, "Delete every closed file request")
+	addDryRunFlag(fileRequestDeleteCmd)
+	enableStructuredOutput(fileRequestDeleteCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+func TestFileRequestDeleteJSONOutputsDeletedRequests(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{outputFlag: "json"})
+	var deleted []string
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		deleteFn: func(arg *file_requests.DeleteFileRequestArgs) (*file_requests.DeleteFileRequestsResult, error) {
+			deleted = arg.Ids
+			return file_requests.NewDeleteFileRequestsResult([]*file_requests.FileRequest{testFileRequest(arg.Ids[0], "Acme deliverables", false)}), nil
+		},
+	})
+
+	if err := fileRequestDelete(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request delete error: %v", err)
+	}
+	if strings.Join(deleted, ",") != testFileRequestID {
+		t.Fatalf("deleted ids = %v", deleted)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if string(got.Input) != `{"ids":["oaCAVmEyrqYnkZX9955Y"],"all_closed":false}` {
+		t.Fatalf("input = %s", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != fileRequestStatusDeleted || string(got.Results[0].Input) != `{"id":"oaCAVmEyrqYnkZX9955Y"}` {
+		t.Fatalf("results = %#v, want one deleted file request", got.Results)
+	}
+}
+
+func TestFileRequestDeleteAllClosed(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"all-closed": "true", "verbose": "true"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		deleteAllClosedFn: func() (*file_requests.DeleteAllClosedFileRequestsResult, error) {
+			return file_requests.NewDeleteAllClosedFileRequestsResult([]*file_requests.FileRequest{
+				testFileRequest("a", "First", false),
+				testFileRequest("b", "Second", false),
+			}), nil
+		},
+	})
+
+	if err := fileRequestDelete(cmd, nil); err != nil {
+		t.Fatalf("file-request delete error: %v", err)
+	}
+	if got, want := stdout.String(), "Deleted file request a\nDeleted file request b\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestDeleteAllClosedDryRunListsClosedRequests(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"all-closed": "true", dryRunFlagName: "true"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		listV2Fn: func(arg *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error) {
+			return file_requests.NewListFileRequestsV2Result([]*file_requests.FileRequest{
+				testFileRequest("a", "First", false),
+				testFileRequest("b", "Second", true),
+				testFileRequest("c", "Third", false),
+			}, "", false), nil
+		},
+		deleteAllClosedFn: func() (*file_requests.DeleteAllClosedFileRequestsResult, error) {
+			t.Fatal("delete_all_closed called during dry-run")
+			return nil, nil
+		},
+	})
+
+	if err := fileRequestDelete(cmd, nil); err != nil {
+		t.Fatalf("file-request delete error: %v", err)
+	}
+	if got, want := stdout.String(), "Would delete file request a\nWould delete file request c\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestDeleteRequiresIDsOrAllClosed(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+	}{
+		{name: "neither", args: nil},
+		{name: "both", args: []string{testFileRequestID}, flags: map[string]string{"all-closed": "true"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testFileRequestCmd()
+			setFileRequestFlags(t, cmd, tt.flags)
+			stubFileRequestsClient(t, &mockFileRequestsClient{})
+
+			err := fileRequestDelete(cmd, tt.args)
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+			if details := jsonErrorDetails(err); details["flag"] != "all-closed" || details["argument"] != "id" {
+				t.Fatalf("details = %#v", details)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+// rawUpdateFileRequestArgs mirrors file_requests.UpdateFileRequestArgs. The
+// SDK tags Open with omitempty, so it cannot close a file request.
+type rawUpdateFileRequestArgs struct {
+	ID          string                                   `json:"id"`
+	Title       string                                   `json:"title,omitempty"`
+	Destination string                                   `json:"destination,omitempty"`
+	Deadline    *file_requests.UpdateFileRequestDeadline `json:"deadline"`
+	Open        *bool                                    `json:"open,omitempty"`
+	Description string                                   `json:"description,omitempty"`
+}
+
+func newRawUpdateFileRequestArgs(id string) *rawUpdateFileRequestArgs {
+	return &rawUpdateFileRequestArgs{
+		ID:       id,
+		Deadline: &file_requests.UpdateFileRequestDeadline{Tagged: dropbox.Tagged{Tag: file_requests.UpdateFileRequestDeadlineNoUpdate}},
+	}
+}
+
+func (dbx *sdkFileRequestsClient) UpdateRawContext(ctx context.Context, arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+	req := dropbox.Request{
+		Host:      "api",
+		Namespace: "file_requests",
+		Route:     "update",
+		Auth:      "user",
+		Style:     "rpc",
+		Arg:       arg,
+	}
+
+	resp, respBody, err := executeSharingRawRequest(ctx, dbx.cfg, req, parseUpdateFileRequestError)
+	if err != nil {
+		return nil, err
+	}
+	if respBody != nil {
+		_ = respBody.Close()
+	}
+
+	var res file_requests.FileRequest
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func parseUpdateFileRequestError(err error) error {
+	var appErr file_requests.UpdateAPIError
+	parsed := auth.ParseError(err, &appErr)
+	if samePointer(parsed, &appErr) {
+		return appErr
+	}
+	return parsed
+}
@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+	"github.com/spf13/cobra"
+)
+
+type mockFileRequestsClient struct {
+	createFn          func(*file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error)
+	deleteFn          func(*file_requests.DeleteFileRequestArgs) (*file_requests.DeleteFileRequestsResult, error)
+	deleteAllClosedFn func() (*file_requests.DeleteAllClosedFileRequestsResult, error)
+	getFn             func(*file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error)
+	listV2Fn          func(*file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error)
+	listContinueFn    func(*file_requests.ListFileRequestsContinueArg) (*file_requests.ListFileRequestsV2Result, error)
+	updateRawFn       func(*rawUpdateFileRequestArgs) (*file_requests.FileRequest, error)
+}
+
+func (m *mockFileRequestsClient) Create(arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+	if m.createFn != nil {
+		return m.createFn(arg)
+	}
+	return &file_requests.FileRequest{}, nil
+}
+
+func (m *mockFileRequestsClient) CreateContext(ctx context.Context, arg *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error) {
+	return m.Create(arg)
+}
+
+func (m *mockFileRequestsClient) Delete(arg *file_requests.DeleteFileRequestArgs) (*file_requests.DeleteFileRequestsResult, error) {
+	if m.deleteFn != nil {
+		return m.deleteFn(arg)
+	}
+	return &file_requests.DeleteFileRequestsResult{}, nil
+}
+
+func (m *mockFileRequestsClient) DeleteContext(ctx context.Context, arg *file_requests.DeleteFileRequestArgs) (*file_requests.DeleteFileRequestsResult, error) {
+	return m.Delete(arg)
+}
+
+func (m *mockFileRequestsClient) DeleteAllClosed() (*file_requests.DeleteAllClosedFileRequestsResult, error) {
+	if m.deleteAllClosedFn != nil {
+		return m.deleteAllClosedFn()
+	}
+	return &file_requests.DeleteAllClosedFileRequestsResult{}, nil
+}
+
+func (m *mockFileRequestsClient) DeleteAllClosedContext(ctx context.Context) (*file_requests.DeleteAllClosedFileRequestsResult, error) {
+	return m.DeleteAllClosed()
+}
+
+func (m *mockFileRequestsClient) Get(arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+	if m.getFn != nil {
+		return m.getFn(arg)
+	}
+	return &file_requests.FileRequest{}, nil
+}
+
+func (m *mockFileRequestsClient) GetContext(ctx context.Context, arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+	return m.Get(arg)
+}
+
+func (m *mockFileRequestsClient) ListV2(arg *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error) {
+	if m.listV2Fn != nil {
+		return m.listV2Fn(arg)
+	}
+	return &file_requests.ListFileRequestsV2Result{}, nil
+}
+
+func (m *mockFileRequestsClient) ListV2Context(ctx context.Context, arg *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error) {
+	return m.ListV2(arg)
+}
+
+func (m *mockFileRequestsClient) ListContinue(arg *file_requests.ListFileRequestsContinueArg) (*file_requests.ListFileRequestsV2Result, error) {
+	if m.listContinueFn != nil {
+		return m.listContinueFn(arg)
+	}
+	return &file_requests.ListFileRequestsV2Result{}, nil
+}
+
+func (m *mockFileRequestsClient) ListContinueContext(ctx context.Context, arg *file_requests.ListFileRequestsContinueArg) (*file_requests.ListFileRequestsV2Result, error) {
+	return m.ListContinue(arg)
+}
+
+func (m *mockFileRequestsClient) UpdateRaw(arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+	if m.updateRawFn != nil {
+		return m.updateRawFn(arg)
+	}
+	return &file_requests.FileRequest{}, nil
+}
+
+func (m *mockFileRequestsClient) UpdateRawContext(ctx context.Context, arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+	return m.UpdateRaw(arg)
+}
+
+func stubFileRequestsClient(t *testing.T, c fileRequestsClient) {
+	t.Helper()
+	orig := fileRequestsNewFunc
+	fileRequestsNewFunc = func(dropbox.Config) fileRequestsClient { return c }
+	t.Cleanup(func() { fileRequestsNewFunc = orig })
+}
+
+const testFileRequestID = "oaCAVmEyrqYnkZX9955Y"
+
+func testFileRequest(id, title string, open bool) *file_requests.FileRequest {
+	request := file_requests.NewFileRequest(id, "https://www.dropbox.com/request/"+id, title, dropbox.DBXTime(time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)), open, 3)
+	request.Destination = "/Vendors/Acme"
+	return request
+}
+
+func testFileRequestCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "file-request"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().Bool("verbose", false, "")
+	cmd.Flags().Uint64("limit", 0, "")
+	cmd.Flags().String("title", "", "")
+	cmd.Flags().String("destination", "", "")
+	cmd.Flags().String("description", "", "")
+	addFileRequestDeadlineFlags(cmd)
+	cmd.Flags().Bool("closed", false, "")
+	cmd.Flags().Bool("remove-deadline", false, "")
+	cmd.Flags().Bool("open", false, "")
+	cmd.Flags().Bool("close", false, "")
+	cmd.Flags().Bool("all-closed", false, "")
+	addDryRunFlag(cmd)
+	return cmd, &stdout
+}
+
+func setFileRequestFlags(t *testing.T, cmd *cobra.Command, flags map[string]string) {
+	t.Helper()
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+}
+
+type fileRequestOutput struct {
+	Input   json.RawMessage `json:"input"`
+	Results []struct {
+		Status string          `json:"status"`
+		Kind   string          `json:"kind"`
+		Input  json.RawMessage `json:"input"`
+		Result jsonFileRequest `json:"result"`
+	} `json:"results"`
+}
+
+func decodeFileRequestOutput(t *testing.T, stdout *bytes.Buffer) fileRequestOutput {
+	t.Helper()
+	var got fileRequestOutput
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	return got
+}
+
+func TestFileRequestGetJSONOutputsRequest(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{outputFlag: "json"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		getFn: func(arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+			request := testFileRequest(arg.Id, "Acme deliverables", true)
+			request.Deadline = file_requests.NewFileRequestDeadline(dropbox.DBXTime(time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC)))
+			request.Deadline.AllowLateUploads = &file_requests.GracePeriod{}
+			request.Deadline.AllowLateUploads.Tag = file_requests.GracePeriodSevenDays
+			return request, nil
+		},
+	})
+
+	if err := fileRequestGet(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request get error: %v", err)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if string(got.Input) != `{"id":"oaCAVmEyrqYnkZX9955Y"}` {
+		t.Fatalf("input = %s", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != fileRequestStatusFound || got.Results[0].Kind != fileRequestKind {
+		t.Fatalf("results = %#v, want one found file request", got.Results)
+	}
+	request := got.Results[0].Result
+	if request.ID != testFileRequestID || request.Destination != "/Vendors/Acme" || !request.IsOpen || request.FileCount != 3 {
+		t.Fatalf("request = %#v", request)
+	}
+	if request.Deadline == nil || *request.Deadline != "2026-07-01T17:00:00Z" || request.AllowLateUploads != "seven_days" {
+		t.Fatalf("deadline = %v, allow_late_uploads = %q", request.Deadline, request.AllowLateUploads)
+	}
+}
+
+func TestFileRequestGetTextOutput(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		getFn: func(arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+			return testFileRequest(arg.Id, "Acme deliverables", false), nil
+		},
+	})
+
+	if err := fileRequestGet(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request get error: %v", err)
+	}
+	want := "Id:          oaCAVmEyrqYnkZX9955Y\n" +
+		"Title:       Acme deliverables\n" +
+		"Url:         https://www.dropbox.com/request/oaCAVmEyrqYnkZX9955Y\n" +
+		"Destination: /Vendors/Acme\n" +
+		"Status:      closed\n" +
+		"Files:       3\n" +
+		"Created:     2026-05-01T09:00:00Z\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestListPaginatesUntilLimit(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{"limit": "3"})
+	var listLimit uint64
+	var cursors []string
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		listV2Fn: func(arg *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error) {
+			listLimit = arg.Limit
+			return file_requests.NewListFileRequestsV2Result([]*file_requests.FileRequest{
+				testFileRequest("a", "First", true),
+				testFileRequest("b", "Second", false),
+			}, "cursor-1", true), nil
+		},
+		listContinueFn: func(arg *file_requests.ListFileRequestsContinueArg) (*file_requests.ListFileRequestsV2Result, error) {
+			cursors = append(cursors, arg.Cursor)
+			return file_requests.NewListFileRequestsV2Result([]*file_requests.FileRequest{
+				testFileRequest("c", "Third", true),
+				testFileRequest("d", "Fourth", true),
+			}, "cursor-2", true), nil
+		},
+	})
+
+	if err := fileRequestList(cmd, nil); err != nil {
+		t.Fatalf("file-request list error: %v", err)
+	}
+	if listLimit != 3 {
+		t.Fatalf("list_v2 limit = %d, want 3", listLimit)
+	}
+	if len(cursors) != 1 || cursors[0] != "cursor-1" {
+		t.Fatalf("continue cursors = %v, want one cursor-1 call", cursors)
+	}
+	want := "a   open   3   /Vendors/Acme First\n" +
+		"b   closed 3   /Vendors/Acme Second\n" +
+		"c   open   3   /Vendors/Acme Third\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestFileRequestListJSONOutputsRequests(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{outputFlag: "json"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		listV2Fn: func(arg *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error) {
+			return file_requests.NewListFileRequestsV2Result([]*file_requests.FileRequest{testFileRequest(testFileRequestID, "Acme deliverables", true)}, "", false), nil
+		},
+	})
+
+	if err := fileRequestList(cmd, nil); err != nil {
+		t.Fatalf("file-request list error: %v", err)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if string(got.Input) != `{}` {
+		t.Fatalf("input = %s, want empty object", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != fileRequestStatusListed || got.Results[0].Result.URL != "https://www.dropbox.com/request/"+testFileRequestID {
+		t.Fatalf("results = %#v, want one listed file request", got.Results)
+	}
+}
+
+func TestFileRequestDeadlineFlagsValidateGrace(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]string
+		flag  string
+	}{
+		{name: "grace without deadline", flags: map[string]string{"grace": "one-day"}, flag: ""},
+		{name: "invalid deadline", flags: map[string]string{"deadline": "tomorrow"}, flag: "deadline"},
+		{name: "invalid grace", flags: map[string]string{"deadline": "2026-07-01T17:00:00Z", "grace": "forever"}, flag: "grace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testFileRequestCmd()
+			setFileRequestFlags(t, cmd, tt.flags)
+			_, err := fileRequestDeadlineFlags(cmd)
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+			if details := jsonErrorDetails(err); tt.flag != "" && details["flag"] != tt.flag {
+				t.Fatalf("details = %#v, want flag %q", details, tt.flag)
+			}
+		})
+	}
+}
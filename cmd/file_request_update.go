@@ -0,0 +1,250 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+	"github.com/spf13/cobra"
+)
+
+type fileRequestUpdateInput struct {
+	ID               string `json:"id"`
+	Title            string `json:"title,omitempty"`
+	Destination      string `json:"destination,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Deadline         string `json:"deadline,omitempty"`
+	AllowLateUploads string `json:"allow_late_uploads,omitempty"`
+	RemoveDeadline   bool   `json:"remove_deadline,omitempty"`
+	Open             bool   `json:"open,omitempty"`
+	Close            bool   `json:"close,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
+}
+
+func fileRequestUpdate(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`file-request update` requires an `id` argument", argumentErrorDetails("id"))
+	}
+	input, arg, err := parseFileRequestUpdateOptions(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	var request jsonFileRequest
+	if input.DryRun {
+		request, err = getFileRequest(fileRequestsNewFunc(config), input.ID)
+		if err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationUpdate))
+		}
+		previewFileRequestUpdate(&request, input)
+	} else {
+		res, err := fileRequestsNewFunc(config).UpdateRawContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationUpdate), map[string]any{"value": input.ID})
+		}
+		request = jsonFileRequestFromDropbox(res)
+	}
+
+	if !input.DryRun {
+		commandVerboseStatus(cmd, "Updated file request %s", input.ID)
+	}
+	results := []jsonOperationResult{newJSONOperationResult(plannedStatus(input.DryRun, fileRequestStatusUpdated), fileRequestKind, fileRequestResultInput{DryRun: input.DryRun}, request)}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		if input.DryRun {
+			return writeDryRunLine(w, "update file request", input.ID)
+		}
+		return nil
+	})
+}
+
+func parseFileRequestUpdateOptions(cmd *cobra.Command, id string) (fileRequestUpdateInput, *rawUpdateFileRequestArgs, error) {
+	title, _ := cmd.Flags().GetString("title")
+	destinationArg, _ := cmd.Flags().GetString("destination")
+	description, _ := cmd.Flags().GetString("description")
+	removeDeadline, _ := cmd.Flags().GetBool("remove-deadline")
+	reopen, _ := cmd.Flags().GetBool("open")
+	closeRequest, _ := cmd.Flags().GetBool("close")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return fileRequestUpdateInput{}, nil, err
+	}
+
+	if reopen && closeRequest {
+		return fileRequestUpdateInput{}, nil, invalidArgumentsErrorWithDetails("`--open` and `--close` cannot be used together", flagsErrorDetails("open", "close"))
+	}
+	deadline, err := fileRequestDeadlineFlags(cmd)
+	if err != nil {
+		return fileRequestUpdateInput{}, nil, err
+	}
+	if deadline != nil && removeDeadline {
+		return fileRequestUpdateInput{}, nil, invalidArgumentsErrorWithDetails("`--deadline` and `--remove-deadline` cannot be used together", flagsErrorDetails("deadline", "remove-deadline"))
+	}
+	if title == "" && destinationArg == "" && description == "" && deadline == nil && !removeDeadline && !reopen && !closeRequest {
+		return fileRequestUpdateInput{}, nil, invalidArgumentsErrorWithDetails("at least one file request setting flag is required", flagsErrorDetails("title", "destination", "description", "deadline", "remove-deadline", "open", "close"))
+	}
+
+	var destination string
+	if destinationArg != "" {
+		destination, err = validatePath(destinationArg)
+		if err != nil {
+			return fileRequestUpdateInput{}, nil, err
+		}
+		if destination == "" {
+			return fileRequestUpdateInput{}, nil, invalidArgumentsErrorWithDetails("the Dropbox root cannot be a file request destination", mergeJSONErrorDetails(flagErrorDetails("destination"), pathErrorDetails("/")))
+		}
+	}
+
+	arg := newRawUpdateFileRequestArgs(id)
+	arg.Title = title
+	arg.Destination = destination
+	arg.Description = description
+	if deadline != nil || removeDeadline {
+		arg.Deadline = &file_requests.UpdateFileRequestDeadline{Update: deadline}
+		arg.Deadline.Tag = file_requests.UpdateFileRequestDeadlineUpdate
+	}
+	if reopen || closeRequest {
+		arg.Open = &reopen
+	}
+
+	input := fileRequestUpdateInput{
+		ID:             id,
+		Title:          title,
+		Destination:    destination,
+		Description:    description,
+		RemoveDeadline: removeDeadline,
+		Open:           reopen,
+		Close:          closeRequest,
+		DryRun:         dryRun,
+	}
+	input.Deadline, input.AllowLateUploads = fileRequestDeadlineInput(deadline)
+	return input, arg, nil
+}
+
+// previewFileRequestUpdate applies the requested changes to the current file
+// request so dry-run output shows the settings an update would produce.
+func previewFileRequestUpdate(request *jsonFileRequest, input fileRequestUpdateInput) {
+	if input.Title != "" {
+		request.Title = input.Title
+	}
+	if input.Destination != "" {
+		request.Destination = input.Destination
+	}
+	if input.Description != "" {
+		request.Description = input.Description
+	}
+	if input.Deadline != "" {
+		deadline := input.Deadline
+		request.Deadline = &deadline
+		request.AllowLateUploads = input.AllowLateUploads
+	}
+	if input.RemoveDeadline {
+		request.Deadline = nil
+		request.AllowLateUploads = ""
+	}
+	if input.Open || input.Close {
+		request.IsOpen = input.Open
+	}
+}
+
+func fileRequestClose(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return invalidArgumentsErrorWithDetails("`file-request close` requires at least one `id` argument", argumentErrorDetails("id"))
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	client := fileRequestsNewFunc(config)
+	results := make([]jsonOperationResult, 0, len(args))
+	for _, id := range args {
+		var request jsonFileRequest
+		if dryRun {
+			request, err = getFileRequest(client, id)
+			if err != nil {
+				return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationClose))
+			}
+			request.IsOpen = false
+		} else {
+			arg := newRawUpdateFileRequestArgs(id)
+			open := false
+			arg.Open = &open
+			res, err := client.UpdateRawContext(currentContext(), arg)
+			if err != nil {
+				return withJSONErrorDetails(err, operationErrorDetails(fileRequestOperationClose), map[string]any{"value": id})
+			}
+			request = jsonFileRequestFromDropbox(res)
+		}
+		results = append(results, newJSONOperationResult(plannedStatus(dryRun, fileRequestStatusClosed), fileRequestKind, fileRequestInput{ID: id, DryRun: dryRun}, request))
+	}
+
+	return renderOperation(cmd, nil, results, nil, func(w io.Writer) error {
+		if !dryRun && !verbose {
+			return nil
+		}
+		for _, id := range args {
+			if dryRun {
+				if err := writeDryRunLine(w, "close file request", id); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "Closed file request %s\n", id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var fileRequestUpdateCmd = &cobra.Command{
+	Use:   "update [flags] <id>",
+	Short: "Update a file request",
+	Long: `Change the title, destination, description, deadline, or open state of a
+file request. At least one setting flag is required.`,
+	Example: `  dbxcli file-request update oaCAVmEyrqYnkZX9955Y --title "Acme final deliverables"
+  dbxcli file-request update oaCAVmEyrqYnkZX9955Y --deadline 2026-08-01T17:00:00Z --grace two-days
+  dbxcli file-request update oaCAVmEyrqYnkZX9955Y --remove-deadline --open`,
+	RunE: fileRequestUpdate,
+}
+
+var fileRequestCloseCmd = &cobra.Command{
+	Use:     "close [flags] <id>...",
+	Short:   "Close file requests",
+	Long:    "Close one or more file requests so they stop accepting uploads.",
+	Example: `  dbxcli file-request close oaCAVmEyrqYnkZX9955Y`,
+	RunE:    fileRequestClose,
+}
+
+func init() {
+	fileRequestCmd.AddCommand(fileRequestUpdateCmd)
+	fileRequestCmd.AddCommand(fileRequestCloseCmd)
+
+	fileRequestUpdateCmd.Flags().String("title", "", "New file request title")
+	fileRequestUpdateCmd.Flags().String("destination", "", "New destination folder")
+	fileRequestUpdateCmd.Flags().String("description", "", "New file request description")
+	addFileRequestDeadlineFlags(fileRequestUpdateCmd)
+	fileRequestUpdateCmd.Flags().Bool("remove-deadline", false, "Remove the upload deadline")
+	fileRequestUpdateCmd.Flags().Bool("open", false, "Reopen the file request")
+	fileRequestUpdateCmd.Flags().Bool("close", false, "Close the file request")
+
+	for _, cmd := range []*cobra.Command{fileRequestUpdateCmd, fileRequestCloseCmd} {
+		addDryRunFlag(cmd)
+		enableStructuredOutput(cmd)
+	}
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+func TestFileRequestUpdateJSONOutputsRequest(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{
+		outputFlag:        "json",
+		"title":           "Acme final deliverables",
+		"remove-deadline": "true",
+	})
+	var updated *rawUpdateFileRequestArgs
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		updateRawFn: func(arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+			updated = arg
+			return testFileRequest(arg.ID, arg.Title, true), nil
+		},
+	})
+
+	if err := fileRequestUpdate(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request update error: %v", err)
+	}
+	if updated == nil || updated.Title != "Acme final deliverables" || updated.Open != nil {
+		t.Fatalf("update arg = %#v", updated)
+	}
+	if updated.Deadline.Tag != file_requests.UpdateFileRequestDeadlineUpdate || updated.Deadline.Update != nil {
+		t.Fatalf("deadline = %#v, want cleared deadline", updated.Deadline)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if string(got.Input) != `{"id":"oaCAVmEyrqYnkZX9955Y","title":"Acme final deliverables","remove_deadline":true}` {
+		t.Fatalf("input = %s", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != fileRequestStatusUpdated || got.Results[0].Result.Title != "Acme final deliverables" {
+		t.Fatalf("results = %#v, want one updated file request", got.Results)
+	}
+}
+
+func TestFileRequestUpdateDryRunPreviewsChanges(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{outputFlag: "json", "close": "true", "deadline": "2026-08-01T17:00:00Z", dryRunFlagName: "true"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		getFn: func(arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+			return testFileRequest(arg.Id, "Acme deliverables", true), nil
+		},
+		updateRawFn: func(arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+			t.Fatal("update called during dry-run")
+			return nil, nil
+		},
+	})
+
+	if err := fileRequestUpdate(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request update error: %v", err)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != jsonStatusPlanned || string(got.Results[0].Input) != `{"dry_run":true}` {
+		t.Fatalf("results = %#v, want one planned update", got.Results)
+	}
+	request := got.Results[0].Result
+	if request.IsOpen || request.Deadline == nil || *request.Deadline != "2026-08-01T17:00:00Z" || request.Title != "Acme deliverables" {
+		t.Fatalf("preview = %#v", request)
+	}
+}
+
+func TestFileRequestUpdateValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  []string
+	}{
+		{name: "no changes", flags: nil, want: []string{"title", "destination", "description", "deadline", "remove-deadline", "open", "close"}},
+		{name: "open and close", flags: map[string]string{"open": "true", "close": "true"}, want: []string{"open", "close"}},
+		{name: "deadline and remove", flags: map[string]string{"deadline": "2026-08-01T17:00:00Z", "remove-deadline": "true"}, want: []string{"deadline", "remove-deadline"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testFileRequestCmd()
+			setFileRequestFlags(t, cmd, tt.flags)
+			stubFileRequestsClient(t, &mockFileRequestsClient{})
+
+			err := fileRequestUpdate(cmd, []string{testFileRequestID})
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+			flags, _ := jsonErrorDetails(err)["flags"].([]string)
+			if strings.Join(flags, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("flags = %v, want %v", flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileRequestCloseSendsOpenFalse(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{outputFlag: "json"})
+	var ids []string
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		updateRawFn: func(arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+			if arg.Open == nil || *arg.Open {
+				t.Fatalf("open = %v, want false", arg.Open)
+			}
+			ids = append(ids, arg.ID)
+			return testFileRequest(arg.ID, "Acme deliverables", false), nil
+		},
+	})
+
+	if err := fileRequestClose(cmd, []string{"a", "b"}); err != nil {
+		t.Fatalf("file-request close error: %v", err)
+	}
+	if strings.Join(ids, ",") != "a,b" {
+		t.Fatalf("closed ids = %v, want a,b", ids)
+	}
+
+	got := decodeFileRequestOutput(t, stdout)
+	if len(got.Results) != 2 || got.Results[1].Status != fileRequestStatusClosed || string(got.Results[1].Input) != `{"id":"b"}` || got.Results[1].Result.IsOpen {
+		t.Fatalf("results = %#v, want two closed file requests", got.Results)
+	}
+}
+
+func TestFileRequestCloseDryRunPrintsPlan(t *testing.T) {
+	cmd, stdout := testFileRequestCmd()
+	setFileRequestFlags(t, cmd, map[string]string{dryRunFlagName: "true"})
+	stubFileRequestsClient(t, &mockFileRequestsClient{
+		getFn: func(arg *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error) {
+			return testFileRequest(arg.Id, "Acme deliverables", true), nil
+		},
+		updateRawFn: func(arg *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error) {
+			t.Fatal("update called during dry-run")
+			return nil, nil
+		},
+	})
+
+	if err := fileRequestClose(cmd, []string{testFileRequestID}); err != nil {
+		t.Fatalf("file-request close error: %v", err)
+	}
+	if got, want := stdout.String(), "Would close file request oaCAVmEyrqYnkZX9955Y\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestUpdateFileRequestRawSendsOpenFalse(t *testing.T) {
+	var body map[string]any
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() != "https://api.dropboxapi.com/2/file_requests/update" {
+				t.Fatalf("url = %q, want file_requests/update route", req.URL.String())
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":"oaCAVmEyrqYnkZX9955Y","url":"https://www.dropbox.com/request/oaCAVmEyrqYnkZX9955Y","title":"Acme","created":"2026-05-01T09:00:00Z","is_open":false,"file_count":0}`)),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+	dbx := &sdkFileRequestsClient{
+		cfg: dropbox.Config{
+			Token:  "token",
+			Client: httpClient,
+		},
+	}
+
+	arg := newRawUpdateFileRequestArgs(testFileRequestID)
+	open := false
+	arg.Open = &open
+	res, err := dbx.UpdateRawContext(currentContext(), arg)
+	if err != nil {
+		t.Fatalf("UpdateRaw error: %v", err)
+	}
+	if res.Id != testFileRequestID || res.IsOpen {
+		t.Fatalf("result = %#v, want closed request", res)
+	}
+
+	if value, ok := body["open"].(bool); !ok || value {
+		t.Fatalf("open = %#v, want false", body["open"])
+	}
+	deadline, ok := body["deadline"].(map[string]any)
+	if !ok || deadline[".tag"] != file_requests.UpdateFileRequestDeadlineNoUpdate {
+		t.Fatalf("deadline = %#v, want no_update", body["deadline"])
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+type fileRequestsClient interface {
+	CreateContext(context.Context, *file_requests.CreateFileRequestArgs) (*file_requests.FileRequest, error)
+	DeleteContext(context.Context, *file_requests.DeleteFileRequestArgs) (*file_requests.DeleteFileRequestsResult, error)
+	DeleteAllClosedContext(context.Context) (*file_requests.DeleteAllClosedFileRequestsResult, error)
+	GetContext(context.Context, *file_requests.GetFileRequestArgs) (*file_requests.FileRequest, error)
+	ListV2Context(context.Context, *file_requests.ListFileRequestsArg) (*file_requests.ListFileRequestsV2Result, error)
+	ListContinueContext(context.Context, *file_requests.ListFileRequestsContinueArg) (*file_requests.ListFileRequestsV2Result, error)
+	UpdateRawContext(context.Context, *rawUpdateFileRequestArgs) (*file_requests.FileRequest, error)
+}
+
+type sdkFileRequestsClient struct {
+	file_requests.ContextClient
+	cfg dropbox.Config
+}
+
+var fileRequestsNewFunc = func(cfg dropbox.Config) fileRequestsClient {
+	return &sdkFileRequestsClient{
+		ContextClient: file_requests.NewContext(cfg),
+		cfg:           cfg,
+	}
+}
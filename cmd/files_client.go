@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 )
 
@@ -13,16 +14,29 @@ type filesClient interface {
 	CreateFolderV2Context(context.Context, *files.CreateFolderArg) (*files.CreateFolderResult, error)
 	DeleteV2Context(context.Context, *files.DeleteArg) (*files.DeleteResult, error)
 	DownloadContext(context.Context, *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error)
+	DownloadZipContext(context.Context, *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error)
 	ExportContext(context.Context, *files.ExportArg) (*files.ExportResult, io.ReadCloser, error)
+	GetFileLockBatchContext(context.Context, *files.LockFileBatchArg) (*files.LockFileBatchResult, error)
 	GetMetadataContext(context.Context, *files.GetMetadataArg) (files.IsMetadata, error)
+	GetPreviewContext(context.Context, *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error)
+	GetTemporaryLinkContext(context.Context, *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error)
+	GetTemporaryUploadLinkContext(context.Context, *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error)
+	GetThumbnailBatchContext(context.Context, *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error)
 	ListFolderContext(context.Context, *files.ListFolderArg) (*files.ListFolderResult, error)
 	ListFolderContinueContext(context.Context, *files.ListFolderContinueArg) (*files.ListFolderResult, error)
 	ListRevisionsContext(context.Context, *files.ListRevisionsArg) (*files.ListRevisionsResult, error)
+	LockFileBatchContext(context.Context, *files.LockFileBatchArg) (*files.LockFileBatchResult, error)
 	MoveV2Context(context.Context, *files.RelocationArg) (*files.RelocationResult, error)
 	PermanentlyDeleteContext(context.Context, *files.DeleteArg) error
 	RestoreContext(context.Context, *files.RestoreArg) (*files.FileMetadata, error)
+	SaveUrlContext(context.Context, *files.SaveUrlArg) (*files.SaveUrlResult, error)
+	SaveUrlCheckJobStatusContext(context.Context, *async.PollArg) (*files.SaveUrlJobStatus, error)
 	SearchV2Context(context.Context, *files.SearchV2Arg) (*files.SearchV2Result, error)
 	SearchContinueV2Context(context.Context, *files.SearchV2ContinueArg) (*files.SearchV2Result, error)
+	TagsAddContext(context.Context, *files.AddTagArg) error
+	TagsGetContext(context.Context, *files.GetTagsArg) (*files.GetTagsResult, error)
+	TagsRemoveContext(context.Context, *files.RemoveTagArg) error
+	UnlockFileBatchContext(context.Context, *files.UnlockFileBatchArg) (*files.LockFileBatchResult, error)
 	UploadContext(context.Context, *files.UploadArg, io.Reader) (*files.FileMetadata, error)
 	UploadSessionAppendV2Context(context.Context, *files.UploadSessionAppendArg, io.Reader) error
 	UploadSessionFinishContext(context.Context, *files.UploadSessionFinishArg, io.Reader) (*files.FileMetadata, error)
@@ -30,6 +30,7 @@ type listOptions struct {
 	sortBy     string
 	reverse    bool
 	limit      uint64
+	props      *lsPropertyColumns
 }
 
 func formatTime(t time.Time, opts listOptions) string {
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,18 +44,28 @@ const (
 
 type getOptions struct {
 	errOut io.Writer
+	// exportFormat is the --export-format value applied to export-only files
+	// such as Paper docs. Empty means the file's default export format.
+	exportFormat string
+	// decrypt, when set, decrypts downloaded files written by put --encrypt.
+	decrypt *encryptionKeys
 }
 
 type getCommandInput struct {
-	Source    string `json:"source"`
-	Target    string `json:"target"`
-	Recursive bool   `json:"recursive"`
-	Stdout    bool   `json:"stdout"`
+	Source       string `json:"source"`
+	Target       string `json:"target"`
+	Recursive    bool   `json:"recursive"`
+	Stdout       bool   `json:"stdout"`
+	Zip          bool   `json:"zip"`
+	ExportFormat string `json:"export_format,omitempty"`
+	Decrypt      bool   `json:"decrypt,omitempty"`
 }
 
 type getResultInput struct {
 	Source string `json:"source"`
 	Target string `json:"target"`
+	// ExportFormat is the format an export-only file was exported as.
+	ExportFormat string `json:"export_format,omitempty"`
 }
 
 type getResult struct {
@@ -83,13 +94,26 @@ func get(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	recursive, _ := cmd.Flags().GetBool("recursive")
-	opts := parseGetOptions(cmd)
+	opts, err := parseGetOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	if zip, _ := cmd.Flags().GetBool("zip"); zip {
+		if recursive {
+			return invalidArgumentsErrorWithDetails("`--recursive` cannot be used with --zip", flagsErrorDetails("recursive", "zip"))
+		}
+		if opts.decrypt != nil {
+			return invalidArgumentsErrorWithDetails("`--decrypt` cannot be used with --zip", flagsErrorDetails("decrypt", "zip"))
+		}
+		return getZip(cmd, src, args, opts)
+	}
 
 	if dst == "-" {
 		if commandOutputFormat(cmd) == output.FormatJSON {
 			return invalidArgumentsErrorWithDetails("`get --output=json` cannot be used with stdout target `-`", mergeJSONErrorDetails(operationErrorDetails("download"), argumentErrorDetails("dst"), flagErrorDetails("output")))
 		}
-		return getStdout(cmd, src, recursive)
+		return getStdout(cmd, src, recursive, opts)
 	}
 
 	dbx := filesNewFunc(config)
@@ -112,10 +136,12 @@ func get(cmd *cobra.Command, args []string) (err error) {
 			return withJSONErrorDetails(err, operationErrorDetails("download"), pathErrorDetails(src), relocationErrorDetails(src, dst))
 		}
 		return renderGetResults(cmd, getCommandInput{
-			Source:    src,
-			Target:    dst,
-			Recursive: false,
-			Stdout:    false,
+			Source:       src,
+			Target:       dst,
+			Recursive:    false,
+			Stdout:       false,
+			ExportFormat: opts.exportFormat,
+			Decrypt:      opts.decrypt != nil,
 		}, []getResult{result})
 	}
 
@@ -138,20 +164,29 @@ func get(cmd *cobra.Command, args []string) (err error) {
 			dst = filepath.Join(dst, sourceName)
 		}
 		if commandOutputFormat(cmd) == output.FormatText {
-			return withJSONErrorDetails(getRecursiveWithRootMetadata(dbx, src, dst, meta), operationErrorDetails("download"), pathErrorDetails(src), relocationErrorDetails(src, dst))
+			return withJSONErrorDetails(getRecursiveWithRootMetadata(dbx, src, dst, meta, opts), operationErrorDetails("download"), pathErrorDetails(src), relocationErrorDetails(src, dst))
 		}
 		results, err := getRecursiveWithResults(dbx, src, dst, meta, opts)
 		if err != nil {
 			return withJSONErrorDetails(err, operationErrorDetails("download"), pathErrorDetails(src), relocationErrorDetails(src, dst))
 		}
 		return renderGetResults(cmd, getCommandInput{
-			Source:    src,
-			Target:    dst,
-			Recursive: true,
-			Stdout:    false,
+			Source:       src,
+			Target:       dst,
+			Recursive:    true,
+			Stdout:       false,
+			ExportFormat: opts.exportFormat,
+			Decrypt:      opts.decrypt != nil,
 		}, results)
 	}
 
+	if opts.decrypt != nil {
+		// The decrypted file drops the encrypted file extension.
+		sourceName = decryptedName(sourceName)
+		if !dstExplicit {
+			dst = decryptedName(dst)
+		}
+	}
 	dstFilenameExplicit := dstExplicit
 	if f, statErr := os.Stat(dst); statErr == nil && f.IsDir() {
 		dst = filepath.Join(dst, sourceName)
@@ -162,22 +197,89 @@ func get(cmd *cobra.Command, args []string) (err error) {
 	if !ok {
 		return fmt.Errorf("unexpected metadata type for %s", src)
 	}
+	if err := validateGetExportFormat(src, fileMeta, opts.exportFormat); err != nil {
+		return err
+	}
 	result, err := downloadFileWithResult(dbx, src, dst, fileMeta, dstFilenameExplicit, opts)
 	if err != nil {
 		return withJSONErrorDetails(err, operationErrorDetails("download"), pathErrorDetails(src), relocationErrorDetails(src, dst))
 	}
 	return renderGetResults(cmd, getCommandInput{
-		Source:    src,
-		Target:    result.Input.Target,
-		Recursive: false,
-		Stdout:    false,
+		Source:       src,
+		Target:       result.Input.Target,
+		Recursive:    false,
+		Stdout:       false,
+		ExportFormat: opts.exportFormat,
+		Decrypt:      opts.decrypt != nil,
 	}, []getResult{result})
 }
 
-func parseGetOptions(cmd *cobra.Command) getOptions {
+func parseGetOptions(cmd *cobra.Command) (getOptions, error) {
+	exportFormat, _ := cmd.Flags().GetString("export-format")
+	exportFormat = strings.ToLower(strings.TrimSpace(exportFormat))
+	if cmd.Flags().Changed("export-format") && exportFormat == "" {
+		return getOptions{}, invalidArgumentsErrorWithDetails("--export-format requires a format such as markdown, html, pdf, or docx", flagValueErrorDetails("export-format", exportFormat))
+	}
+	decrypt, err := parseDecryptFlags(cmd)
+	if err != nil {
+		return getOptions{}, err
+	}
+	if decrypt != nil && exportFormat != "" {
+		return getOptions{}, invalidArgumentsErrorWithDetails("`--decrypt` cannot be used with --export-format", flagsErrorDetails("decrypt", "export-format"))
+	}
 	return getOptions{
-		errOut: cmd.ErrOrStderr(),
+		errOut:       cmd.ErrOrStderr(),
+		exportFormat: exportFormat,
+		decrypt:      decrypt,
+	}, nil
+}
+
+// exportFormats lists the formats an export-only file can be exported as,
+// starting with its default format.
+func exportFormats(metadata *files.FileMetadata) []string {
+	if !isExportOnlyFile(metadata) {
+		return nil
+	}
+	formats := []string{metadata.ExportInfo.ExportAs}
+	for _, format := range metadata.ExportInfo.ExportOptions {
+		if !slices.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+	return formats
+}
+
+// exportFormatFor returns the format an export-only file is exported as when
+// format was requested, or "" for files that download as-is.
+func exportFormatFor(metadata *files.FileMetadata, format string) string {
+	if !isExportOnlyFile(metadata) {
+		return ""
+	}
+	if format != "" {
+		return format
 	}
+	return metadata.ExportInfo.ExportAs
+}
+
+// validateGetExportFormat rejects --export-format for a single file that is
+// not export-only, or that does not offer the requested format.
+func validateGetExportFormat(src string, metadata *files.FileMetadata, format string) error {
+	if format == "" || metadata == nil {
+		return nil
+	}
+	details := mergeJSONErrorDetails(operationErrorDetails("download"), pathErrorDetails(src), flagValueErrorDetails("export-format", format))
+	if !isExportOnlyFile(metadata) {
+		return invalidArgumentsErrorfWithDetails("%s is not an export-only file; --export-format applies to files such as Paper docs", details, src)
+	}
+	return checkExportFormat(src, metadata, format, details)
+}
+
+func checkExportFormat(src string, metadata *files.FileMetadata, format string, details map[string]any) error {
+	formats := exportFormats(metadata)
+	if format == "" || slices.Contains(formats, format) {
+		return nil
+	}
+	return invalidArgumentsErrorfWithDetails("%s cannot be exported as %s (available: %s)", details, src, format, strings.Join(formats, ", "))
 }
 
 func getErrorOutput(opts getOptions) io.Writer {
@@ -222,22 +324,32 @@ func getOperationResults(results []getResult) []jsonOperationResult {
 	return operationResults
 }
 
-func getStdout(cmd *cobra.Command, src string, recursive bool) error {
-	if recursive {
-		return invalidArgumentsErrorWithDetails("`get -` cannot be used with --recursive", mergeJSONErrorDetails(operationErrorDetails("download"), flagErrorDetails("recursive")))
-	}
-
+func getStdout(cmd *cobra.Command, src string, recursive bool, opts getOptions) error {
 	dbx := filesNewFunc(config)
 
 	meta, err := dbx.GetMetadataContext(currentContext(), files.NewGetMetadataArg(src))
 	if err == nil {
-		if _, ok := meta.(*files.FolderMetadata); ok {
-			return invalidArgumentsErrorfWithDetails("%s is a folder; cannot download folder to stdout", mergeJSONErrorDetails(operationErrorDetails("download"), pathErrorDetails(src)), src)
+		if folder, ok := meta.(*files.FolderMetadata); ok {
+			if !recursive {
+				return invalidArgumentsErrorfWithDetails("%s is a folder; cannot download folder to stdout without --recursive, which writes a tar stream", mergeJSONErrorDetails(operationErrorDetails("download"), pathErrorDetails(src)), src)
+			}
+			if opts.decrypt != nil {
+				return invalidArgumentsErrorWithDetails("`--decrypt` cannot be used with a tar stream to stdout", mergeJSONErrorDetails(pathErrorDetails(src), flagsErrorDetails("decrypt", "recursive")))
+			}
+			return getTar(cmd, dbx, src, folder, opts)
 		}
+	} else if recursive {
+		return withJSONErrorDetails(fmt.Errorf("get metadata for %s: %v", src, err), operationErrorDetails("download"), pathErrorDetails(src))
 	}
 
 	fileMeta, _ := meta.(*files.FileMetadata)
-	return withJSONErrorDetails(downloadToStdoutWithMetadata(dbx, src, fileMeta, cmd.OutOrStdout()), operationErrorDetails("download"), pathErrorDetails(src))
+	if err := validateGetExportFormat(src, fileMeta, opts.exportFormat); err != nil {
+		return err
+	}
+	if opts.decrypt != nil {
+		return withJSONErrorDetails(downloadDecryptedToStdout(dbx, src, opts.decrypt, cmd.OutOrStdout()), operationErrorDetails("download"), pathErrorDetails(src))
+	}
+	return withJSONErrorDetails(downloadToStdoutWithMetadata(dbx, src, fileMeta, opts.exportFormat, cmd.OutOrStdout()), operationErrorDetails("download"), pathErrorDetails(src))
 }
 
 func getRecursive(dbx filesClient, src, dst string) error {
@@ -245,8 +357,8 @@ func getRecursive(dbx filesClient, src, dst string) error {
 	return err
 }
 
-func getRecursiveWithRootMetadata(dbx filesClient, src, dst string, rootMeta files.IsMetadata) error {
-	_, err := getRecursiveInternal(dbx, src, dst, rootMeta, getOptions{}, false)
+func getRecursiveWithRootMetadata(dbx filesClient, src, dst string, rootMeta files.IsMetadata, opts getOptions) error {
+	_, err := getRecursiveInternal(dbx, src, dst, rootMeta, opts, false)
 	return err
 }
 
@@ -255,23 +367,9 @@ func getRecursiveWithResults(dbx filesClient, src, dst string, rootMeta files.Is
 }
 
 func getRecursiveInternal(dbx filesClient, src, dst string, rootMeta files.IsMetadata, opts getOptions, collectResults bool) ([]getResult, error) {
-	arg := files.NewListFolderArg(src)
-	arg.Recursive = true
-
-	res, err := dbx.ListFolderContext(currentContext(), arg)
+	entries, err := listFolderRecursive(dbx, src)
 	if err != nil {
-		return nil, withJSONErrorDetails(fmt.Errorf("list folder %s: %v", src, err), operationErrorDetails("download"), pathErrorDetails(src))
-	}
-
-	var entries []files.IsMetadata
-	entries = append(entries, res.Entries...)
-	for res.HasMore {
-		cont := files.NewListFolderContinueArg(res.Cursor)
-		res, err = dbx.ListFolderContinueContext(currentContext(), cont)
-		if err != nil {
-			return nil, withJSONErrorDetails(fmt.Errorf("list folder continue: %v", err), operationErrorDetails("download"), pathErrorDetails(src))
-		}
-		entries = append(entries, res.Entries...)
+		return nil, err
 	}
 
 	var results []getResult
@@ -325,13 +423,23 @@ func getRecursiveInternal(dbx filesClient, src, dst string, rootMeta files.IsMet
 				continue
 			}
 			localPath := filepath.Join(dst, filepath.FromSlash(relPath))
+			fileOpts := opts
+			if opts.decrypt != nil {
+				// Only encrypted files are decrypted; the rest of the tree
+				// downloads as-is.
+				if hasEncryptedExt(f.Name) {
+					localPath = decryptedName(localPath)
+				} else {
+					fileOpts.decrypt = nil
+				}
+			}
 			if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
 				downloadErrors = append(downloadErrors, fmt.Errorf("mkdir %s: %w", filepath.Dir(localPath), err))
 				continue
 			}
 			fmt.Fprintf(getErrorOutput(opts), "Downloading %s -> %s\n", f.PathDisplay, localPath)
 			if collectResults {
-				result, err := downloadFileWithResult(dbx, f.PathDisplay, localPath, f, false, opts)
+				result, err := downloadFileWithResult(dbx, f.PathDisplay, localPath, f, false, fileOpts)
 				if err != nil {
 					downloadErrors = append(
 						downloadErrors,
@@ -342,7 +450,7 @@ func getRecursiveInternal(dbx filesClient, src, dst string, rootMeta files.IsMet
 				results = append(results, result)
 				continue
 			}
-			if _, _, err := downloadFileWithMetadata(dbx, f.PathDisplay, localPath, f, false, getErrorOutput(opts)); err != nil {
+			if _, _, err := downloadFileWithMetadata(dbx, f.PathDisplay, localPath, f, false, fileOpts); err != nil {
 				downloadErrors = append(
 					downloadErrors,
 					fmt.Errorf("%s: %w", f.PathDisplay, err),
@@ -361,6 +469,29 @@ func getRecursiveInternal(dbx filesClient, src, dst string, rootMeta files.IsMet
 	return results, nil
 }
 
+// listFolderRecursive returns every entry under src, following pagination.
+func listFolderRecursive(dbx filesClient, src string) ([]files.IsMetadata, error) {
+	arg := files.NewListFolderArg(src)
+	arg.Recursive = true
+
+	res, err := dbx.ListFolderContext(currentContext(), arg)
+	if err != nil {
+		return nil, withJSONErrorDetails(fmt.Errorf("list folder %s: %v", src, err), operationErrorDetails("download"), pathErrorDetails(src))
+	}
+
+	var entries []files.IsMetadata
+	entries = append(entries, res.Entries...)
+	for res.HasMore {
+		cont := files.NewListFolderContinueArg(res.Cursor)
+		res, err = dbx.ListFolderContinueContext(currentContext(), cont)
+		if err != nil {
+			return nil, withJSONErrorDetails(fmt.Errorf("list folder continue: %v", err), operationErrorDetails("download"), pathErrorDetails(src))
+		}
+		entries = append(entries, res.Entries...)
+	}
+	return entries, nil
+}
+
 func ensureLocalDirectoryResult(source, target string, metadata files.IsMetadata) (getResult, error) {
 	status := getStatusCreated
 	if info, err := os.Stat(target); err == nil {
@@ -390,7 +521,7 @@ func relativeTo(base, full string) (string, error) {
 }
 
 func downloadFile(dbx filesClient, src string, dst string) error {
-	_, _, err := downloadFileWithMetadata(dbx, src, dst, nil, false, os.Stderr)
+	_, _, err := downloadFileWithMetadata(dbx, src, dst, nil, false, getOptions{})
 	return err
 }
 
@@ -402,11 +533,17 @@ func downloadFileWithResult(
 	dstExplicit bool,
 	opts getOptions,
 ) (getResult, error) {
-	metadata, actualDst, err := downloadFileWithMetadata(dbx, src, dst, metadata, dstExplicit, getErrorOutput(opts))
+	exportFormat := exportFormatFor(metadata, opts.exportFormat)
+	metadata, actualDst, err := downloadFileWithMetadata(dbx, src, dst, metadata, dstExplicit, opts)
+	if err != nil {
+		return getResult{}, err
+	}
+	result, err := newGetResult(getStatusDownloaded, getKindFile, src, actualDst, metadata)
 	if err != nil {
 		return getResult{}, err
 	}
-	return newGetResult(getStatusDownloaded, getKindFile, src, actualDst, metadata)
+	result.Input.ExportFormat = exportFormat
+	return result, nil
 }
 
 func downloadFileWithMetadata(
@@ -415,18 +552,25 @@ func downloadFileWithMetadata(
 	dst string,
 	metadata *files.FileMetadata,
 	dstExplicit bool,
-	errOut io.Writer,
+	opts getOptions,
 ) (*files.FileMetadata, string, error) {
+	if opts.decrypt != nil {
+		result, err := downloadDecryptedFile(dbx, src, dst, opts)
+		return result, dst, err
+	}
 	if !isExportOnlyFile(metadata) {
-		result, err := downloadFileOnce(dbx, src, dst, errOut)
+		result, err := downloadFileOnce(dbx, src, dst, getErrorOutput(opts))
 		return result, dst, err
 	}
+	if err := checkExportFormat(src, metadata, opts.exportFormat, flagValueErrorDetails("export-format", opts.exportFormat)); err != nil {
+		return nil, "", err
+	}
 
 	var result *files.FileMetadata
 	actualDst := dst
 	err := retryWithBackoff(func() error {
 		var err error
-		result, actualDst, err = exportFileToPath(dbx, src, dst, dstExplicit)
+		result, actualDst, err = exportFileToPath(dbx, src, opts.exportFormat, dst, dstExplicit)
 		return err
 	})
 	return result, actualDst, err
@@ -507,15 +651,18 @@ func downloadFileOnce(dbx filesClient, src string, dst string, errOut io.Writer)
 func exportFile(
 	dbx filesClient,
 	src string,
+	format string,
 ) (*files.ExportResult, io.ReadCloser, error) {
+	arg := files.NewExportArg(src)
+	arg.ExportFormat = format
 	return dbx.ExportContext(
 		currentContext(),
-		files.NewExportArg(src),
+		arg,
 	)
 }
 
-func exportFileToPath(dbx filesClient, src string, dst string, dstExplicit bool) (*files.FileMetadata, string, error) {
-	res, contents, err := exportFile(dbx, src)
+func exportFileToPath(dbx filesClient, src string, format string, dst string, dstExplicit bool) (*files.FileMetadata, string, error) {
+	res, contents, err := exportFile(dbx, src, format)
 	if err != nil {
 		return nil, "", err
 	}
@@ -525,14 +672,25 @@ func exportFileToPath(dbx filesClient, src string, dst string, dstExplicit bool)
 		dst = filepath.Join(filepath.Dir(dst), res.ExportMetadata.Name)
 	}
 
+	if err := writeDownloadFile(dst, contents); err != nil {
+		return nil, "", err
+	}
+	return res.FileMetadata, dst, nil
+}
+
+// writeDownloadFile copies contents into a temporary file beside dst and
+// renames it into place, so an interrupted download never leaves a partial
+// file at dst. Symlinks at dst are resolved first and the link target is
+// replaced.
+func writeDownloadFile(dst string, contents io.Reader) error {
 	finalDst, err := downloadDestinationPath(dst)
 	if err != nil {
-		return nil, "", err
+		return err
 	}
 
 	f, tmp, err := createDownloadTemp(finalDst)
 	if err != nil {
-		return nil, "", err
+		return err
 	}
 
 	removeTemp := true
@@ -546,18 +704,18 @@ func exportFileToPath(dbx filesClient, src string, dst string, dstExplicit bool)
 	closeErr := f.Close()
 
 	if copyErr != nil {
-		return nil, "", copyErr
+		return copyErr
 	}
 	if closeErr != nil {
-		return nil, "", closeErr
+		return closeErr
 	}
 
 	if err := os.Rename(tmp, finalDst); err != nil {
-		return nil, "", err
+		return err
 	}
 
 	removeTemp = false
-	return res.FileMetadata, dst, nil
+	return nil
 }
 
 func isExportOnlyFile(metadata *files.FileMetadata) bool {
@@ -576,17 +734,43 @@ var getCmd = &cobra.Command{
   - Use --recursive (-r) to download entire directories.
   - Use - as target to write file bytes to stdout.
     Stdout is byte-clean: all progress and errors go to stderr.
+  - With --recursive, - writes a folder to stdout as a POSIX tar stream.
+    Entry names are relative to the folder and keep each file's modified
+    time, so the stream can be piped into tar x or put --untar.
+  - Export-only files such as Paper docs are exported in their default
+    format. Use --export-format to choose another one; ls -l lists the
+    formats each file offers. With --recursive the format applies to
+    every export-only file in the tree.
+  - Use --decrypt to verify and decrypt files uploaded with put --encrypt.
+    The key comes from --passphrase-file, DBXCLI_ENCRYPTION_PASSPHRASE, or
+    --identity, the PEM X25519 private key matching put --recipient. The
+    .dbxenc extension is dropped from default target names, and with
+    --recursive only .dbxenc files are decrypted.
+  - Use --zip to download a folder as one zip archive instead of one request
+    per file. The target defaults to <folder>.zip; use - to stream the
+    archive to stdout. Folders of 20 GB or more, or with 10,000 or more
+    files, cannot be zipped; use --recursive for those.
 `,
 	Example: `  dbxcli get /remote/file.txt ./local-file.txt
   dbxcli get rev:a1c10ce0dd78 ./historical-file.txt
   dbxcli get -r /remote/folder ./local-folder
   dbxcli get /backups/src.tgz - | tar tz
-  dbxcli get /file.txt - > local-copy.txt`,
+  dbxcli get /file.txt - > local-copy.txt
+  dbxcli get -r /Projects/site - | tar x -C ./site
+  dbxcli get --decrypt --passphrase-file ~/.dbxcli-key /Private/taxes.pdf.dbxenc
+  dbxcli get --export-format html /Notes/plan.paper ./plan.html
+  dbxcli get --zip /Photos/2024 ./photos-2024.zip
+  dbxcli get --zip /Photos/2024 - | bsdtar -tf -`,
 	RunE: get,
 }
 
 func init() {
 	RootCmd.AddCommand(getCmd)
 	getCmd.Flags().BoolP("recursive", "r", false, "Recursively download a folder")
+	getCmd.Flags().Bool("zip", false, "Download a folder as a single zip archive")
+	getCmd.Flags().String("export-format", "", "Format for export-only files such as Paper docs (markdown, html, pdf, docx)")
+	getCmd.Flags().Bool("decrypt", false, "Verify and decrypt files uploaded with put --encrypt")
+	getCmd.Flags().String("passphrase-file", "", "Read the --decrypt passphrase from a file (default: $DBXCLI_ENCRYPTION_PASSPHRASE)")
+	getCmd.Flags().String("identity", "", "PEM X25519 private key for files encrypted with put --recipient")
 	enableStructuredOutput(getCmd)
 }
@@ -894,7 +894,7 @@ func TestGetStdoutFolderErrorHasInvalidArgumentsCode(t *testing.T) {
 	}
 	stubFilesClient(t, mock)
 
-	err := getStdout(&cobra.Command{}, "/remote-folder", false)
+	err := getStdout(&cobra.Command{}, "/remote-folder", false, getOptions{})
 	if err == nil {
 		t.Fatal("expected folder stdout error")
 	}
@@ -1383,3 +1383,142 @@ func TestGetRecursiveExportOnlyFileUsesExportName(t *testing.T) {
 		t.Fatalf("doc.paper exists or stat failed with non-not-exist error: %v", err)
 	}
 }
+
+func getTestPaperMetadata(path string) *files.FileMetadata {
+	return &files.FileMetadata{
+		Metadata: files.Metadata{
+			Name:        path[strings.LastIndex(path, "/")+1:],
+			PathDisplay: path,
+		},
+		ExportInfo: &files.ExportInfo{
+			ExportAs:      "html",
+			ExportOptions: []string{"html", "markdown"},
+		},
+	}
+}
+
+func TestGetRecursiveExportFormatAppliesToEveryExportOnlyFile(t *testing.T) {
+	dst := filepath.Join(t.TempDir(), "out")
+	var exported []string
+	mock := &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestFolderMetadata("/remote"), nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				getTestPaperMetadata("/remote/a.paper"),
+				getTestPaperMetadata("/remote/b.paper"),
+			}}, nil
+		},
+		exportFn: func(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error) {
+			exported = append(exported, arg.Path+"="+arg.ExportFormat)
+			name := strings.TrimSuffix(filepath.Base(arg.Path), ".paper") + ".md"
+			return &files.ExportResult{
+				ExportMetadata: &files.ExportMetadata{Name: name},
+				FileMetadata:   getTestPaperMetadata(arg.Path),
+			}, io.NopCloser(strings.NewReader("# notes")), nil
+		},
+	}
+	stubFilesClient(t, mock)
+
+	var stdout bytes.Buffer
+	cmd := testGetCmd()
+	cmd.SetOut(&stdout)
+	cmd.SetErr(io.Discard)
+	cmd.Flags().String(outputFlag, "json", "")
+	for name, value := range map[string]string{"recursive": "true", "export-format": "Markdown"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := get(cmd, []string{"/remote", dst}); err != nil {
+		t.Fatalf("get error: %v", err)
+	}
+	if got := strings.Join(exported, ","); got != "/remote/a.paper=markdown,/remote/b.paper=markdown" {
+		t.Fatalf("exports = %s, want markdown for every paper doc", got)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "b.md")); err != nil {
+		t.Fatalf("stat b.md: %v", err)
+	}
+
+	var output struct {
+		Input   getCommandInput `json:"input"`
+		Results []getResult     `json:"results"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if output.Input.ExportFormat != "markdown" {
+		t.Fatalf("input export_format = %q, want markdown", output.Input.ExportFormat)
+	}
+	if len(output.Results) != 3 || output.Results[1].Input.ExportFormat != "markdown" || output.Results[0].Input.ExportFormat != "" {
+		t.Fatalf("results = %#v, want export_format on exported files only", output.Results)
+	}
+	if got := output.Results[1].Result.ExportOptions; strings.Join(got, ",") != "html,markdown" {
+		t.Fatalf("result export_options = %v", got)
+	}
+}
+
+func TestGetExportFormatValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		meta   *files.FileMetadata
+		format string
+		want   string
+	}{
+		{name: "regular file", meta: getTestFileMetadata("/notes.txt", 4), format: "pdf", want: "not an export-only file"},
+		{name: "unsupported format", meta: getTestPaperMetadata("/plan.paper"), format: "docx", want: "available: html, markdown"},
+		{name: "empty format", meta: getTestPaperMetadata("/plan.paper"), format: " ", want: "requires a format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubFilesClient(t, &mockFilesClient{
+				getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+					return tt.meta, nil
+				},
+				exportFn: func(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error) {
+					t.Fatal("ExportContext should not be called")
+					return nil, nil, nil
+				},
+			})
+			cmd := testGetCmd()
+			if err := cmd.Flags().Set("export-format", tt.format); err != nil {
+				t.Fatal(err)
+			}
+
+			err := get(cmd, []string{tt.meta.PathDisplay, filepath.Join(t.TempDir(), "out")})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+			if jsonErrorCode(err) != jsonErrorCodeInvalidArguments || jsonErrorDetails(err)["flag"] != "export-format" {
+				t.Fatalf("code = %q, details = %#v", jsonErrorCode(err), jsonErrorDetails(err))
+			}
+		})
+	}
+}
+
+func TestGetStdoutExportFormat(t *testing.T) {
+	var format string
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestPaperMetadata(arg.Path), nil
+		},
+		exportFn: func(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error) {
+			format = arg.ExportFormat
+			return &files.ExportResult{}, io.NopCloser(strings.NewReader("# plan")), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := testGetCmd()
+	cmd.SetOut(&stdout)
+	if err := cmd.Flags().Set("export-format", "markdown"); err != nil {
+		t.Fatal(err)
+	}
+	if err := get(cmd, []string{"/plan.paper", "-"}); err != nil {
+		t.Fatalf("get error: %v", err)
+	}
+	if format != "markdown" || stdout.String() != "# plan" {
+		t.Fatalf("format = %q, stdout = %q", format, stdout.String())
+	}
+}
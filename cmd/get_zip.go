@@ -0,0 +1,167 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dustin/go-humanize"
+	"github.com/mitchellh/ioprogress"
+	"github.com/spf13/cobra"
+)
+
+const (
+	getKindZip         = "zip"
+	getZipOperation    = "download_zip"
+	getZipDefaultName  = "Dropbox"
+	getZipLimitsReason = "Dropbox only zips folders under 20 GB with fewer than 10,000 files, each under 4 GB"
+)
+
+// getZip downloads a folder as a single zip archive through
+// files/download_zip instead of one request per file.
+func getZip(cmd *cobra.Command, src string, args []string, opts getOptions) error {
+	if opts.exportFormat != "" {
+		return invalidArgumentsErrorWithDetails("`--zip` and `--export-format` cannot be used together", flagsErrorDetails("zip", "export-format"))
+	}
+
+	target := ""
+	if len(args) == 2 {
+		target = args[1]
+	}
+
+	dbx := filesNewFunc(config)
+	if target == "-" {
+		if commandOutputFormat(cmd) == output.FormatJSON {
+			return invalidArgumentsErrorWithDetails("`get --output=json` cannot be used with stdout target `-`", mergeJSONErrorDetails(operationErrorDetails(getZipOperation), argumentErrorDetails("dst"), flagErrorDetails("output")))
+		}
+		err := streamToStdout(cmd.OutOrStdout(), func() (io.ReadCloser, error) {
+			_, contents, err := dbx.DownloadZipContext(currentContext(), files.NewDownloadZipArg(src))
+			if err != nil {
+				return nil, err
+			}
+			return zipProgressReadCloser(contents, getErrorOutput(opts)), nil
+		})
+		return getZipError(err, src, "")
+	}
+
+	metadata, target, err := downloadZipToPath(dbx, src, target, getErrorOutput(opts))
+	if err != nil {
+		return getZipError(err, src, target)
+	}
+	commandVerboseStatus(cmd, "Downloaded %s -> %s", src, target)
+
+	var resultMetadata files.IsMetadata
+	if metadata != nil {
+		resultMetadata = metadata
+	}
+	result, err := newGetResult(getStatusDownloaded, getKindZip, src, target, resultMetadata)
+	if err != nil {
+		return err
+	}
+	return renderGetResults(cmd, getCommandInput{
+		Source: src,
+		Target: target,
+		Zip:    true,
+	}, []getResult{result})
+}
+
+// downloadZipToPath writes the zip archive of src to dst. An empty dst or an
+// existing directory gets <folder name>.zip, named from the folder metadata
+// Dropbox returns with the archive.
+func downloadZipToPath(dbx filesClient, src, dst string, errOut io.Writer) (*files.FolderMetadata, string, error) {
+	var metadata *files.FolderMetadata
+	actualDst := dst
+	err := retryWithBackoff(func() error {
+		res, contents, err := dbx.DownloadZipContext(currentContext(), files.NewDownloadZipArg(src))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = contents.Close() }()
+
+		if res != nil {
+			metadata = res.Metadata
+		}
+		actualDst = zipTargetPath(src, dst, metadata)
+		return writeDownloadFile(actualDst, zipProgressReadCloser(contents, errOut))
+	})
+	return metadata, actualDst, err
+}
+
+func zipTargetPath(src, dst string, metadata *files.FolderMetadata) string {
+	name := path.Base(src)
+	if metadata != nil && metadata.Name != "" {
+		name = metadata.Name
+	}
+	if name == "" || name == "/" || name == "." {
+		name = getZipDefaultName
+	}
+	name += ".zip"
+
+	if dst == "" {
+		return name
+	}
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		return filepath.Join(dst, name)
+	}
+	return dst
+}
+
+// zipProgressReadCloser reports bytes received on errOut. Dropbox does not
+// send the archive size up front, so only the running total is shown.
+func zipProgressReadCloser(contents io.ReadCloser, errOut io.Writer) io.ReadCloser {
+	if errOut == nil {
+		errOut = io.Discard
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: &ioprogress.Reader{
+			Reader: contents,
+			DrawFunc: ioprogress.DrawTerminalf(errOut, func(progress, _ int64) string {
+				return fmt.Sprintf("Downloading zip %s", humanize.IBytes(uint64(progress)))
+			}),
+		},
+		Closer: contents,
+	}
+}
+
+// getZipError explains the download_zip size and file-count limits, pointing
+// at `get -r` as the fallback for folders too big to zip.
+func getZipError(err error, src, target string) error {
+	if err == nil {
+		return nil
+	}
+	details := mergeJSONErrorDetails(operationErrorDetails(getZipOperation), pathErrorDetails(src))
+	if target != "" {
+		details = mergeJSONErrorDetails(details, relocationErrorDetails(src, target))
+	}
+
+	var apiErr files.DownloadZipAPIError
+	if errors.As(err, &apiErr) && apiErr.EndpointError != nil {
+		switch apiErr.EndpointError.Tag {
+		case files.DownloadZipErrorTooLarge, files.DownloadZipErrorTooManyFiles:
+			return newCodedError(jsonErrorCodeCommandFailed, fmt.Errorf("%s is too big to download as a zip (%s); use `get -r` instead: %w", src, getZipLimitsReason, err), details)
+		}
+	}
+	return withJSONErrorDetails(err, details)
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestGetZipJSONWritesDefaultTarget(t *testing.T) {
+	t.Chdir(t.TempDir())
+	cmd, stdout, stderr := testGetZipCmd("json")
+	stubFilesClient(t, &mockFilesClient{
+		downloadZipFn: func(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+			if arg.Path != "/Photos/2024" {
+				t.Fatalf("download_zip path = %q", arg.Path)
+			}
+			meta := getTestFolderMetadata("/Photos/2024")
+			meta.Name = "2024"
+			return &files.DownloadZipResult{Metadata: meta}, io.NopCloser(strings.NewReader("PK\x03\x04")), nil
+		},
+	})
+
+	if err := get(cmd, []string{"Photos/2024"}); err != nil {
+		t.Fatalf("get --zip error: %v", err)
+	}
+	if content, err := os.ReadFile("2024.zip"); err != nil || string(content) != "PK\x03\x04" {
+		t.Fatalf("zip file = %q, %v", content, err)
+	}
+	if !strings.Contains(stderr.String(), "Downloading zip") {
+		t.Fatalf("stderr = %q, want progress", stderr.String())
+	}
+
+	var got struct {
+		Input   getCommandInput `json:"input"`
+		Results []getResult     `json:"results"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if !got.Input.Zip || got.Input.Target != "2024.zip" {
+		t.Fatalf("input = %#v", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Kind != getKindZip || got.Results[0].Result == nil || got.Results[0].Result.Type != "folder" {
+		t.Fatalf("results = %#v, want one zip result", got.Results)
+	}
+}
+
+func TestGetZipIntoDirectory(t *testing.T) {
+	dir := t.TempDir()
+	cmd, _, _ := testGetZipCmd("text")
+	stubFilesClient(t, &mockFilesClient{
+		downloadZipFn: func(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+			return &files.DownloadZipResult{Metadata: getTestFolderMetadata("/Shared Docs")}, io.NopCloser(strings.NewReader("zip")), nil
+		},
+	})
+
+	if err := get(cmd, []string{"id:abc", dir}); err != nil {
+		t.Fatalf("get --zip error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "Shared Docs.zip")); err != nil {
+		t.Fatalf("stat zip: %v", err)
+	}
+}
+
+func TestGetZipStdout(t *testing.T) {
+	cmd, stdout, stderr := testGetZipCmd("text")
+	stubFilesClient(t, &mockFilesClient{
+		downloadZipFn: func(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+			return &files.DownloadZipResult{}, io.NopCloser(strings.NewReader("zip-bytes")), nil
+		},
+	})
+
+	if err := get(cmd, []string{"/Photos", "-"}); err != nil {
+		t.Fatalf("get --zip error: %v", err)
+	}
+	if stdout.String() != "zip-bytes" {
+		t.Fatalf("stdout = %q, want only archive bytes", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Downloading zip") {
+		t.Fatalf("stderr = %q, want progress", stderr.String())
+	}
+}
+
+func TestGetZipTooLargeSuggestsRecursive(t *testing.T) {
+	for _, tag := range []string{files.DownloadZipErrorTooLarge, files.DownloadZipErrorTooManyFiles} {
+		t.Run(tag, func(t *testing.T) {
+			cmd, _, _ := testGetZipCmd("text")
+			stubFilesClient(t, &mockFilesClient{
+				downloadZipFn: func(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+					return nil, nil, files.DownloadZipAPIError{
+						APIError:      dropbox.APIError{ErrorSummary: tag},
+						EndpointError: &files.DownloadZipError{Tagged: dropbox.Tagged{Tag: tag}},
+					}
+				},
+			})
+
+			err := get(cmd, []string{"/Archive", filepath.Join(t.TempDir(), "a.zip")})
+			if err == nil || !strings.Contains(err.Error(), "use `get -r` instead") {
+				t.Fatalf("err = %v, want get -r hint", err)
+			}
+			details := jsonErrorDetails(err)
+			if jsonErrorCode(err) != jsonErrorCodeCommandFailed || details["operation"] != getZipOperation || details["api_summary"] != tag {
+				t.Fatalf("code = %q, details = %#v", jsonErrorCode(err), details)
+			}
+		})
+	}
+}
+
+func TestGetZipValidation(t *testing.T) {
+	cmd, _, _ := testGetZipCmd("json")
+	stubFilesClient(t, &mockFilesClient{})
+	if err := get(cmd, []string{"/Photos", "-"}); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("stdout with JSON err = %v, want invalid arguments", err)
+	}
+
+	cmd, _, _ = testGetZipCmd("text")
+	if err := cmd.Flags().Set("export-format", "html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := get(cmd, []string{"/Photos"}); jsonErrorDetails(err)["flags"] == nil {
+		t.Fatalf("err = %v, want --zip/--export-format conflict", err)
+	}
+
+	cmd, _, _ = testGetZipCmd("text")
+	if err := cmd.Flags().Set("recursive", "true"); err != nil {
+		t.Fatal(err)
+	}
+	err := get(cmd, []string{"/Photos"})
+	if jsonErrorCode(err) != jsonErrorCodeInvalidArguments || !strings.Contains(err.Error(), "--recursive") {
+		t.Fatalf("err = %v, want --zip/--recursive conflict", err)
+	}
+	if flags, _ := jsonErrorDetails(err)["flags"].([]string); strings.Join(flags, ",") != "recursive,zip" {
+		t.Fatalf("details = %#v, want recursive and zip flags", jsonErrorDetails(err))
+	}
+}
+
+func testGetZipCmd(format string) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+	var stdout, stderr bytes.Buffer
+	cmd := testGetCmd()
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, format, "")
+	if err := cmd.Flags().Set("zip", "true"); err != nil {
+		panic(err)
+	}
+	return cmd, &stdout, &stderr
+}
@@ -0,0 +1,379 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	hashStatusHashed  = "hashed"
+	hashStatusMatched = "matched"
+	hashKindFile      = "file"
+
+	hashOperationCheck = "hash_check"
+
+	// Check outcomes other than a match only appear in text output; any of
+	// them makes the command fail with hash_mismatch.
+	hashCheckOK            = "OK"
+	hashCheckFailed        = "FAILED"
+	hashCheckMissingRemote = "MISSING IN DROPBOX"
+	hashCheckMissingLocal  = "MISSING LOCALLY"
+)
+
+// hashCheckFailureOutcomes maps text-mode check outcomes to the outcome
+// values reported in hash_mismatch error details.
+var hashCheckFailureOutcomes = map[string]string{
+	hashCheckFailed:        "mismatched",
+	hashCheckMissingRemote: "missing_remote",
+	hashCheckMissingLocal:  "missing_local",
+}
+
+type hashInput struct {
+	Paths     []string `json:"paths"`
+	Recursive bool     `json:"recursive"`
+	Check     bool     `json:"check"`
+	Remote    string   `json:"remote,omitempty"`
+}
+
+type hashResultInput struct {
+	Path   string `json:"path"`
+	Remote string `json:"remote,omitempty"`
+}
+
+// hashJSON is the Dropbox content hash of a local file.
+type hashJSON struct {
+	ContentHash string `json:"content_hash"`
+	Size        int64  `json:"size"`
+}
+
+type hashResult struct {
+	input       hashResultInput
+	contentHash string
+	size        int64
+	// check is the text-mode outcome of a --check comparison.
+	check string
+}
+
+func hash(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return invalidArgumentsErrorWithDetails("`hash` requires at least one local `file` argument", argumentErrorDetails("file"))
+	}
+	recursive, _ := cmd.Flags().GetBool("recursive")
+	check, _ := cmd.Flags().GetBool("check")
+	if check {
+		return hashCheck(cmd, args, recursive)
+	}
+
+	var results []hashResult
+	for _, arg := range args {
+		fileResults, err := hashLocalPath(arg, recursive)
+		if err != nil {
+			return err
+		}
+		results = append(results, fileResults...)
+	}
+
+	input := hashInput{Paths: args, Recursive: recursive}
+	return renderOperation(cmd, input, hashOperationResults(hashStatusHashed, results), nil, func(w io.Writer) error {
+		for _, result := range results {
+			if _, err := fmt.Fprintf(w, "%s  %s\n", result.contentHash, result.input.Path); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// hashLocalPath hashes src, or every regular file under it with recursive.
+func hashLocalPath(src string, recursive bool) ([]hashResult, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return nil, withJSONErrorDetails(err, pathErrorDetails(src))
+	}
+	if !info.IsDir() {
+		result, err := hashLocalFile(src)
+		if err != nil {
+			return nil, err
+		}
+		return []hashResult{result}, nil
+	}
+	if !recursive {
+		return nil, invalidArgumentsErrorfWithDetails("%s is a directory (use --recursive to hash every file in it)", pathErrorDetails(src), src)
+	}
+
+	var results []hashResult
+	err = filepath.WalkDir(src, func(filePath string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		result, err := hashLocalFile(filePath)
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, withJSONErrorDetails(err, pathErrorDetails(src))
+	}
+	return results, nil
+}
+
+func hashLocalFile(src string) (hashResult, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return hashResult{}, withJSONErrorDetails(err, pathErrorDetails(src))
+	}
+	defer f.Close()
+
+	counter := &countingWriter{w: io.Discard}
+	sum, err := contenthash.Compute(io.TeeReader(f, counter))
+	if err != nil {
+		return hashResult{}, withJSONErrorDetails(fmt.Errorf("hash %s: %w", src, err), pathErrorDetails(src))
+	}
+	return hashResult{
+		input:       hashResultInput{Path: src},
+		contentHash: sum,
+		size:        counter.n,
+	}, nil
+}
+
+// hashCheck compares local content hashes with the content_hash Dropbox
+// stores for each file, without downloading anything.
+func hashCheck(cmd *cobra.Command, args []string, recursive bool) error {
+	if len(args) != 2 {
+		return invalidArgumentsErrorWithDetails("`hash --check` requires a local path and a Dropbox path", argumentsErrorDetails("local", "remote"))
+	}
+	local := args[0]
+	remote, err := validatePath(args[1])
+	if err != nil {
+		return err
+	}
+	input := hashInput{Paths: []string{local}, Recursive: recursive, Check: true, Remote: remote}
+	details := mergeJSONErrorDetails(operationErrorDetails(hashOperationCheck), pathErrorDetails(local))
+
+	info, err := os.Stat(local)
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+	if info.IsDir() && !recursive {
+		return invalidArgumentsErrorfWithDetails("%s is a directory (use --recursive to check every file in it)", details, local)
+	}
+
+	dbx := filesNewFunc(config)
+	var results []hashResult
+	if info.IsDir() {
+		results, err = hashCheckFolder(dbx, local, remote)
+	} else {
+		results, err = hashCheckFile(dbx, local, remote)
+	}
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+
+	var failed []hashResult
+	for _, result := range results {
+		if result.check != hashCheckOK {
+			failed = append(failed, result)
+		}
+	}
+	text := func(w io.Writer) error {
+		for _, result := range results {
+			name := result.input.Path
+			if result.check == hashCheckMissingLocal {
+				name = result.input.Remote
+			}
+			if _, err := fmt.Fprintf(w, "%s: %s\n", name, result.check); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(failed) == 0 {
+		return renderOperation(cmd, input, hashOperationResults(hashStatusMatched, results), nil, text)
+	}
+
+	// The error envelope replaces the result list in JSON output, so text
+	// output lists every file and the error details list every failure.
+	if commandOutputFormat(cmd) == output.FormatText {
+		if err := commandOutput(cmd).RenderText(text); err != nil {
+			return err
+		}
+	}
+	first := failed[0].input.Path
+	if failed[0].check == hashCheckMissingLocal {
+		first = failed[0].input.Remote
+	}
+	return newCodedError(jsonErrorCodeHashMismatch, fmt.Errorf("hash check failed: %d of %d file(s) do not match Dropbox, starting with %s", len(failed), len(results), first), mergeJSONErrorDetails(operationErrorDetails(hashOperationCheck), pathErrorDetails(first), hashCheckFailureErrorDetails(failed)))
+}
+
+func hashCheckFailureErrorDetails(failed []hashResult) map[string]any {
+	failures := make([]map[string]any, 0, len(failed))
+	for _, result := range failed {
+		failures = append(failures, map[string]any{
+			"local_path":  result.input.Path,
+			"remote_path": result.input.Remote,
+			"outcome":     hashCheckFailureOutcomes[result.check],
+		})
+	}
+	return map[string]any{"failures": failures}
+}
+
+func hashCheckFile(dbx filesClient, local, remote string) ([]hashResult, error) {
+	result, err := hashLocalFile(local)
+	if err != nil {
+		return nil, err
+	}
+	result.input.Remote = remote
+
+	meta, err := dbx.GetMetadataContext(currentContext(), files.NewGetMetadataArg(remote))
+	if err != nil {
+		if isGetMetadataNotFoundError(err) {
+			result.check = hashCheckMissingRemote
+			return []hashResult{result}, nil
+		}
+		return nil, fmt.Errorf("get metadata for %s: %w", remote, err)
+	}
+	file, ok := meta.(*files.FileMetadata)
+	if !ok {
+		return nil, invalidArgumentsErrorfWithDetails("%s is not a file in Dropbox (use --recursive with a local directory to check a folder)", pathErrorDetails(remote), remote)
+	}
+	result.check = hashCheckOutcome(result, file)
+	return []hashResult{result}, nil
+}
+
+// hashCheckFolder compares every regular file under local with the file at
+// the same relative path under remote. Dropbox paths compare
+// case-insensitively. Files on only one side are reported as missing.
+func hashCheckFolder(dbx filesClient, local, remote string) ([]hashResult, error) {
+	local = filepath.Clean(local)
+	localResults, err := hashLocalPath(local, true)
+	if err != nil {
+		return nil, err
+	}
+
+	root := remote
+	if root == "" {
+		root = "/"
+	}
+	entries, err := listFolderRecursive(dbx, remote)
+	if err != nil {
+		return nil, err
+	}
+	remoteFiles := make(map[string]*files.FileMetadata)
+	for _, entry := range entries {
+		file, ok := entry.(*files.FileMetadata)
+		if !ok {
+			continue
+		}
+		rel, err := relativeTo(remote, file.PathDisplay)
+		if err != nil {
+			return nil, err
+		}
+		remoteFiles[strings.ToLower(rel)] = file
+	}
+
+	var results []hashResult
+	for _, result := range localResults {
+		rel, err := filepath.Rel(local, result.input.Path)
+		if err != nil {
+			return nil, err
+		}
+		rel = filepath.ToSlash(rel)
+		result.input.Remote = path.Join(root, rel)
+		file, ok := remoteFiles[strings.ToLower(rel)]
+		if !ok {
+			result.check = hashCheckMissingRemote
+		} else {
+			result.input.Remote = file.PathDisplay
+			result.check = hashCheckOutcome(result, file)
+			delete(remoteFiles, strings.ToLower(rel))
+		}
+		results = append(results, result)
+	}
+	for rel, file := range remoteFiles {
+		results = append(results, hashResult{
+			input: hashResultInput{Path: filepath.Join(local, filepath.FromSlash(rel)), Remote: file.PathDisplay},
+			check: hashCheckMissingLocal,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return strings.ToLower(results[i].input.Remote) < strings.ToLower(results[j].input.Remote)
+	})
+	return results, nil
+}
+
+func hashCheckOutcome(result hashResult, file *files.FileMetadata) string {
+	if file.ContentHash == "" || file.ContentHash != result.contentHash {
+		return hashCheckFailed
+	}
+	return hashCheckOK
+}
+
+func hashOperationResults(status string, results []hashResult) []jsonOperationResult {
+	operationResults := make([]jsonOperationResult, 0, len(results))
+	for _, result := range results {
+		operationResults = append(operationResults, newJSONOperationResult(status, hashKindFile, result.input, hashJSON{
+			ContentHash: result.contentHash,
+			Size:        result.size,
+		}))
+	}
+	return operationResults
+}
+
+var hashCmd = &cobra.Command{
+	Use:   "hash [flags] <file>...",
+	Short: "Compute Dropbox content hashes of local files",
+	Long: `Compute the Dropbox content_hash of local files.
+  - The hash uses the same algorithm as the content_hash field of Dropbox
+    file metadata (ls -l --output=json), so local and remote copies can be
+    compared without downloading anything.
+  - Use --recursive (-r) to hash every file in a directory.
+  - Use --check <local> <remote> to compare a local file with a Dropbox file,
+    or with --recursive a local directory with a Dropbox folder. Every file
+    is reported; any mismatch, or a file present on only one side, makes the
+    command fail with hash_mismatch. In JSON output the error details list
+    every failing file with its outcome: mismatched, missing_remote, or
+    missing_local.
+  - Without --check, hash reads only local files and needs no login.
+`,
+	Example: `  dbxcli hash report.pdf
+  dbxcli hash -r ./photos
+  dbxcli hash --check report.pdf /Reports/report.pdf
+  dbxcli hash --check -r ./photos /Backups/photos`,
+	RunE: hash,
+}
+
+func init() {
+	RootCmd.AddCommand(hashCmd)
+	hashCmd.Flags().BoolP("recursive", "r", false, "Hash every file in a directory")
+	hashCmd.Flags().Bool("check", false, "Compare a local file or directory with its Dropbox copy")
+	enableStructuredOutput(hashCmd)
+}
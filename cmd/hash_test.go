@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+type hashOutput struct {
+	Input   hashInput `json:"input"`
+	Results []struct {
+		Status string          `json:"status"`
+		Kind   string          `json:"kind"`
+		Input  hashResultInput `json:"input"`
+		Result hashJSON        `json:"result"`
+	} `json:"results"`
+}
+
+func TestHashJSONOutputsContentHashes(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "report.pdf")
+	content := bytes.Repeat([]byte("report"), 1<<20)
+	if err := os.WriteFile(src, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	want, err := contenthash.Compute(bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, stdout := testHashCmd(map[string]string{outputFlag: "json"})
+	if err := hash(cmd, []string{src}); err != nil {
+		t.Fatalf("hash error: %v", err)
+	}
+
+	got := decodeHashOutput(t, stdout)
+	if len(got.Input.Paths) != 1 || got.Input.Check || len(got.Results) != 1 {
+		t.Fatalf("output = %#v, want one hashed file", got)
+	}
+	result := got.Results[0]
+	if result.Status != hashStatusHashed || result.Kind != hashKindFile || result.Input.Path != src {
+		t.Fatalf("result = %#v", result)
+	}
+	if result.Result.ContentHash != want || result.Result.Size != int64(len(content)) {
+		t.Fatalf("hash = %#v, want %s and size %d", result.Result, want, len(content))
+	}
+}
+
+func TestHashTextPrintsHashAndPath(t *testing.T) {
+	dir := t.TempDir()
+	writeHashTestFiles(t, dir, map[string]string{"a.txt": "a", "sub/b.txt": "b"})
+
+	cmd, stdout := testHashCmd(map[string]string{"recursive": "true"})
+	if err := hash(cmd, []string{dir}); err != nil {
+		t.Fatalf("hash error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("stdout = %q, want two lines", stdout.String())
+	}
+	wantA, _ := contenthash.Compute(strings.NewReader("a"))
+	if lines[0] != wantA+"  "+filepath.Join(dir, "a.txt") {
+		t.Fatalf("first line = %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "  "+filepath.Join(dir, "sub", "b.txt")) {
+		t.Fatalf("second line = %q", lines[1])
+	}
+}
+
+func TestHashDirectoryRequiresRecursive(t *testing.T) {
+	cmd, _ := testHashCmd(nil)
+	err := hash(cmd, []string{t.TempDir()})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments", err)
+	}
+	if !strings.Contains(err.Error(), "--recursive") {
+		t.Fatalf("err = %v, want --recursive hint", err)
+	}
+}
+
+func TestHashCheckRecursiveJSONOutputsMatches(t *testing.T) {
+	dir := t.TempDir()
+	writeHashTestFiles(t, dir, map[string]string{"a.txt": "alpha", "sub/b.txt": "beta"})
+
+	var downloads int
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if arg.Path != "/Backups/dir" || !arg.Recursive {
+				t.Fatalf("list folder arg = %#v", arg)
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				&files.FolderMetadata{Metadata: files.Metadata{PathDisplay: "/Backups/Dir/Sub"}},
+				hashTestRemoteFile("/Backups/Dir/A.txt", "alpha"),
+				hashTestRemoteFile("/Backups/Dir/Sub/b.txt", "beta"),
+			}}, nil
+		},
+		downloadFn: func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			downloads++
+			return nil, nil, nil
+		},
+	})
+
+	cmd, stdout := testHashCmd(map[string]string{outputFlag: "json", "recursive": "true", "check": "true"})
+	if err := hash(cmd, []string{dir, "/Backups/dir"}); err != nil {
+		t.Fatalf("hash --check error: %v", err)
+	}
+	if downloads != 0 {
+		t.Fatalf("downloads = %d, want none", downloads)
+	}
+
+	got := decodeHashOutput(t, stdout)
+	if !got.Input.Check || got.Input.Remote != "/Backups/dir" || len(got.Results) != 2 {
+		t.Fatalf("output = %#v, want two matched files", got)
+	}
+	for _, result := range got.Results {
+		if result.Status != hashStatusMatched || result.Result.ContentHash == "" {
+			t.Fatalf("result = %#v, want matched", result)
+		}
+	}
+	if got.Results[0].Input.Remote != "/Backups/Dir/A.txt" || got.Results[1].Input.Remote != "/Backups/Dir/Sub/b.txt" {
+		t.Fatalf("remote paths = %q, %q", got.Results[0].Input.Remote, got.Results[1].Input.Remote)
+	}
+}
+
+func TestHashCheckReportsMismatchedAndMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeHashTestFiles(t, dir, map[string]string{"a.txt": "alpha", "b.txt": "local", "c.txt": "only local"})
+
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				hashTestRemoteFile("/dir/a.txt", "alpha"),
+				hashTestRemoteFile("/dir/b.txt", "remote"),
+				hashTestRemoteFile("/dir/d.txt", "only remote"),
+			}}, nil
+		},
+	})
+
+	cmd, stdout := testHashCmd(map[string]string{"recursive": "true", "check": "true"})
+	err := hash(cmd, []string{dir, "/dir"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeHashMismatch {
+		t.Fatalf("err = %v, want hash_mismatch", err)
+	}
+	if !strings.Contains(err.Error(), "3 of 4") {
+		t.Fatalf("err = %v, want failure count", err)
+	}
+	if got := exitCodeForError(err); got != exitCodeGenericError {
+		t.Fatalf("exit code = %d, want %d", got, exitCodeGenericError)
+	}
+
+	want := filepath.Join(dir, "a.txt") + ": OK\n" +
+		filepath.Join(dir, "b.txt") + ": FAILED\n" +
+		filepath.Join(dir, "c.txt") + ": MISSING IN DROPBOX\n" +
+		"/dir/d.txt: MISSING LOCALLY\n"
+	if stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestHashCheckJSONErrorListsEveryFailure(t *testing.T) {
+	dir := t.TempDir()
+	writeHashTestFiles(t, dir, map[string]string{"a.txt": "alpha", "b.txt": "local", "c.txt": "only local"})
+
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				hashTestRemoteFile("/dir/a.txt", "alpha"),
+				hashTestRemoteFile("/dir/b.txt", "remote"),
+				hashTestRemoteFile("/dir/d.txt", "only remote"),
+			}}, nil
+		},
+	})
+
+	cmd, stdout := testHashCmd(map[string]string{"recursive": "true", "check": "true", outputFlag: "json"})
+	err := hash(cmd, []string{dir, "/dir"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeHashMismatch {
+		t.Fatalf("err = %v, want hash_mismatch", err)
+	}
+	if stdout.Len() != 0 {
+		t.Fatalf("stdout = %q, want no text results in JSON mode", stdout.String())
+	}
+
+	failures, ok := jsonErrorDetails(err)["failures"].([]map[string]any)
+	if !ok || len(failures) != 3 {
+		t.Fatalf("failures = %#v, want three failures", jsonErrorDetails(err)["failures"])
+	}
+	want := []map[string]any{
+		{"local_path": filepath.Join(dir, "b.txt"), "remote_path": "/dir/b.txt", "outcome": "mismatched"},
+		{"local_path": filepath.Join(dir, "c.txt"), "remote_path": "/dir/c.txt", "outcome": "missing_remote"},
+		{"local_path": filepath.Join(dir, "d.txt"), "remote_path": "/dir/d.txt", "outcome": "missing_local"},
+	}
+	for i, failure := range failures {
+		for key, value := range want[i] {
+			if failure[key] != value {
+				t.Fatalf("failures[%d][%s] = %v, want %v", i, key, failure[key], value)
+			}
+		}
+	}
+}
+
+func TestHashCheckFileMissingInDropbox(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(src, []byte("report"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return nil, getMetadataNotFoundError()
+		},
+	})
+
+	cmd, _ := testHashCmd(map[string]string{"check": "true"})
+	err := hash(cmd, []string{src, "/report.pdf"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeHashMismatch {
+		t.Fatalf("err = %v, want hash_mismatch", err)
+	}
+}
+
+func testHashCmd(flags map[string]string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "hash"}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().BoolP("recursive", "r", false, "")
+	cmd.Flags().Bool("check", false, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			panic(err)
+		}
+	}
+	return cmd, &stdout
+}
+
+func writeHashTestFiles(t *testing.T, dir string, contents map[string]string) {
+	t.Helper()
+	for name, content := range contents {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(target, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func hashTestRemoteFile(path, content string) *files.FileMetadata {
+	file := getTestFileMetadata(path, uint64(len(content)))
+	file.ContentHash, _ = contenthash.Compute(strings.NewReader(content))
+	return file
+}
+
+func decodeHashOutput(t *testing.T, stdout *bytes.Buffer) hashOutput {
+	t.Helper()
+	var got hashOutput
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	return got
+}
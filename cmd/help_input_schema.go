@@ -71,7 +71,11 @@ func commandInputSchemaFor(args []jsonCommandArg, flags []jsonCommandFlag) jsonC
 		if flag.Sensitive {
 			property.WriteOnly = true
 		}
-		if value, ok := commandInputFlagDefault(flag, propertyType); ok {
+		if commandInputFlagRepeatable(flag.Type) {
+			property.Type = "array"
+			property.Items = &jsonCommandInputProperty{Type: propertyType}
+			property.Format = ""
+		} else if value, ok := commandInputFlagDefault(flag, propertyType); ok {
 			property.Default = value
 		}
 		schema.Properties[name] = property
@@ -133,7 +137,7 @@ func commandInputPropertyType(valueKind string, flagType string) string {
 	case "bytes", "integer":
 		return "integer"
 	case "enum", "string", "dropbox_path", "local_path", "dropbox_member_id",
-		"dropbox_app_key", "local_file", "secret", "rfc3339_timestamp",
+		"dropbox_app_key", "local_file", "secret", "rfc3339_timestamp", "duration",
 		"url", "email", "account_id", "auth_type", "command_path", "revision":
 		return "string"
 	}
@@ -148,6 +152,11 @@ func commandInputPropertyType(valueKind string, flagType string) string {
 	}
 }
 
+// commandInputFlagRepeatable reports whether a flag may be given more than once.
+func commandInputFlagRepeatable(flagType string) bool {
+	return flagType == "stringArray" || flagType == "stringSlice"
+}
+
 func commandInputPropertyFormat(valueKind string) string {
 	switch valueKind {
 	case "email":
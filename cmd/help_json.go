@@ -49,6 +49,7 @@ type jsonCommandManifest struct {
 	ResultKinds              []string               `json:"result_kinds"`
 	WarningCodes             []string               `json:"warning_codes"`
 	MayPrompt                bool                   `json:"may_prompt"`
+	SensitiveOutput          bool                   `json:"sensitive_output"`
 	InputSchema              jsonCommandInputSchema `json:"input_schema"`
 }
 
@@ -402,6 +403,7 @@ func jsonCommandManifestFor(cmd *cobra.Command) jsonCommandManifest {
 		ResultKinds:              sortedCopyStringSlice(meta.ResultKinds),
 		WarningCodes:             sortedCopyStringSlice(meta.WarningCodes),
 		MayPrompt:                meta.MayPrompt,
+		SensitiveOutput:          meta.SensitiveOutput,
 		InputSchema:              commandInputSchemaFor(args, commandInputSchemaFlags(cmd, flags)),
 	}
 }
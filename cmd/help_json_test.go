@@ -152,19 +152,58 @@ func TestJSONHelpRealRootManifestIncludesPublicCommands(t *testing.T) {
 		"completion zsh",
 		"cp",
 		"du",
+		"file-request",
+		"file-request close",
+		"file-request create",
+		"file-request delete",
+		"file-request get",
+		"file-request list",
+		"file-request update",
 		"get",
+		"hash",
 		"help",
+		"lock",
+		"lock status",
 		"login",
 		"logout",
 		"ls",
 		"mkdir",
 		"mv",
+		"preview",
+		"props",
+		"props get",
+		"props remove",
+		"props set",
+		"props templates",
+		"props templates add",
+		"props templates get",
+		"props templates list",
+		"props update",
 		"put",
 		"restore",
 		"revs",
 		"rm",
+		"save-url",
+		"save-url status",
 		"search",
 		"share",
+		"share file",
+		"share file invite",
+		"share file members",
+		"share file remove",
+		"share file unshare",
+		"share folder",
+		"share folder create",
+		"share folder invite",
+		"share folder leave",
+		"share folder list",
+		"share folder members",
+		"share folder mount",
+		"share folder remove",
+		"share folder set-access",
+		"share folder unmount",
+		"share folder unshare",
+		"share folder update-policy",
 		"share list",
 		"share list folder",
 		"share list link",
@@ -173,14 +212,27 @@ func TestJSONHelpRealRootManifestIncludesPublicCommands(t *testing.T) {
 		"share-link download",
 		"share-link info",
 		"share-link list",
+		"share-link ls",
 		"share-link revoke",
 		"share-link update",
+		"tag",
+		"tag add",
+		"tag list",
+		"tag remove",
 		"team",
 		"team add-member",
 		"team info",
 		"team list-groups",
 		"team list-members",
 		"team remove-member",
+		"team share-links",
+		"team share-links audit",
+		"temp-link",
+		"temp-link get",
+		"temp-link upload",
+		"thumbnail",
+		"undelete",
+		"unlock",
 	}
 	if !reflect.DeepEqual(got, want) {
 		t.Fatalf("real root manifest paths = %v, want %v", got, want)
@@ -284,7 +336,7 @@ func TestJSONHelpManifestV1MachineFields(t *testing.T) {
 	if !put.StdinStdout.ReadsStdin || put.StdinStdout.WritesBinaryStdout {
 		t.Fatalf("put stdin_stdout = %+v, want stdin only", put.StdinStdout)
 	}
-	assertStringSliceEqual(t, "put warning codes", put.WarningCodes, []string{jsonWarningCodeSkippedSymlink})
+	assertStringSliceEqual(t, "put warning codes", put.WarningCodes, []string{jsonWarningCodeSkippedLink, jsonWarningCodeSkippedSymlink, jsonWarningCodeSkippedUnsupportedEntry, jsonWarningCodeUploadFailed})
 	assertStringSliceEqual(t, "put result statuses", put.ResultStatuses, []string{"autorenamed", "created", "existing", "skipped", "uploaded", jsonStatusPlanned})
 	assertStringSliceEqual(t, "put result kinds", put.ResultKinds, []string{"file", "folder"})
 	assertStringSliceEqual(t, "put scopes", put.DropboxScopes, []string{"files.content.write", "files.metadata.read"})
@@ -393,6 +445,14 @@ func TestJSONHelpManifestV1SelectedCommandMetadata(t *testing.T) {
 	if !login.MayPrompt {
 		t.Fatal("login may_prompt = false, want true")
 	}
+	if login.SensitiveOutput {
+		t.Fatal("login sensitive_output = true, want false")
+	}
+	for _, cmd := range []*cobra.Command{tempLinkGetCmd, tempLinkUploadCmd} {
+		if manifest := jsonCommandManifestFor(cmd); !manifest.SensitiveOutput {
+			t.Fatalf("%s sensitive_output = false, want true", manifest.Path)
+		}
+	}
 	tokenType := jsonHelpArgByName(t, login.Args, "token-type")
 	assertStringSliceEqual(t, "login token-type enum", tokenType.EnumValues, []string{"personal", "team-access", "team-manage"})
 	tokenTypeSchema := assertJSONHelpInputProperty(t, login.InputSchema, "token_type", "string", "arg", "token-type", "auth_type")
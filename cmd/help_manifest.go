@@ -25,7 +25,10 @@ type jsonCommandManifestMetadata struct {
 	ResultKinds    []string
 	WarningCodes   []string
 	MayPrompt      bool
-	Known          bool
+	// SensitiveOutput marks commands whose results are credentials, such as
+	// temporary links, that wrappers should not log.
+	SensitiveOutput bool
+	Known           bool
 }
 
 type jsonCommandFlagMetadata struct {
@@ -72,6 +75,12 @@ var sharedLinkPasswordFlagMetadata = map[string]jsonCommandFlagMetadata{
 	"password-prompt": {Conflicts: []string{"password", "password-file"}, MayPrompt: true, ValueKind: "boolean"},
 }
 
+var sharedLinkQRFlagMetadata = map[string]jsonCommandFlagMetadata{
+	"qr":     {ValueKind: "boolean"},
+	"qr-png": {ValueKind: "local_file"},
+	"qr-svg": {ValueKind: "local_file"},
+}
+
 var sharedLinkSettingsFlagMetadata = map[string]jsonCommandFlagMetadata{
 	"allow-download":    {Conflicts: []string{"disallow-download"}, ValueKind: "boolean"},
 	"audience":          {EnumValues: []string{"public", "team", "members", "no-one"}, ValueKind: "enum"},
@@ -80,6 +89,11 @@ var sharedLinkSettingsFlagMetadata = map[string]jsonCommandFlagMetadata{
 	"remove-expiration": {Conflicts: []string{"expires"}, ValueKind: "boolean"},
 }
 
+var fileRequestDeadlineFlagMetadata = map[string]jsonCommandFlagMetadata{
+	"deadline": {ValueKind: "rfc3339_timestamp"},
+	"grace":    {EnumValues: fileRequestGracePeriods, ValueKind: "enum"},
+}
+
 var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 	"account": {
 		Args:          []jsonCommandArg{commandArg("account-id", false, false, "account_id", "Dropbox account ID to look up")},
@@ -122,6 +136,69 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"account_info.read"},
 		Known:         true,
 	},
+	"file-request close": {
+		Args:          []jsonCommandArg{commandArg("id", true, true, "string", "File request ID")},
+		Examples:      []jsonCommandExample{{Description: "Close a file request", Command: "dbxcli file-request close oaCAVmEyrqYnkZX9955Y"}},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"file_requests.write"},
+		Known:         true,
+	},
+	"file-request create": {
+		Args: []jsonCommandArg{commandArg("destination", true, false, "dropbox_path", "Dropbox folder that receives uploads")},
+		Examples: []jsonCommandExample{
+			{Description: "Create a file request", Command: `dbxcli file-request create /Vendors/Acme --title "Acme deliverables"`},
+			{Description: "Create a file request with a deadline and grace period", Command: `dbxcli file-request create /Vendors/Acme --title "Q3 report" --deadline 2026-07-01T17:00:00Z --grace seven-days`},
+		},
+		Flags: mergeCommandFlagMetadata(fileRequestDeadlineFlagMetadata, map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"closed":       {ValueKind: "boolean"},
+			"description":  {ValueKind: "string"},
+			"title":        {Required: true, ValueKind: "string"},
+		}),
+		DropboxScopes: []string{"file_requests.write"},
+		Known:         true,
+	},
+	"file-request delete": {
+		Args: []jsonCommandArg{commandArg("id", false, true, "string", "File request ID; omit when using --all-closed")},
+		Examples: []jsonCommandExample{
+			{Description: "Delete a closed file request", Command: "dbxcli file-request delete oaCAVmEyrqYnkZX9955Y"},
+			{Description: "Preview deleting every closed file request", Command: "dbxcli file-request delete --all-closed --dry-run"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}, "all-closed": {ValueKind: "boolean"}},
+		DropboxScopes: []string{"file_requests.write", "file_requests.read"},
+		Known:         true,
+	},
+	"file-request get": {
+		Args:          []jsonCommandArg{commandArg("id", true, false, "string", "File request ID")},
+		Examples:      []jsonCommandExample{{Description: "Show a file request", Command: "dbxcli file-request get oaCAVmEyrqYnkZX9955Y"}},
+		DropboxScopes: []string{"file_requests.read"},
+		Known:         true,
+	},
+	"file-request list": {
+		Examples:      []jsonCommandExample{{Description: "List file requests", Command: "dbxcli file-request list"}},
+		Flags:         map[string]jsonCommandFlagMetadata{"limit": {ValueKind: "integer"}},
+		DropboxScopes: []string{"file_requests.read"},
+		Known:         true,
+	},
+	"file-request update": {
+		Args: []jsonCommandArg{commandArg("id", true, false, "string", "File request ID")},
+		Examples: []jsonCommandExample{
+			{Description: "Rename a file request", Command: `dbxcli file-request update oaCAVmEyrqYnkZX9955Y --title "Acme final deliverables"`},
+			{Description: "Remove the deadline and reopen a file request", Command: "dbxcli file-request update oaCAVmEyrqYnkZX9955Y --remove-deadline --open"},
+		},
+		Flags: mergeCommandFlagMetadata(fileRequestDeadlineFlagMetadata, map[string]jsonCommandFlagMetadata{
+			dryRunFlagName:    {ValueKind: "boolean"},
+			"close":           {Conflicts: []string{"open"}, ValueKind: "boolean"},
+			"deadline":        {Conflicts: []string{"remove-deadline"}, ValueKind: "rfc3339_timestamp"},
+			"description":     {ValueKind: "string"},
+			"destination":     {ValueKind: "dropbox_path"},
+			"open":            {Conflicts: []string{"close"}, ValueKind: "boolean"},
+			"remove-deadline": {Conflicts: []string{"deadline"}, ValueKind: "boolean"},
+			"title":           {ValueKind: "string"},
+		}),
+		DropboxScopes: []string{"file_requests.write", "file_requests.read"},
+		Known:         true,
+	},
 	"get": {
 		Args: []jsonCommandArg{
 			commandArg("source", true, false, "dropbox_path", "Dropbox path, file ID, revision, or namespace-relative path"),
@@ -130,12 +207,36 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		Examples: []jsonCommandExample{
 			{Description: "Download a file", Command: "dbxcli get /remote.txt ./remote.txt"},
 			{Description: "Download a file revision", Command: "dbxcli get rev:a1c10ce0dd78 ./historical.txt"},
+			{Description: "Export a Paper doc as HTML", Command: "dbxcli get --export-format html /Notes/plan.paper ./plan.html"},
+			{Description: "Download a folder as one zip archive", Command: "dbxcli get --zip /Photos/2024 ./photos-2024.zip"},
+			{Description: "Stream a folder to stdout as a tar archive", Command: "dbxcli get -r /Projects/site - | tar x -C ./site"},
+			{Description: "Download and decrypt a file uploaded with put --encrypt", Command: "dbxcli get --decrypt --passphrase-file ~/.dbxcli-key /Private/taxes.pdf.dbxenc"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"decrypt":         {Conflicts: []string{"export-format", "zip"}, ValueKind: "boolean"},
+			"export-format":   {Conflicts: []string{"decrypt", "zip"}, ValueKind: "string"},
+			"identity":        {ValueKind: "local_file"},
+			"passphrase-file": {ValueKind: "local_file"},
+			"recursive":       {Conflicts: []string{"zip"}, ValueKind: "boolean"},
+			"zip":             {Conflicts: []string{"decrypt", "export-format", "recursive"}, ValueKind: "boolean"},
 		},
-		Flags:         map[string]jsonCommandFlagMetadata{"recursive": {ValueKind: "boolean"}},
 		DropboxScopes: []string{"files.content.read", "files.metadata.read"},
 		StdinStdout:   jsonCommandStdinStdout{WritesBinaryStdout: true},
 		Known:         true,
 	},
+	"hash": {
+		Args: []jsonCommandArg{commandArg("file", true, true, "local_path", "Local files or directories to hash; with --check, a local path and a Dropbox path")},
+		Examples: []jsonCommandExample{
+			{Description: "Print the Dropbox content hash of a local file", Command: "dbxcli hash report.pdf"},
+			{Description: "Check a local folder against its Dropbox copy", Command: "dbxcli hash --check -r ./photos /Backups/photos"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"check":     {ValueKind: "boolean"},
+			"recursive": {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"files.metadata.read"},
+		Known:         true,
+	},
 	"help": {
 		Args:     []jsonCommandArg{commandArg("command", false, true, "command_path", "Command path to describe")},
 		Examples: []jsonCommandExample{{Description: "Describe a command as JSON", Command: "dbxcli --output=json help put"}},
@@ -148,16 +249,35 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		MayPrompt: true,
 		Known:     true,
 	},
+	"lock": {
+		Args: []jsonCommandArg{commandArg("path", true, true, "dropbox_path", "Dropbox file to lock")},
+		Examples: []jsonCommandExample{
+			{Description: "Lock a file for editing", Command: "dbxcli lock /Design/logo.psd"},
+			{Description: "Lock several files at once", Command: "dbxcli lock /Design/logo.psd /Design/banner.psd"},
+		},
+		DropboxScopes: []string{"files.content.write"},
+		Known:         true,
+	},
+	"lock status": {
+		Args:          []jsonCommandArg{commandArg("path", true, true, "dropbox_path", "Dropbox file to inspect")},
+		Examples:      []jsonCommandExample{{Description: "Show who holds a file lock", Command: "dbxcli lock status /Design/logo.psd"}},
+		DropboxScopes: []string{"files.content.read"},
+		Known:         true,
+	},
 	"logout": {
 		Examples: []jsonCommandExample{{Description: "Log out and remove saved credentials", Command: "dbxcli logout"}},
 		Known:    true,
 	},
 	"ls": {
-		Args:     []jsonCommandArg{commandArg("path", false, false, "dropbox_path", "Dropbox folder or file path")},
-		Examples: []jsonCommandExample{{Description: "List the root folder", Command: "dbxcli ls /"}},
+		Args: []jsonCommandArg{commandArg("path", false, false, "dropbox_path", "Dropbox folder or file path")},
+		Examples: []jsonCommandExample{
+			{Description: "List the root folder", Command: "dbxcli ls /"},
+			{Description: "Show property template fields as columns", Command: "dbxcli ls -l --props Retention /Contracts"},
+		},
 		Flags: mergeCommandFlagMetadata(commonListFlagMetadata, map[string]jsonCommandFlagMetadata{
 			"include-deleted": {ValueKind: "boolean"},
 			"only-deleted":    {ValueKind: "boolean"},
+			"props":           {ValueKind: "string"},
 			"recursive":       {ValueKind: "boolean"},
 			"recurse":         {ValueKind: "boolean"},
 		}),
@@ -181,6 +301,94 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"files.content.write", "files.metadata.read"},
 		Known:         true,
 	},
+	"preview": {
+		Args: []jsonCommandArg{
+			commandArg("source", true, false, "dropbox_path", "Dropbox path, file ID, revision, or namespace-relative path"),
+			streamCommandArg("target", false, false, "local_path", "Local file or folder, or - for stdout"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Download a PDF preview of a document", Command: "dbxcli preview /Reports/Q3.docx"},
+			{Description: "Write a preview to stdout", Command: "dbxcli preview /Reports/Q3.pptx - > Q3.pdf"},
+		},
+		DropboxScopes: []string{"files.content.read"},
+		StdinStdout:   jsonCommandStdinStdout{WritesBinaryStdout: true},
+		Known:         true,
+	},
+	"props get": {
+		Args: []jsonCommandArg{commandArg("path", true, false, "dropbox_path", "Dropbox file or folder")},
+		Examples: []jsonCommandExample{
+			{Description: "Show property groups for all of your templates", Command: "dbxcli props get /Contracts/acme.pdf"},
+			{Description: "Show one template's properties", Command: "dbxcli props get /Contracts/acme.pdf --template Retention"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{"template": {ValueKind: "string"}},
+		DropboxScopes: []string{"files.metadata.read"},
+		Known:         true,
+	},
+	"props remove": {
+		Args: []jsonCommandArg{
+			commandArg("path", true, false, "dropbox_path", "Dropbox file or folder"),
+			commandArg("template", true, false, "string", "Property template ID or name"),
+		},
+		Examples:      []jsonCommandExample{{Description: "Remove a property group", Command: "dbxcli props remove /Contracts/acme.pdf Retention"}},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
+	"props set": {
+		Args: []jsonCommandArg{
+			commandArg("path", true, false, "dropbox_path", "Dropbox file or folder"),
+			commandArg("template", true, false, "string", "Property template ID or name"),
+			commandArg("field=value", true, true, "string", "Property field assignments"),
+		},
+		Examples:      []jsonCommandExample{{Description: "Set a property group", Command: "dbxcli props set /Contracts/acme.pdf Retention project=P-12 class=7y"}},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
+	"props templates add": {
+		Args: []jsonCommandArg{commandArg("name", true, false, "string", "Template name")},
+		Examples: []jsonCommandExample{
+			{Description: "Add a user template", Command: "dbxcli props templates add Retention --field project --field \"class:Retention class\""},
+			{Description: "Add a team template", Command: "dbxcli props templates add Retention --team --field class"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"description": {ValueKind: "string"},
+			"field":       {Required: true, ValueKind: "string"},
+			"team":        {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
+	"props templates get": {
+		Args:          []jsonCommandArg{commandArg("template-id", true, false, "string", "Property template ID")},
+		Examples:      []jsonCommandExample{{Description: "Show a template", Command: "dbxcli props templates get ptid:1a5n2i6d3OYEAAAAAAAAAYa"}},
+		Flags:         map[string]jsonCommandFlagMetadata{"team": {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.read"},
+		Known:         true,
+	},
+	"props templates list": {
+		Examples: []jsonCommandExample{
+			{Description: "List your templates", Command: "dbxcli props templates list"},
+			{Description: "List team templates", Command: "dbxcli props templates list --team"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{"team": {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.read"},
+		Known:         true,
+	},
+	"props update": {
+		Args: []jsonCommandArg{
+			commandArg("path", true, false, "dropbox_path", "Dropbox file or folder"),
+			commandArg("template", true, false, "string", "Property template ID or name"),
+			commandArg("field=value", false, true, "string", "Property fields to add or change"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Change one field", Command: "dbxcli props update /Contracts/acme.pdf Retention class=10y"},
+			{Description: "Remove one field", Command: "dbxcli props update /Contracts/acme.pdf Retention --remove-field project"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}, "remove-field": {ValueKind: "string"}},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
 	"put": {
 		Args: []jsonCommandArg{
 			streamCommandArg("source", true, false, "local_path", "Local source path, or - for stdin"),
@@ -189,14 +397,23 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		Examples: []jsonCommandExample{
 			{Description: "Upload a file", Command: "dbxcli put file.txt /destination/file.txt"},
 			{Description: "Upload from stdin", Command: "printf 'hello' | dbxcli put - /hello.txt"},
+			{Description: "Upload each entry of a tar stream from stdin", Command: "tar c -C ./site . | dbxcli put --untar - /Projects/site"},
+			{Description: "Replace a file only if it is still at a known revision", Command: "dbxcli put --if-rev 015f3a2b1c0d0000000 config.yaml /config.yaml"},
+			{Description: "Encrypt a file with a passphrase before upload", Command: "dbxcli put --encrypt --passphrase-file ~/.dbxcli-key taxes.pdf /Private/"},
 		},
 		Flags: map[string]jsonCommandFlagMetadata{
-			"chunksize":    {ValueKind: "bytes"},
-			"debug":        {ValueKind: "boolean"},
-			dryRunFlagName: {ValueKind: "boolean"},
-			"if-exists":    {EnumValues: []string{"overwrite", "skip", "fail", "autorename"}, ValueKind: "enum"},
-			"recursive":    {ValueKind: "boolean"},
-			"workers":      {ValueKind: "integer"},
+			"chunksize":          {ValueKind: "bytes"},
+			"debug":              {ValueKind: "boolean"},
+			dryRunFlagName:       {ValueKind: "boolean"},
+			"encrypt":            {ValueKind: "boolean", Conflicts: []string{"untar"}},
+			"if-exists":          {EnumValues: []string{"overwrite", "skip", "fail", "autorename"}, ValueKind: "enum", Conflicts: []string{"if-rev", "if-unchanged-since"}},
+			"if-rev":             {ValueKind: "revision", Conflicts: []string{"if-exists", "if-unchanged-since", "recursive", "untar"}},
+			"if-unchanged-since": {ValueKind: "rfc3339_timestamp", Conflicts: []string{"if-exists", "if-rev", "recursive", "untar"}},
+			"passphrase-file":    {ValueKind: "local_file", Conflicts: []string{"recipient"}},
+			"recipient":          {ValueKind: "local_file", Conflicts: []string{"passphrase-file"}},
+			"recursive":          {ValueKind: "boolean", Conflicts: []string{"if-rev", "if-unchanged-since", "untar"}},
+			"untar":              {ValueKind: "boolean", Conflicts: []string{"encrypt", "if-rev", "if-unchanged-since", "recursive"}},
+			"workers":            {ValueKind: "integer"},
 		},
 		DropboxScopes: []string{"files.content.write", "files.metadata.read"},
 		StdinStdout:   jsonCommandStdinStdout{ReadsStdin: true},
@@ -231,21 +448,242 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"files.content.write", "files.metadata.read"},
 		Known:         true,
 	},
+	"save-url": {
+		Args: []jsonCommandArg{
+			commandArg("url", true, false, "url", "HTTP or HTTPS URL for Dropbox to fetch"),
+			commandArg("path", true, false, "dropbox_path", "Dropbox file path to save to"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Save a URL into Dropbox and wait for it to finish", Command: "dbxcli save-url https://example.com/data.csv /Datasets/data.csv"},
+			{Description: "Start a save and print the async job ID", Command: "dbxcli save-url --no-wait https://example.com/big.tar.gz /Datasets/big.tar.gz"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"if-exists":    {EnumValues: []string{"fail", "skip", "autorename"}, ValueKind: "enum"},
+			"no-wait":      {Conflicts: []string{"wait"}, ValueKind: "boolean"},
+			"wait":         {Conflicts: []string{"no-wait"}, ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"files.content.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"save-url status": {
+		Args:          []jsonCommandArg{commandArg("async-job-id", true, false, "string", "Async job ID printed by save-url --no-wait")},
+		Examples:      []jsonCommandExample{{Description: "Check a save-url job", Command: "dbxcli save-url status PID_RUvpd3jhU7LYNXJkJ9bGnQ"}},
+		DropboxScopes: []string{"files.content.write"},
+		Known:         true,
+	},
 	"search": {
 		Args: []jsonCommandArg{
 			commandArg("query", true, false, "string", "Search query"),
 			commandArg("path-scope", false, false, "dropbox_path", "Dropbox path scope"),
 		},
-		Examples: []jsonCommandExample{{Description: "Search by filename", Command: "dbxcli search report /Reports"}},
+		Examples: []jsonCommandExample{
+			{Description: "Search by filename", Command: "dbxcli search report /Reports"},
+			{Description: "Search only files carrying a tag", Command: "dbxcli search logo /Design --tag approved"},
+		},
 		Flags: mergeCommandFlagMetadata(commonListFlagMetadata, map[string]jsonCommandFlagMetadata{
 			"content":  {ValueKind: "boolean"},
 			"order-by": {EnumValues: []string{"relevance", "modified"}, ValueKind: "enum"},
+			"tag":      {ValueKind: "string"},
 		}),
 		DropboxScopes: []string{"files.metadata.read", "files.content.read"},
 		Known:         true,
 	},
+	"share file invite": {
+		Args: []jsonCommandArg{
+			commandArg("file", true, false, "string", "Dropbox path or file ID"),
+			commandArg("member", true, true, "string", "Email address, account ID, team member ID, or group ID to invite"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Share a file with a user as a viewer", Command: "dbxcli share file invite /Contracts/acme.pdf alice@example.com"},
+			{Description: "Share a file by ID as an editor without notifying", Command: "dbxcli share file invite --access editor --quiet id:a4ayc_80_OEAAAAAAAAAXw legal@example.com"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"access":       {EnumValues: []string{"viewer", "editor", "viewer_no_comment"}, ValueKind: "enum"},
+			"message":      {ValueKind: "string"},
+			"quiet":        {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.write"},
+		Known:         true,
+	},
+	"share file members": {
+		Args: []jsonCommandArg{commandArg("file", true, true, "string", "Dropbox path or file ID")},
+		Examples: []jsonCommandExample{
+			{Description: "List who can access a file", Command: "dbxcli share file members /Contracts/acme.pdf"},
+			{Description: "Audit several files as JSON", Command: "dbxcli share file members /Contracts/acme.pdf /Contracts/globex.pdf --output=json"},
+		},
+		DropboxScopes: []string{"sharing.read"},
+		Known:         true,
+	},
+	"share file remove": {
+		Args: []jsonCommandArg{
+			commandArg("file", true, false, "string", "Dropbox path or file ID"),
+			commandArg("member", true, true, "string", "Email address, account ID, team member ID, or group ID to remove"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Remove a member from a file", Command: "dbxcli share file remove /Contracts/acme.pdf alice@example.com"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"sharing.write"},
+		Known:         true,
+	},
+	"share file unshare": {
+		Args: []jsonCommandArg{commandArg("file", true, false, "string", "Dropbox path or file ID")},
+		Examples: []jsonCommandExample{
+			{Description: "Remove all direct members of a file", Command: "dbxcli share file unshare /Contracts/acme.pdf"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"sharing.write"},
+		Known:         true,
+	},
+	"share folder create": {
+		Args: []jsonCommandArg{commandArg("path", true, false, "dropbox_path", "Dropbox folder path to share")},
+		Examples: []jsonCommandExample{
+			{Description: "Share a folder", Command: "dbxcli share folder create /Projects"},
+			{Description: "Share a folder that only the owner can manage", Command: "dbxcli share folder create --acl-update-policy owner --shared-link-policy members /Projects"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName:       {ValueKind: "boolean"},
+			"acl-update-policy":  {EnumValues: []string{"editors", "owner"}, ValueKind: "enum"},
+			"member-policy":      {EnumValues: []string{"anyone", "team"}, ValueKind: "enum"},
+			"shared-link-policy": {EnumValues: []string{"anyone", "members", "team"}, ValueKind: "enum"},
+			"viewer-info-policy": {EnumValues: []string{"disabled", "enabled"}, ValueKind: "enum"},
+		},
+		DropboxScopes: []string{"sharing.write"},
+		Known:         true,
+	},
+	"share folder invite": {
+		Args: []jsonCommandArg{
+			commandArg("folder", true, false, "string", "Dropbox path or shared folder ID"),
+			commandArg("member", true, true, "string", "Email address, account ID, team member ID, or group ID to invite"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Invite a user as a viewer", Command: "dbxcli share folder invite /Projects alice@example.com"},
+			{Description: "Invite a group as editors with a message", Command: `dbxcli share folder invite --access editor --message "Welcome aboard" /Projects g:1234567890abcdef`},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"access":       {EnumValues: []string{"viewer", "editor", "viewer_no_comment"}, ValueKind: "enum"},
+			"message":      {ValueKind: "string"},
+			"quiet":        {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder leave": {
+		Args: []jsonCommandArg{commandArg("folder", true, false, "string", "Dropbox path or shared folder ID")},
+		Examples: []jsonCommandExample{
+			{Description: "Leave a shared folder", Command: "dbxcli share folder leave /Projects"},
+			{Description: "Leave and keep a copy of the contents", Command: "dbxcli share folder leave --keep-copy 84528192421"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"keep-copy":    {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder list": {
+		Examples: []jsonCommandExample{
+			{Description: "List shared folders", Command: "dbxcli share folder list"},
+			{Description: "List pending invitations to mount", Command: "dbxcli share folder list --mountable"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"long":      {ValueKind: "boolean"},
+			"mountable": {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.read"},
+		Known:         true,
+	},
+	"share folder members": {
+		Args:          []jsonCommandArg{commandArg("folder", true, false, "string", "Dropbox path or shared folder ID")},
+		Examples:      []jsonCommandExample{{Description: "List the members of a shared folder", Command: "dbxcli share folder members /Projects"}},
+		DropboxScopes: []string{"sharing.read", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder mount": {
+		Args: []jsonCommandArg{commandArg("shared-folder-id", true, false, "string", "Shared folder ID from share folder list --mountable")},
+		Examples: []jsonCommandExample{
+			{Description: "Accept a shared folder invitation", Command: "dbxcli share folder mount 84528192421"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"sharing.write"},
+		Known:         true,
+	},
+	"share folder remove": {
+		Args: []jsonCommandArg{
+			commandArg("folder", true, false, "string", "Dropbox path or shared folder ID"),
+			commandArg("member", true, true, "string", "Email address, account ID, team member ID, or group ID to remove"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Remove a member from a shared folder", Command: "dbxcli share folder remove /Projects alice@example.com"},
+			{Description: "Preview removing a group", Command: "dbxcli share folder remove --dry-run /Projects g:1234567890abcdef"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"leave-a-copy": {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder set-access": {
+		Args: []jsonCommandArg{
+			commandArg("folder", true, false, "string", "Dropbox path or shared folder ID"),
+			commandArg("member", true, true, "string", "Email address, account ID, team member ID, or group ID to update"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Make a member an editor", Command: "dbxcli share folder set-access --access editor /Projects alice@example.com"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"access":       {EnumValues: []string{"viewer", "editor", "viewer_no_comment"}, ValueKind: "enum"},
+		},
+		DropboxScopes: []string{"sharing.write", "sharing.read", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder unmount": {
+		Args:          []jsonCommandArg{commandArg("folder", true, false, "string", "Dropbox path or shared folder ID")},
+		Examples:      []jsonCommandExample{{Description: "Unmount a shared folder", Command: "dbxcli share folder unmount /Projects"}},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"sharing.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder unshare": {
+		Args: []jsonCommandArg{commandArg("folder", true, false, "string", "Dropbox path or shared folder ID")},
+		Examples: []jsonCommandExample{
+			{Description: "Stop sharing a folder", Command: "dbxcli share folder unshare /Projects"},
+			{Description: "Stop sharing and let members keep a copy", Command: "dbxcli share folder unshare --leave-a-copy 84528192421"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"leave-a-copy": {ValueKind: "boolean"},
+		},
+		DropboxScopes: []string{"sharing.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"share folder update-policy": {
+		Args: []jsonCommandArg{commandArg("folder", true, false, "string", "Dropbox path or shared folder ID")},
+		Examples: []jsonCommandExample{
+			{Description: "Restrict membership to the team", Command: "dbxcli share folder update-policy --member-policy team /Projects"},
+			{Description: "Preview a policy change", Command: "dbxcli share folder update-policy --dry-run --viewer-info-policy disabled /Projects"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName:       {ValueKind: "boolean"},
+			"acl-update-policy":  {EnumValues: []string{"editors", "owner"}, ValueKind: "enum"},
+			"member-policy":      {EnumValues: []string{"anyone", "team"}, ValueKind: "enum"},
+			"shared-link-policy": {EnumValues: []string{"anyone", "members", "team"}, ValueKind: "enum"},
+			"viewer-info-policy": {EnumValues: []string{"disabled", "enabled"}, ValueKind: "enum"},
+		},
+		DropboxScopes: []string{"sharing.write", "sharing.read", "files.metadata.read"},
+		Known:         true,
+	},
 	"share list folder": {
-		Examples:      []jsonCommandExample{{Description: "List shared folders", Command: "dbxcli share list folder"}},
+		Examples: []jsonCommandExample{
+			{Description: "List shared folders", Command: "dbxcli share list folder"},
+			{Description: "List shared folders with their policies", Command: "dbxcli share list folder --long"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{"long": {ValueKind: "boolean"}},
 		DropboxScopes: []string{"sharing.read"},
 		Known:         true,
 	},
@@ -256,9 +694,19 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		Known:         true,
 	},
 	"share-link create": {
-		Args:          []jsonCommandArg{commandArg("path", true, false, "dropbox_path", "Dropbox path to share")},
-		Examples:      []jsonCommandExample{{Description: "Create a shared link", Command: "dbxcli share-link create /Reports/report.pdf"}},
-		Flags:         mergeCommandFlagMetadata(mergeCommandFlagMetadata(sharedLinkSettingsFlagMetadata, sharedLinkPasswordFlagMetadata), map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}, "access": {EnumValues: []string{"viewer", "editor", "max"}, ValueKind: "enum"}}),
+		Args: []jsonCommandArg{commandArg("path", false, false, "dropbox_path", "Dropbox path to share; omit when using --from-file")},
+		Examples: []jsonCommandExample{
+			{Description: "Create a shared link", Command: "dbxcli share-link create /Reports/report.pdf"},
+			{Description: "Create team links for every path in a manifest and save a CSV of URLs", Command: "dbxcli share-link create --from-file deliverables.txt --audience team --csv"},
+			{Description: "Create a shared link and save it as a QR code image", Command: "dbxcli share-link create /Events/flyer.pdf --qr-png flyer-qr.png"},
+		},
+		Flags: mergeCommandFlagMetadata(mergeCommandFlagMetadata(mergeCommandFlagMetadata(sharedLinkSettingsFlagMetadata, sharedLinkPasswordFlagMetadata), sharedLinkQRFlagMetadata), map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"access":       {EnumValues: []string{"viewer", "editor", "max"}, ValueKind: "enum"},
+			"csv":          {ValueKind: "boolean"},
+			"from-file":    {ValueKind: "local_file"},
+			"workers":      {ValueKind: "integer"},
+		}),
 		DropboxScopes: []string{"sharing.write", "sharing.read"},
 		Known:         true,
 	},
@@ -267,10 +715,14 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 			commandArg("url", true, false, "url", "Shared link URL"),
 			streamCommandArg("target", false, false, "local_path", "Local destination path, or - for stdout"),
 		},
-		Examples: []jsonCommandExample{{Description: "Download a shared link", Command: "dbxcli share-link download https://www.dropbox.com/s/example/file.txt"}},
+		Examples: []jsonCommandExample{
+			{Description: "Download a shared link", Command: "dbxcli share-link download https://www.dropbox.com/s/example/file.txt"},
+			{Description: "Resume an interrupted folder download", Command: "dbxcli share-link download -r --if-exists skip https://www.dropbox.com/s/example/folder"},
+		},
 		Flags: mergeCommandFlagMetadata(sharedLinkPasswordFlagMetadata, map[string]jsonCommandFlagMetadata{
-			"path":      {ValueKind: "dropbox_path"},
-			"recursive": {ValueKind: "boolean"},
+			"if-exists": {EnumValues: []string{"overwrite", "skip", "fail"}, ValueKind: "enum", Conflicts: []string{"path"}},
+			"path":      {ValueKind: "dropbox_path", Conflicts: []string{"if-exists", "recursive"}},
+			"recursive": {ValueKind: "boolean", Conflicts: []string{"path"}},
 		}),
 		DropboxScopes: []string{"sharing.read", "files.content.read"},
 		StdinStdout:   jsonCommandStdinStdout{WritesBinaryStdout: true},
@@ -279,7 +731,7 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 	"share-link info": {
 		Args:          []jsonCommandArg{commandArg("url", true, false, "url", "Shared link URL")},
 		Examples:      []jsonCommandExample{{Description: "Display shared link metadata", Command: "dbxcli share-link info https://www.dropbox.com/s/example/file.txt"}},
-		Flags:         mergeCommandFlagMetadata(sharedLinkPasswordFlagMetadata, map[string]jsonCommandFlagMetadata{"path": {ValueKind: "dropbox_path"}}),
+		Flags:         mergeCommandFlagMetadata(mergeCommandFlagMetadata(sharedLinkPasswordFlagMetadata, sharedLinkQRFlagMetadata), map[string]jsonCommandFlagMetadata{"path": {ValueKind: "dropbox_path"}}),
 		DropboxScopes: []string{"sharing.read"},
 		Known:         true,
 	},
@@ -289,10 +741,39 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"sharing.read"},
 		Known:         true,
 	},
+	"share-link ls": {
+		Args: []jsonCommandArg{commandArg("url", true, false, "url", "Folder shared link URL")},
+		Examples: []jsonCommandExample{
+			{Description: "List a folder shared link", Command: "dbxcli share-link ls https://www.dropbox.com/scl/fo/example/folder"},
+			{Description: "List a subfolder recursively with details", Command: "dbxcli share-link ls -lR --path /photos https://www.dropbox.com/scl/fo/example/folder"},
+		},
+		Flags: mergeCommandFlagMetadata(sharedLinkPasswordFlagMetadata, map[string]jsonCommandFlagMetadata{
+			"long":        {ValueKind: "boolean"},
+			"path":        {ValueKind: "dropbox_path"},
+			"recursive":   {ValueKind: "boolean"},
+			"reverse":     {ValueKind: "boolean"},
+			"sort":        {EnumValues: []string{"name", "size", "time", "type"}, ValueKind: "enum"},
+			"time":        {EnumValues: []string{"server", "client"}, ValueKind: "enum"},
+			"time-format": {EnumValues: []string{"short", "rfc3339"}, ValueKind: "enum"},
+		}),
+		DropboxScopes: []string{"sharing.read", "files.metadata.read"},
+		Known:         true,
+	},
 	"share-link revoke": {
-		Args:          []jsonCommandArg{commandArg("url", false, false, "url", "Shared link URL; omit when using --path")},
-		Examples:      []jsonCommandExample{{Description: "Revoke a shared link", Command: "dbxcli share-link revoke https://www.dropbox.com/s/example/file.txt"}},
-		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}, "path": {ValueKind: "dropbox_path"}},
+		Args: []jsonCommandArg{commandArg("url", false, false, "url", "Shared link URL; omit when using --path or --all-under")},
+		Examples: []jsonCommandExample{
+			{Description: "Revoke a shared link", Command: "dbxcli share-link revoke https://www.dropbox.com/s/example/file.txt"},
+			{Description: "Preview revoking every public link under a folder", Command: "dbxcli share-link revoke --all-under /Clients --audience public --dry-run"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName:   {ValueKind: "boolean"},
+			"all-under":      {Conflicts: []string{"path"}, ValueKind: "dropbox_path"},
+			"allow-download": {ValueKind: "boolean"},
+			"audience":       {EnumValues: []string{"public", "team", "members", "no-one"}, ValueKind: "enum"},
+			"expired":        {ValueKind: "boolean"},
+			"no-password":    {ValueKind: "boolean"},
+			"path":           {Conflicts: []string{"all-under"}, ValueKind: "dropbox_path"},
+		},
 		DropboxScopes: []string{"sharing.write", "sharing.read"},
 		Known:         true,
 	},
@@ -309,6 +790,38 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"sharing.write", "sharing.read"},
 		Known:         true,
 	},
+	"tag add": {
+		Args: []jsonCommandArg{
+			commandArg("tag", true, false, "string", "Tag to add"),
+			commandArg("path", true, true, "dropbox_path", "Dropbox file or folder to tag"),
+		},
+		Examples: []jsonCommandExample{
+			{Description: "Tag several files", Command: "dbxcli tag add approved /Design/logo.psd /Design/banner.psd"},
+			{Description: "Preview tagging a file", Command: "dbxcli tag add approved /Design/logo.psd --dry-run"},
+		},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
+	"tag list": {
+		Args: []jsonCommandArg{commandArg("path", true, true, "dropbox_path", "Dropbox file, or folder whose contents to list")},
+		Examples: []jsonCommandExample{
+			{Description: "List tags on a file", Command: "dbxcli tag list /Design/logo.psd"},
+			{Description: "List tags for a folder listing", Command: "dbxcli tag list /Design"},
+		},
+		DropboxScopes: []string{"files.metadata.read"},
+		Known:         true,
+	},
+	"tag remove": {
+		Args: []jsonCommandArg{
+			commandArg("tag", true, false, "string", "Tag to remove"),
+			commandArg("path", true, true, "dropbox_path", "Dropbox file or folder to untag"),
+		},
+		Examples:      []jsonCommandExample{{Description: "Remove a tag from a file", Command: "dbxcli tag remove draft /Design/logo.psd"}},
+		Flags:         map[string]jsonCommandFlagMetadata{dryRunFlagName: {ValueKind: "boolean"}},
+		DropboxScopes: []string{"files.metadata.write"},
+		Known:         true,
+	},
 	"team add-member": {
 		Args: []jsonCommandArg{
 			commandArg("email", true, false, "email", "Member email address"),
@@ -340,6 +853,79 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 		DropboxScopes: []string{"members.write"},
 		Known:         true,
 	},
+	"team share-links audit": {
+		Examples: []jsonCommandExample{
+			{Description: "List every team member's shared links", Command: "dbxcli team share-links audit"},
+			{Description: "Export the audit as CSV", Command: "dbxcli team share-links audit --csv"},
+			{Description: "Check shared links against a policy file", Command: "dbxcli team share-links audit --policy link-policy.json --output=json"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"csv":    {ValueKind: "boolean"},
+			"policy": {ValueKind: "local_file"},
+		},
+		DropboxScopes: []string{"members.read", "sharing.read", "team_data.member"},
+		Known:         true,
+	},
+	"temp-link get": {
+		Args: []jsonCommandArg{commandArg("path", true, false, "dropbox_path", "Dropbox file, file ID, or revision to link")},
+		Examples: []jsonCommandExample{
+			{Description: "Create a four-hour download link", Command: "dbxcli temp-link get /Builds/app.tar.gz"},
+		},
+		DropboxScopes:   []string{"files.content.read"},
+		SensitiveOutput: true,
+		Known:           true,
+	},
+	"temp-link upload": {
+		Args: []jsonCommandArg{commandArg("path", true, false, "dropbox_path", "Dropbox file path the upload will be saved to")},
+		Examples: []jsonCommandExample{
+			{Description: "Create a four-hour upload link", Command: "dbxcli temp-link upload /Builds/app.tar.gz"},
+			{Description: "Create a 30-minute link that never overwrites", Command: "dbxcli temp-link upload --duration 30m --if-exists fail /Inbox/report.pdf"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"duration":  {ValueKind: "duration"},
+			"if-exists": {EnumValues: []string{"overwrite", "skip", "autorename", "fail"}, ValueKind: "enum"},
+		},
+		DropboxScopes:   []string{"files.content.write", "files.metadata.read"},
+		SensitiveOutput: true,
+		Known:           true,
+	},
+	"thumbnail": {
+		Args: []jsonCommandArg{commandArg("path", true, true, "dropbox_path", "Dropbox image, or a single folder of images")},
+		Examples: []jsonCommandExample{
+			{Description: "Download a thumbnail of an image", Command: "dbxcli thumbnail /Photos/cover.jpg"},
+			{Description: "Download WebP thumbnails of every image in a folder", Command: "dbxcli thumbnail /Photos/Catalogue --size w256h256 --format webp -o ./thumbs"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			"format":  {EnumValues: thumbnailFormats, ValueKind: "enum"},
+			"mode":    {EnumValues: thumbnailModes, ValueKind: "enum"},
+			"out-dir": {ValueKind: "local_path"},
+			"size":    {EnumValues: thumbnailSizes, ValueKind: "enum"},
+		},
+		DropboxScopes: []string{"files.content.read", "files.metadata.read"},
+		StdinStdout:   jsonCommandStdinStdout{WritesBinaryStdout: true},
+		Known:         true,
+	},
+	"undelete": {
+		Args: []jsonCommandArg{commandArg("folder", true, false, "dropbox_path", "Dropbox folder to search for deleted files")},
+		Examples: []jsonCommandExample{
+			{Description: "Restore every deleted file under a folder", Command: "dbxcli undelete /Reports"},
+			{Description: "Preview restoring recently deleted PDFs", Command: "dbxcli undelete /Reports --since 7d --match '*.pdf' --dry-run"},
+		},
+		Flags: map[string]jsonCommandFlagMetadata{
+			dryRunFlagName: {ValueKind: "boolean"},
+			"match":        {ValueKind: "string"},
+			"since":        {ValueKind: "string"},
+			"workers":      {ValueKind: "integer"},
+		},
+		DropboxScopes: []string{"files.content.write", "files.metadata.read"},
+		Known:         true,
+	},
+	"unlock": {
+		Args:          []jsonCommandArg{commandArg("path", true, true, "dropbox_path", "Dropbox file to unlock")},
+		Examples:      []jsonCommandExample{{Description: "Release a file lock", Command: "dbxcli unlock /Design/logo.psd"}},
+		DropboxScopes: []string{"files.content.write"},
+		Known:         true,
+	},
 	"version": {
 		Examples: []jsonCommandExample{{Description: "Print version information", Command: "dbxcli version"}},
 		Known:    true,
@@ -347,34 +933,78 @@ var commandManifestRegistry = map[string]jsonCommandManifestMetadata{
 }
 
 var commandContractRegistry = map[string]jsonCommandContractMetadata{
-	"account":             {Statuses: []string{"found"}, Kinds: []string{"account"}},
-	"cp":                  {Statuses: []string{"autorenamed", "copied", "skipped", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
-	"du":                  {Statuses: []string{"reported"}, Kinds: []string{"space_usage"}},
-	"get":                 {Statuses: []string{"created", "downloaded", "existing"}, Kinds: []string{"file", "folder"}},
-	"help":                {Statuses: []string{"described"}, Kinds: []string{"command"}},
-	"logout":              {Statuses: []string{"already_logged_out", "logged_out"}, Kinds: []string{"auth"}, Warnings: []string{jsonWarningCodeTokenRevokeFailed}},
-	"ls":                  {Statuses: []string{"listed"}, Kinds: []string{"deleted", "file", "folder"}},
-	"mkdir":               {Statuses: []string{"created", "existing", jsonStatusPlanned}, Kinds: []string{"folder"}},
-	"mv":                  {Statuses: []string{"autorenamed", "moved", "skipped", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
-	"put":                 {Statuses: []string{"autorenamed", "created", "existing", "skipped", "uploaded", jsonStatusPlanned}, Kinds: []string{"file", "folder"}, Warnings: []string{jsonWarningCodeSkippedSymlink}},
-	"restore":             {Statuses: []string{"restored", jsonStatusPlanned}, Kinds: []string{"file"}},
-	"revs":                {Statuses: []string{"revision"}, Kinds: []string{"file"}},
-	"rm":                  {Statuses: []string{"deleted", "permanently_deleted", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
-	"search":              {Statuses: []string{"found"}, Kinds: []string{"deleted", "file", "folder"}},
-	"share list folder":   {Statuses: []string{"listed"}, Kinds: []string{"shared_folder"}},
-	"share list link":     {Statuses: []string{"listed"}, Kinds: []string{"file", "folder", "link"}, Warnings: []string{jsonWarningCodeDeprecatedCommand}},
-	"share-link create":   {Statuses: []string{"created", "existing", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link"}},
-	"share-link download": {Statuses: []string{"downloaded"}, Kinds: []string{"file", "folder", "link"}},
-	"share-link info":     {Statuses: []string{"found"}, Kinds: []string{"file", "folder", "link"}},
-	"share-link list":     {Statuses: []string{"listed"}, Kinds: []string{"file", "folder", "link"}},
-	"share-link revoke":   {Statuses: []string{"revoked", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link", "shared_link"}},
-	"share-link update":   {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link"}},
-	"team add-member":     {Statuses: []string{"added", "completed", "started"}, Kinds: []string{"team_member"}},
-	"team info":           {Statuses: []string{"found"}, Kinds: []string{"team"}},
-	"team list-groups":    {Statuses: []string{"listed"}, Kinds: []string{"team_group"}},
-	"team list-members":   {Statuses: []string{"listed"}, Kinds: []string{"team_member"}},
-	"team remove-member":  {Statuses: []string{"completed", "removed", "started"}, Kinds: []string{"team_member"}},
-	"version":             {Statuses: []string{"reported"}, Kinds: []string{"version"}},
+	"account":                    {Statuses: []string{"found"}, Kinds: []string{"account"}},
+	"cp":                         {Statuses: []string{"autorenamed", "copied", "skipped", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
+	"du":                         {Statuses: []string{"reported"}, Kinds: []string{"space_usage"}},
+	"file-request close":         {Statuses: []string{"closed", jsonStatusPlanned}, Kinds: []string{"file_request"}},
+	"file-request create":        {Statuses: []string{"created", jsonStatusPlanned}, Kinds: []string{"file_request"}},
+	"file-request delete":        {Statuses: []string{"deleted", jsonStatusPlanned}, Kinds: []string{"file_request"}},
+	"file-request get":           {Statuses: []string{"found"}, Kinds: []string{"file_request"}},
+	"file-request list":          {Statuses: []string{"listed"}, Kinds: []string{"file_request"}},
+	"file-request update":        {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"file_request"}},
+	"get":                        {Statuses: []string{"created", "downloaded", "existing"}, Kinds: []string{"file", "folder", "zip"}},
+	"hash":                       {Statuses: []string{"hashed", "matched"}, Kinds: []string{"file"}},
+	"help":                       {Statuses: []string{"described"}, Kinds: []string{"command"}},
+	"lock":                       {Statuses: []string{"locked"}, Kinds: []string{"file"}, Warnings: []string{jsonWarningCodeFileLockFailed}},
+	"lock status":                {Statuses: []string{"locked", "unlocked"}, Kinds: []string{"file"}, Warnings: []string{jsonWarningCodeFileLockFailed}},
+	"logout":                     {Statuses: []string{"already_logged_out", "logged_out"}, Kinds: []string{"auth"}, Warnings: []string{jsonWarningCodeTokenRevokeFailed}},
+	"ls":                         {Statuses: []string{"listed"}, Kinds: []string{"deleted", "file", "folder"}},
+	"mkdir":                      {Statuses: []string{"created", "existing", jsonStatusPlanned}, Kinds: []string{"folder"}},
+	"mv":                         {Statuses: []string{"autorenamed", "moved", "skipped", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
+	"preview":                    {Statuses: []string{"downloaded"}, Kinds: []string{"preview"}},
+	"props get":                  {Statuses: []string{"found"}, Kinds: []string{"file", "folder"}},
+	"props remove":               {Statuses: []string{"removed", jsonStatusPlanned}, Kinds: []string{"property_group"}},
+	"props set":                  {Statuses: []string{"set", jsonStatusPlanned}, Kinds: []string{"property_group"}},
+	"props templates add":        {Statuses: []string{"added"}, Kinds: []string{"property_template"}},
+	"props templates get":        {Statuses: []string{"found"}, Kinds: []string{"property_template"}},
+	"props templates list":       {Statuses: []string{"listed"}, Kinds: []string{"property_template"}},
+	"props update":               {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"property_group"}},
+	"put":                        {Statuses: []string{"autorenamed", "created", "existing", "skipped", "uploaded", jsonStatusPlanned}, Kinds: []string{"file", "folder"}, Warnings: []string{jsonWarningCodeSkippedLink, jsonWarningCodeSkippedSymlink, jsonWarningCodeSkippedUnsupportedEntry, jsonWarningCodeUploadFailed}},
+	"restore":                    {Statuses: []string{"restored", jsonStatusPlanned}, Kinds: []string{"file"}},
+	"revs":                       {Statuses: []string{"revision"}, Kinds: []string{"file"}},
+	"rm":                         {Statuses: []string{"deleted", "permanently_deleted", jsonStatusPlanned}, Kinds: []string{"deleted", "file", "folder"}},
+	"save-url":                   {Statuses: []string{"autorenamed", "saved", "skipped", "started", jsonStatusPlanned}, Kinds: []string{"file"}},
+	"save-url status":            {Statuses: []string{"in_progress", "saved"}, Kinds: []string{"file"}},
+	"search":                     {Statuses: []string{"found"}, Kinds: []string{"deleted", "file", "folder"}},
+	"share file invite":          {Statuses: []string{"invited", jsonStatusPlanned}, Kinds: []string{"group", "user"}, Warnings: []string{jsonWarningCodeFileSharingFailed}},
+	"share file members":         {Statuses: []string{"listed"}, Kinds: []string{"group", "invitee", "user"}, Warnings: []string{jsonWarningCodeFileSharingFailed}},
+	"share file remove":          {Statuses: []string{"removed", jsonStatusPlanned}, Kinds: []string{"group", "user"}},
+	"share file unshare":         {Statuses: []string{"unshared", jsonStatusPlanned}, Kinds: []string{"file"}},
+	"share folder create":        {Statuses: []string{"shared", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share folder invite":        {Statuses: []string{"invited", jsonStatusPlanned}, Kinds: []string{"group", "user"}},
+	"share folder leave":         {Statuses: []string{"left", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share folder list":          {Statuses: []string{"listed"}, Kinds: []string{"shared_folder"}},
+	"share folder members":       {Statuses: []string{"listed"}, Kinds: []string{"group", "invitee", "user"}},
+	"share folder mount":         {Statuses: []string{"mounted", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share folder remove":        {Statuses: []string{"removed", jsonStatusPlanned}, Kinds: []string{"group", "user"}, Warnings: []string{jsonWarningCodeMemberRemoveFailed}},
+	"share folder set-access":    {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"group", "user"}},
+	"share folder unmount":       {Statuses: []string{"unmounted", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share folder unshare":       {Statuses: []string{"unshared", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share folder update-policy": {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"shared_folder"}},
+	"share list folder":          {Statuses: []string{"listed"}, Kinds: []string{"shared_folder"}},
+	"share list link":            {Statuses: []string{"listed"}, Kinds: []string{"file", "folder", "link"}, Warnings: []string{jsonWarningCodeDeprecatedCommand}},
+	"share-link create":          {Statuses: []string{"created", "existing", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link"}, Warnings: []string{jsonWarningCodeShareLinkCreateFailed}},
+	"share-link download":        {Statuses: []string{"downloaded"}, Kinds: []string{"file", "folder", "link"}},
+	"share-link info":            {Statuses: []string{"found"}, Kinds: []string{"file", "folder", "link"}},
+	"share-link list":            {Statuses: []string{"listed"}, Kinds: []string{"file", "folder", "link"}},
+	"share-link ls":              {Statuses: []string{"listed"}, Kinds: []string{"file", "folder"}},
+	"share-link revoke":          {Statuses: []string{"revoked", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link", "shared_link"}, Warnings: []string{jsonWarningCodeShareLinkRevokeFailed}},
+	"share-link update":          {Statuses: []string{"updated", jsonStatusPlanned}, Kinds: []string{"file", "folder", "link"}},
+	"tag add":                    {Statuses: []string{"added", jsonStatusPlanned}, Kinds: []string{"tag"}, Warnings: []string{jsonWarningCodeTagFailed}},
+	"tag list":                   {Statuses: []string{"listed"}, Kinds: []string{"file", "folder"}},
+	"tag remove":                 {Statuses: []string{"removed", jsonStatusPlanned}, Kinds: []string{"tag"}, Warnings: []string{jsonWarningCodeTagFailed}},
+	"team add-member":            {Statuses: []string{"added", "completed", "started"}, Kinds: []string{"team_member"}},
+	"team info":                  {Statuses: []string{"found"}, Kinds: []string{"team"}},
+	"team list-groups":           {Statuses: []string{"listed"}, Kinds: []string{"team_group"}},
+	"team list-members":          {Statuses: []string{"listed"}, Kinds: []string{"team_member"}},
+	"team remove-member":         {Statuses: []string{"completed", "removed", "started"}, Kinds: []string{"team_member"}},
+	"team share-links audit":     {Statuses: []string{"compliant", "listed", "violation"}, Kinds: []string{"file", "folder", "link"}, Warnings: []string{jsonWarningCodeMemberAuditFailed}},
+	"temp-link get":              {Statuses: []string{"created"}, Kinds: []string{"download_link"}},
+	"temp-link upload":           {Statuses: []string{"created", "skipped"}, Kinds: []string{"upload_link"}},
+	"thumbnail":                  {Statuses: []string{"downloaded"}, Kinds: []string{"thumbnail"}, Warnings: []string{jsonWarningCodeThumbnailFailed}},
+	"undelete":                   {Statuses: []string{"restored", jsonStatusPlanned}, Kinds: []string{"file"}, Warnings: []string{jsonWarningCodeRestoreFailed}},
+	"unlock":                     {Statuses: []string{"unlocked"}, Kinds: []string{"file"}, Warnings: []string{jsonWarningCodeFileLockFailed}},
+	"version":                    {Statuses: []string{"reported"}, Kinds: []string{"version"}},
 }
 
 func commandArg(name string, required bool, variadic bool, valueKind string, description string) jsonCommandArg {
@@ -272,6 +272,7 @@ func TestPublicJSONManifestSchemaFile(t *testing.T) {
 		"runnable",
 		"schema_refs",
 		"scope_accuracy",
+		"sensitive_output",
 		"short",
 		"stdin_stdout",
 		"supports_structured_output",
@@ -314,29 +315,73 @@ func expectedStructuredOutputCommands() []string {
 		"account",
 		"cp",
 		"du",
+		"file-request close",
+		"file-request create",
+		"file-request delete",
+		"file-request get",
+		"file-request list",
+		"file-request update",
 		"get",
+		"hash",
+		"lock",
+		"lock status",
 		"logout",
 		"ls",
 		"mkdir",
 		"mv",
+		"preview",
+		"props get",
+		"props remove",
+		"props set",
+		"props templates add",
+		"props templates get",
+		"props templates list",
+		"props update",
 		"put",
 		"restore",
 		"revs",
 		"rm",
+		"save-url",
+		"save-url status",
 		"search",
+		"share file invite",
+		"share file members",
+		"share file remove",
+		"share file unshare",
+		"share folder create",
+		"share folder invite",
+		"share folder leave",
+		"share folder list",
+		"share folder members",
+		"share folder mount",
+		"share folder remove",
+		"share folder set-access",
+		"share folder unmount",
+		"share folder unshare",
+		"share folder update-policy",
 		"share list folder",
 		"share list link",
 		"share-link create",
 		"share-link download",
 		"share-link info",
 		"share-link list",
+		"share-link ls",
 		"share-link revoke",
 		"share-link update",
+		"tag add",
+		"tag list",
+		"tag remove",
 		"team add-member",
 		"team info",
 		"team list-groups",
 		"team list-members",
 		"team remove-member",
+		"team share-links audit",
+		"temp-link get",
+		"temp-link upload",
+		"thumbnail",
+		"undelete",
+		"unlock",
 		"version",
 	}
 }
@@ -391,10 +436,38 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "du_test.go",
 			tests: []string{"TestDuJSONIndividualAllocation", "TestDuJSONTeamAllocation"},
 		},
+		"file-request close": {
+			file:  "file_request_update_test.go",
+			tests: []string{"TestFileRequestCloseSendsOpenFalse"},
+		},
+		"file-request create": {
+			file:  "file_request_create_test.go",
+			tests: []string{"TestFileRequestCreateJSONOutputsRequest"},
+		},
+		"file-request delete": {
+			file:  "file_request_delete_test.go",
+			tests: []string{"TestFileRequestDeleteJSONOutputsDeletedRequests"},
+		},
+		"file-request get": {
+			file:  "file_request_test.go",
+			tests: []string{"TestFileRequestGetJSONOutputsRequest"},
+		},
+		"file-request list": {
+			file:  "file_request_test.go",
+			tests: []string{"TestFileRequestListJSONOutputsRequests"},
+		},
+		"file-request update": {
+			file:  "file_request_update_test.go",
+			tests: []string{"TestFileRequestUpdateJSONOutputsRequest"},
+		},
 		"get": {
 			file:  "get_test.go",
 			tests: []string{"TestGetJSONFileOutputsDownloadedResult", "TestGetJSONRecursiveOutputsDirectoryAndFileResults"},
 		},
+		"hash": {
+			file:  "hash_test.go",
+			tests: []string{"TestHashJSONOutputsContentHashes", "TestHashCheckRecursiveJSONOutputsMatches"},
+		},
 		"help": {
 			file:  "help_json_test.go",
 			tests: []string{"TestJSONHelpSupportedForms", "TestJSONHelpManifestFields"},
@@ -403,6 +476,14 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "ls_test.go",
 			tests: []string{"TestLsJSONListsResultsAndInput", "TestLsJSONDeletedEntryIsStructured"},
 		},
+		"lock": {
+			file:  "lock_test.go",
+			tests: []string{"TestLockJSONOutputsLockedFiles"},
+		},
+		"lock status": {
+			file:  "lock_test.go",
+			tests: []string{"TestLockStatusJSONOutputsLockHolders"},
+		},
 		"logout": {
 			file:  "logout_test.go",
 			tests: []string{"TestLogoutJSONReturnsLoggedOut", "TestLogoutJSONReturnsAlreadyLoggedOut", "TestLogoutJSONWarnsOnRemoteRevokeFailureAfterRemovingCredentials"},
@@ -415,6 +496,38 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "mv_test.go",
 			tests: []string{"TestMvJSONOutputsRelocationResults", "TestMvJSONMultipleSourcesOutputsMultipleResults"},
 		},
+		"preview": {
+			file:  "preview_test.go",
+			tests: []string{"TestPreviewJSONWritesDefaultTarget"},
+		},
+		"props get": {
+			file:  "props_test.go",
+			tests: []string{"TestPropsGetJSONOutputsPropertyGroups"},
+		},
+		"props remove": {
+			file:  "props_test.go",
+			tests: []string{"TestPropsRemoveJSONOutputsResult"},
+		},
+		"props set": {
+			file:  "props_test.go",
+			tests: []string{"TestPropsSetJSONOutputsResult"},
+		},
+		"props templates add": {
+			file:  "props_templates_test.go",
+			tests: []string{"TestPropsTemplatesAddJSONOutputsTemplate"},
+		},
+		"props templates get": {
+			file:  "props_templates_test.go",
+			tests: []string{"TestPropsTemplatesGetJSONOutputsTemplate"},
+		},
+		"props templates list": {
+			file:  "props_templates_test.go",
+			tests: []string{"TestPropsTemplatesListJSONOutputsTemplates"},
+		},
+		"props update": {
+			file:  "props_test.go",
+			tests: []string{"TestPropsUpdateJSONOutputsResult"},
+		},
 		"put": {
 			file:  "put_test.go",
 			tests: []string{"TestPutJSONSingleFileOutputsUploadedResult", "TestPutJSONRecursiveOutputsDirectoryAndFileResults"},
@@ -431,10 +544,78 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "rm_test.go",
 			tests: []string{"TestRmJSONDeletesFile", "TestRmJSONMultipleTargets"},
 		},
+		"save-url": {
+			file:  "save_url_test.go",
+			tests: []string{"TestSaveURLJSONWaitsForJob", "TestSaveURLCompleteImmediatelyReportsAutorename"},
+		},
+		"save-url status": {
+			file:  "save_url_test.go",
+			tests: []string{"TestSaveURLStatusJSONOutputsSavedFile"},
+		},
 		"search": {
 			file:  "search_test.go",
 			tests: []string{"TestSearchJSONOutputsInputAndResults", "TestSearchJSONOmitsPathWithoutScope"},
 		},
+		"share file invite": {
+			file:  "share_file_test.go",
+			tests: []string{"TestShareFileInviteWarnsAboutFailedMembers"},
+		},
+		"share file members": {
+			file:  "share_file_test.go",
+			tests: []string{"TestShareFileMembersBatchesAndFollowsCursors"},
+		},
+		"share file remove": {
+			file:  "share_file_test.go",
+			tests: []string{"TestShareFileRemoveReportsInheritedAccess"},
+		},
+		"share file unshare": {
+			file:  "share_file_test.go",
+			tests: []string{"TestShareFileUnshareDryRunDoesNotUnshare"},
+		},
+		"share folder create": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderCreateWaitsForShareJob"},
+		},
+		"share folder invite": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderInviteAddsMembersWithAccess"},
+		},
+		"share folder leave": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderLeaveKeepsCopyAndWaitsForJob"},
+		},
+		"share folder list": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderListMountableListsInvitations"},
+		},
+		"share folder members": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderMembersJSONListsUsersGroupsAndInvitees"},
+		},
+		"share folder mount": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderMountPrintsMountedPath"},
+		},
+		"share folder remove": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderRemoveWaitsForJob"},
+		},
+		"share folder set-access": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderSetAccessResolvesEmailToAccountID"},
+		},
+		"share folder unmount": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderUnmountUsesResolvedID"},
+		},
+		"share folder unshare": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderUnshareWaitsForJob"},
+		},
+		"share folder update-policy": {
+			file:  "share_folder_test.go",
+			tests: []string{"TestShareFolderUpdatePolicySendsOnlyGivenPolicies", "TestShareFolderUpdatePolicyDryRunShowsPlannedPolicies"},
+		},
 		"share list folder": {
 			file:  "share_list_folders_test.go",
 			tests: []string{"TestShareListFoldersJSONOutputsSharedFolders", "TestShareListFoldersJSONPaginates"},
@@ -459,6 +640,10 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "share_link_json_test.go",
 			tests: []string{"TestShareLinkListJSONOutputsResultsAndInput"},
 		},
+		"share-link ls": {
+			file:  "share_link_json_test.go",
+			tests: []string{"TestShareLinkLsJSONListsResultsAndInput"},
+		},
 		"share-link revoke": {
 			file:  "share_link_json_test.go",
 			tests: []string{"TestShareLinkRevokeJSONOutputsRevokedURL"},
@@ -467,6 +652,18 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "share_link_json_test.go",
 			tests: []string{"TestShareLinkUpdateJSONOutputsUpdatedMetadata"},
 		},
+		"tag add": {
+			file:  "tag_test.go",
+			tests: []string{"TestTagAddJSONOutputsResults", "TestTagAddJSONDryRunOutputsPlannedResults"},
+		},
+		"tag list": {
+			file:  "tag_test.go",
+			tests: []string{"TestTagListJSONOutputsFolderListing"},
+		},
+		"tag remove": {
+			file:  "tag_test.go",
+			tests: []string{"TestTagRemoveJSONOutputsResults"},
+		},
 		"team add-member": {
 			file:  "team_json_test.go",
 			tests: []string{"TestTeamAddMemberJSONOutputsMutationResult"},
@@ -487,6 +684,30 @@ func jsonSuccessFixtureCoverage() map[string]jsonSuccessFixture {
 			file:  "team_json_test.go",
 			tests: []string{"TestTeamRemoveMemberJSONOutputsMutationResult"},
 		},
+		"team share-links audit": {
+			file:  "team_share_links_test.go",
+			tests: []string{"TestTeamShareLinksAuditJSONFlagsPolicyViolations"},
+		},
+		"temp-link get": {
+			file:  "temp_link_test.go",
+			tests: []string{"TestTempLinkGetJSONOutputsLink"},
+		},
+		"temp-link upload": {
+			file:  "temp_link_test.go",
+			tests: []string{"TestTempLinkUploadJSONMapsIfExistsToCommitMode", "TestTempLinkUploadSkipsExistingFile"},
+		},
+		"thumbnail": {
+			file:  "thumbnail_test.go",
+			tests: []string{"TestThumbnailJSONWritesFilesAndWarnsOnFailures"},
+		},
+		"unlock": {
+			file:  "lock_test.go",
+			tests: []string{"TestUnlockJSONOutputsUnlockedFiles"},
+		},
+		"undelete": {
+			file:  "undelete_test.go",
+			tests: []string{"TestUndeleteJSONOutputsPerFileResults", "TestUndeleteJSONDryRunOutputsPlannedResults"},
+		},
 		"version": {
 			file:  "version_test.go",
 			tests: []string{"TestVersionJSONOutputsVersionInfo"},
@@ -607,12 +828,15 @@ func expectedJSONErrorCodes() []string {
 		jsonErrorCodeCommandFailed,
 		jsonErrorCodeDropboxAPIError,
 		jsonErrorCodeEnvTokenStillActive,
+		jsonErrorCodeFileLocked,
+		jsonErrorCodeHashMismatch,
 		jsonErrorCodeInvalidArguments,
 		jsonErrorCodeNotFound,
 		jsonErrorCodePartialTransfer,
 		jsonErrorCodePathConflict,
 		jsonErrorCodePermissionDenied,
 		jsonErrorCodeRateLimited,
+		jsonErrorCodeRevConflict,
 		jsonErrorCodeStructuredOutputUnsupported,
 		jsonErrorCodeUnknownCommand,
 		jsonErrorCodeUnknownFlag,
@@ -696,10 +920,43 @@ func assertGoldenErrorOutputShape(t *testing.T, name string, fixture json.RawMes
 
 func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 	file := sampleJSONFileMetadata("/Reports/old.pdf")
+	savedFile := sampleJSONFileMetadata("/Datasets/data.csv")
+	lockCreated := "2026-01-02T03:04:05Z"
+	fileLock := fileLockJSON{Locked: true, IsLockholder: true, LockholderName: "Ada Lovelace", LockholderAccountID: "dbid:ada", Created: &lockCreated, Metadata: file}
 	copyFile := sampleJSONFileMetadata("/Reports/copy.pdf")
 	folder := sampleJSONFolderMetadata("/Reports")
 	sharedLink := sampleShareLinkJSONMetadata()
 	teamMember := sampleTeamMemberJSON()
+	propertyTemplate := jsonPropertyTemplate{
+		TemplateID:  "ptid:1a5n2i6d3OYEAAAAAAAAAYa",
+		Name:        "Retention",
+		Description: "Records retention",
+		Owner:       propertyTemplateOwnerUser,
+		Fields:      []jsonPropertyFieldTemplate{{Name: "project", Description: "Project code", Type: "string"}, {Name: "class", Description: "Retention class", Type: "string"}},
+	}
+	fileRequestCreated := "2026-05-01T09:00:00Z"
+	fileRequestDeadline := "2026-07-01T17:00:00Z"
+	fileRequest := jsonFileRequest{
+		ID:               "oaCAVmEyrqYnkZX9955Y",
+		URL:              "https://www.dropbox.com/request/oaCAVmEyrqYnkZX9955Y",
+		Title:            "Acme deliverables",
+		Destination:      "/Vendors/Acme",
+		Created:          &fileRequestCreated,
+		Deadline:         &fileRequestDeadline,
+		AllowLateUploads: "seven_days",
+		IsOpen:           true,
+		FileCount:        3,
+	}
+	closedFileRequest := jsonFileRequest{
+		ID:          "Tm9rPl0zcEfCXe1bSGYn",
+		URL:         "https://www.dropbox.com/request/Tm9rPl0zcEfCXe1bSGYn",
+		Title:       "Q2 report",
+		Destination: "/Vendors/Acme/Q2",
+		Created:     &fileRequestCreated,
+		FileCount:   12,
+	}
+	propertyFile := sampleJSONFileMetadata("/Reports/old.pdf")
+	propertyFile.PropertyGroups = []jsonPropertyGroup{{TemplateID: propertyTemplate.TemplateID, Fields: []jsonPropertyField{{Name: "project", Value: "P-12"}, {Name: "class", Value: "7y"}}}}
 
 	examples := map[string]jsonOperationOutput{
 		"account": newJSONOperationOutput(accountInput{AccountID: "dbid:lookup"}, []jsonOperationResult{
@@ -721,15 +978,43 @@ func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 				},
 			}),
 		}, nil),
+		"file-request close": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusClosed, fileRequestKind, fileRequestInput{ID: closedFileRequest.ID}, closedFileRequest),
+		}, nil),
+		"file-request create": newJSONOperationOutput(fileRequestCreateInput{Destination: fileRequest.Destination, Title: fileRequest.Title, Deadline: *fileRequest.Deadline, AllowLateUploads: fileRequest.AllowLateUploads}, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusCreated, fileRequestKind, fileRequestResultInput{}, fileRequest),
+		}, nil),
+		"file-request delete": newJSONOperationOutput(fileRequestDeleteInput{IDs: []string{}, AllClosed: true}, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusDeleted, fileRequestKind, fileRequestInput{ID: closedFileRequest.ID}, closedFileRequest),
+		}, nil),
+		"file-request get": newJSONOperationOutput(fileRequestInput{ID: fileRequest.ID}, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusFound, fileRequestKind, nil, fileRequest),
+		}, nil),
+		"file-request list": newJSONOperationOutput(fileRequestListInput{}, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusListed, fileRequestKind, nil, fileRequest),
+			newJSONOperationResult(fileRequestStatusListed, fileRequestKind, nil, closedFileRequest),
+		}, nil),
+		"file-request update": newJSONOperationOutput(fileRequestUpdateInput{ID: fileRequest.ID, Title: fileRequest.Title}, []jsonOperationResult{
+			newJSONOperationResult(fileRequestStatusUpdated, fileRequestKind, fileRequestResultInput{}, fileRequest),
+		}, nil),
 		"get": newJSONOperationOutput(getCommandInput{Source: "/Reports/old.pdf", Target: "old.pdf", Recursive: false, Stdout: false}, []jsonOperationResult{
 			newJSONOperationResult(getStatusDownloaded, getKindFile, getResultInput{Source: "/Reports/old.pdf", Target: "old.pdf"}, file),
 		}, nil),
+		"hash": newJSONOperationOutput(hashInput{Paths: []string{"report.pdf"}}, []jsonOperationResult{
+			newJSONOperationResult(hashStatusHashed, hashKindFile, hashResultInput{Path: "report.pdf"}, hashJSON{ContentHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0}),
+		}, nil),
 		"help": newJSONOperationOutput(jsonHelpInput{Help: true, Path: "ls"}, []jsonOperationResult{
 			newJSONOperationResult(jsonHelpStatusDescribed, jsonHelpKindCommand, nil, jsonCommandManifestFor(lsCmd)),
 		}, nil),
 		"ls": newJSONOperationOutput(lsInput{Path: "/Reports", Recursive: false, IncludeDeleted: true, OnlyDeleted: false, Long: true, Sort: "type", Reverse: false, Time: "server", TimeFormat: "2006-01-02"}, []jsonOperationResult{
 			newJSONOperationResult(lsJSONStatusListed, file.Type, nil, file),
 		}, nil),
+		"lock": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(lockStatusLocked, lockKindFile, lockInput{Path: "/Reports/old.pdf"}, fileLock),
+		}, nil),
+		"lock status": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(lockStatusLocked, lockKindFile, lockInput{Path: "/Reports/old.pdf"}, fileLock),
+		}, nil),
 		"logout": newJSONOperationOutput(nil, []jsonOperationResult{
 			newJSONOperationResult(logoutStatusLoggedOut, logoutKindAuth, nil, logoutResult{RemovedSavedCredentials: true, RemoteTokenRevoked: true}),
 		}, nil),
@@ -739,6 +1024,30 @@ func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 		"mv": newJSONOperationOutput(nil, []jsonOperationResult{
 			newJSONOperationResult(relocationJSONStatusMoved, "file", relocationInput{FromPath: "/Reports/copy.pdf", ToPath: "/Reports/moved.pdf"}, sampleJSONFileMetadata("/Reports/moved.pdf")),
 		}, nil),
+		"preview": newJSONOperationOutput(previewInput{Source: "/Reports/old.pdf", Target: "old.pdf"}, []jsonOperationResult{
+			newJSONOperationResult(getStatusDownloaded, previewKind, getResultInput{Source: "/Reports/old.pdf", Target: "old.pdf"}, file),
+		}, nil),
+		"props get": newJSONOperationOutput(propsGetInput{Path: "/Reports/old.pdf", Templates: []string{"Retention"}}, []jsonOperationResult{
+			newJSONOperationResult(propsStatusFound, propertyFile.Type, nil, propertyFile),
+		}, nil),
+		"props remove": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(propsStatusRemoved, propsKindPropertyGroup, propsInput{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID}, propertyGroupResult{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID, Fields: []jsonPropertyField{}}),
+		}, nil),
+		"props set": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(propsStatusSet, propsKindPropertyGroup, propsInput{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID}, propertyGroupResult{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID, Fields: propertyFile.PropertyGroups[0].Fields}),
+		}, nil),
+		"props templates add": newJSONOperationOutput(propsTemplatesInput{Name: propertyTemplate.Name}, []jsonOperationResult{
+			newJSONOperationResult(propsTemplateStatusAdded, propsKindPropertyTemplate, nil, propertyTemplate),
+		}, nil),
+		"props templates get": newJSONOperationOutput(propsTemplatesInput{TemplateID: propertyTemplate.TemplateID}, []jsonOperationResult{
+			newJSONOperationResult(propsTemplateStatusFound, propsKindPropertyTemplate, nil, propertyTemplate),
+		}, nil),
+		"props templates list": newJSONOperationOutput(propsTemplatesInput{}, []jsonOperationResult{
+			newJSONOperationResult(propsTemplateStatusListed, propsKindPropertyTemplate, nil, propertyTemplate),
+		}, nil),
+		"props update": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(propsStatusUpdated, propsKindPropertyGroup, propsInput{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID}, propertyGroupResult{Path: "/Reports/old.pdf", TemplateID: propertyTemplate.TemplateID, Fields: []jsonPropertyField{{Name: "class", Value: "10y"}}, RemovedFields: []string{"project"}}),
+		}, nil),
 		"put": newJSONOperationOutput(putCommandInput{Source: "README.md", Target: "/README.md", Recursive: true, IfExists: putIfExistsOverwrite, Stdin: false, DryRun: false}, []jsonOperationResult{
 			newJSONOperationResult(putStatusUploaded, putKindFile, putResultInput{Source: "README.md", Target: "/README.md", DryRun: false}, sampleJSONFileMetadata("/README.md")),
 		}, []jsonWarning{{Code: jsonWarningCodeSkippedSymlink, Message: "skipped symlink", Path: "docs/link"}}),
@@ -751,9 +1060,62 @@ func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 		"rm": newJSONOperationOutput(nil, []jsonOperationResult{
 			newJSONOperationResult(removeJSONStatusDeleted, file.Type, removeInput{Path: "/Reports/old.pdf", Permanent: false, Recursive: false, Force: false}, file),
 		}, nil),
+		"save-url": newJSONOperationOutput(saveURLInput{URL: "https://example.com/data.csv", Path: "/Datasets/data.csv", IfExists: "fail", Wait: true}, []jsonOperationResult{
+			newJSONOperationResult(saveURLStatusSaved, saveURLKindFile, saveURLResultInput{URL: "https://example.com/data.csv", Path: "/Datasets/data.csv"}, saveURLJSON{AsyncJobID: "PID_RUvpd3jhU7LYNXJkJ9bGnQ", Metadata: &savedFile}),
+		}, nil),
+		"save-url status": newJSONOperationOutput(saveURLStatusInput{AsyncJobID: "PID_RUvpd3jhU7LYNXJkJ9bGnQ"}, []jsonOperationResult{
+			newJSONOperationResult(saveURLStatusInProgress, saveURLKindFile, nil, saveURLJSON{AsyncJobID: "PID_RUvpd3jhU7LYNXJkJ9bGnQ"}),
+		}, nil),
 		"search": newJSONOperationOutput(searchInput{Query: "report", Path: "/Reports", Long: true, Sort: "type", Reverse: false, Time: "server", TimeFormat: "2006-01-02"}, []jsonOperationResult{
 			newJSONOperationResult(searchJSONStatusFound, folder.Type, nil, folder),
 		}, nil),
+		"share file invite": newJSONOperationOutput(shareFileMembershipInput{File: "/Contracts/acme.pdf", Members: []string{"alice@example.com", "bob@example.com"}, AccessLevel: "viewer"}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusInvited, shareFolderMemberKindUser, shareFolderMemberInput{Member: "alice@example.com"}, shareFileMemberChangeJSON{File: "/Contracts/acme.pdf", AccessLevel: "viewer"}),
+		}, []jsonWarning{{Code: jsonWarningCodeFileSharingFailed, Message: "invite bob@example.com on /Contracts/acme.pdf: no_permission", Path: "/Contracts/acme.pdf"}}),
+		"share file members": newJSONOperationOutput(shareFileMembersInput{Files: []string{"/Contracts/acme.pdf"}}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusListed, shareFolderMemberKindUser, shareFileInput{File: "/Contracts/acme.pdf"}, shareFolderMemberJSON{Type: shareFolderMemberKindUser, AccessType: "owner", AccountID: "dbid:alice", Email: "alice@example.com", DisplayName: "Alice", SameTeam: true}),
+			newJSONOperationResult(shareFolderMemberStatusListed, shareFolderMemberKindInvitee, shareFileInput{File: "/Contracts/acme.pdf"}, shareFolderMemberJSON{Type: shareFolderMemberKindInvitee, AccessType: "viewer", Email: "counsel@example.org"}),
+		}, nil),
+		"share file remove": newJSONOperationOutput(shareFileMembershipInput{File: "/Contracts/acme.pdf", Members: []string{"alice@example.com"}}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusRemoved, shareFolderMemberKindUser, shareFolderMemberInput{Member: "alice@example.com"}, shareFileMemberChangeJSON{File: "/Contracts/acme.pdf", InheritedAccess: "viewer"}),
+		}, nil),
+		"share file unshare": newJSONOperationOutput(shareFileInput{File: "/Contracts/acme.pdf"}, []jsonOperationResult{
+			newJSONOperationResult(shareFileStatusUnshared, shareFileKindFile, shareFileInput{File: "/Contracts/acme.pdf"}, shareFileMemberChangeJSON{File: "/Contracts/acme.pdf"}),
+		}, nil),
+		"share folder create": newJSONOperationOutput(shareFolderCreateInput{Path: "/Projects", AclUpdatePolicy: "owner"}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderStatusShared, shareFolderJSONKindFolder, shareFolderCreateInput{Path: "/Projects", AclUpdatePolicy: "owner"}, sampleShareFolderJSONMetadata()),
+		}, nil),
+		"share folder invite": newJSONOperationOutput(shareFolderMembershipInput{Folder: "/Projects", SharedFolderID: "84528192421", Members: []string{"alice@example.com"}, AccessLevel: "editor"}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusInvited, shareFolderMemberKindUser, shareFolderMemberInput{Member: "alice@example.com"}, shareFolderMemberChangeJSON{SharedFolderID: "84528192421", AccessLevel: "editor"}),
+		}, nil),
+		"share folder leave": newJSONOperationOutput(shareFolderMountInput{Folder: "/Projects", SharedFolderID: "84528192421", KeepCopy: true}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderStatusLeft, shareFolderJSONKindFolder, shareFolderMountInput{Folder: "/Projects", SharedFolderID: "84528192421", KeepCopy: true}, shareFolderRefJSON{SharedFolderID: "84528192421"}),
+		}, nil),
+		"share folder list": newJSONOperationOutput(shareFolderListFoldersInput{Mountable: true}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderJSONStatusListed, shareFolderJSONKindFolder, nil, shareFolderJSONMetadata{Type: shareFolderJSONKindFolder, Name: "Projects", SharedFolderID: "84528192421", AccessType: "editor", OwnerDisplayNames: []string{"Ada Lovelace"}}),
+		}, nil),
+		"share folder members": newJSONOperationOutput(shareFolderMembersInput{Folder: "/Projects", SharedFolderID: "84528192421"}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusListed, shareFolderMemberKindUser, nil, shareFolderMemberJSON{Type: shareFolderMemberKindUser, AccessType: "owner", AccountID: "dbid:alice", Email: "alice@example.com", DisplayName: "Alice", SameTeam: true}),
+			newJSONOperationResult(shareFolderMemberStatusListed, shareFolderMemberKindGroup, nil, shareFolderMemberJSON{Type: shareFolderMemberKindGroup, AccessType: "editor", GroupID: "g:1234567890abcdef", GroupName: "Design", MemberCount: 4, SameTeam: true}),
+		}, nil),
+		"share folder mount": newJSONOperationOutput(shareFolderMountInput{Folder: "84528192421", SharedFolderID: "84528192421"}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderStatusMounted, shareFolderJSONKindFolder, shareFolderMountInput{Folder: "84528192421", SharedFolderID: "84528192421"}, sampleShareFolderJSONMetadata()),
+		}, nil),
+		"share folder remove": newJSONOperationOutput(shareFolderMembershipInput{Folder: "/Projects", SharedFolderID: "84528192421", Members: []string{"alice@example.com"}}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderMemberStatusRemoved, shareFolderMemberKindUser, shareFolderMemberInput{Member: "alice@example.com"}, shareFolderMemberChangeJSON{SharedFolderID: "84528192421", InheritedAccess: "viewer", Warning: "Alice still has access through /Team"}),
+		}, nil),
+		"share folder set-access": newJSONOperationOutput(shareFolderMembershipInput{Folder: "/Projects", SharedFolderID: "84528192421", Members: []string{"g:1234567890abcdef"}, AccessLevel: "viewer", DryRun: true}, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, shareFolderMemberKindGroup, shareFolderMemberInput{Member: "g:1234567890abcdef", DryRun: true}, shareFolderMemberChangeJSON{SharedFolderID: "84528192421", AccessLevel: "viewer"}),
+		}, nil),
+		"share folder unmount": newJSONOperationOutput(shareFolderMountInput{Folder: "/Projects", SharedFolderID: "84528192421", DryRun: true}, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, shareFolderJSONKindFolder, shareFolderMountInput{Folder: "/Projects", SharedFolderID: "84528192421", DryRun: true}, shareFolderRefJSON{SharedFolderID: "84528192421"}),
+		}, nil),
+		"share folder unshare": newJSONOperationOutput(shareFolderUnshareInput{Folder: "/Projects", SharedFolderID: "84528192421", LeaveACopy: true}, []jsonOperationResult{
+			newJSONOperationResult(shareFolderStatusUnshared, shareFolderJSONKindFolder, shareFolderUnshareInput{Folder: "/Projects", SharedFolderID: "84528192421", LeaveACopy: true}, shareFolderRefJSON{SharedFolderID: "84528192421"}),
+		}, nil),
+		"share folder update-policy": newJSONOperationOutput(shareFolderUpdatePolicyInput{Folder: "/Projects", SharedFolderID: "84528192421", MemberPolicy: "team", ViewerInfoPolicy: "disabled", DryRun: true}, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, shareFolderJSONKindFolder, shareFolderUpdatePolicyInput{Folder: "/Projects", SharedFolderID: "84528192421", MemberPolicy: "team", ViewerInfoPolicy: "disabled", DryRun: true}, sampleShareFolderJSONMetadata()),
+		}, nil),
 		"share list folder": newJSONOperationOutput(shareFolderListInput{}, []jsonOperationResult{
 			newJSONOperationResult(shareFolderJSONStatusListed, shareFolderJSONKindFolder, nil, sampleShareFolderJSONMetadata()),
 		}, nil),
@@ -772,12 +1134,24 @@ func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 		"share-link list": newJSONOperationOutput(shareLinkListInput{Path: "/Reports/old.pdf", DirectOnly: true}, []jsonOperationResult{
 			shareLinkJSONOperationResult(shareLinkJSONStatusListed, sharedLink),
 		}, nil),
+		"share-link ls": newJSONOperationOutput(shareLinkLsInput{URL: sharedLink.URL, Path: "/Reports", Recursive: true, Long: true, Sort: "name", Reverse: false, Time: "server", Password: true}, []jsonOperationResult{
+			newJSONOperationResult(lsJSONStatusListed, file.Type, nil, file),
+		}, nil),
 		"share-link revoke": newJSONOperationOutput(shareLinkRevokeInput{Path: "/Reports/old.pdf", DryRun: false}, []jsonOperationResult{
 			newJSONOperationResult(shareLinkJSONStatusRevoked, sharedLink.Type, shareLinkRevokeResultInput{DryRun: false}, shareLinkRevokeResult{URL: sharedLink.URL, Link: &sharedLink}),
 		}, nil),
 		"share-link update": newJSONOperationOutput(shareLinkUpdateInput{URL: sharedLink.URL, Audience: "public", Expires: "2026-07-01T00:00:00Z", RemoveExpiration: false, AllowDownload: true, DisallowDownload: false, Password: true, RemovePassword: false, DryRun: false}, []jsonOperationResult{
 			shareLinkUpdateOperationResult(shareLinkJSONStatusUpdated, sharedLink, shareLinkUpdateOptions{dryRun: false}),
 		}, nil),
+		"tag add": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(tagStatusAdded, tagKindTag, tagInput{Path: "/Reports/old.pdf", Tag: "approved"}, tagResult{Path: "/Reports/old.pdf", Tag: "approved"}),
+		}, nil),
+		"tag list": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(tagStatusListed, file.Type, nil, pathTagsResult{Path: "/Reports/old.pdf", Tags: []string{"approved", "q3"}}),
+		}, nil),
+		"tag remove": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(tagStatusRemoved, tagKindTag, tagInput{Path: "/Reports/old.pdf", Tag: "draft"}, tagResult{Path: "/Reports/old.pdf", Tag: "draft"}),
+		}, nil),
 		"team add-member": newJSONOperationOutput(teamMemberAddInput{Email: "ada@example.com", FirstName: "Ada", LastName: "Lovelace"}, []jsonOperationResult{
 			newJSONOperationResult(teamJSONStatusAdded, teamJSONKindTeamMember, teamMemberAddInput{Email: "ada@example.com", FirstName: "Ada", LastName: "Lovelace"}, teamMemberMutationJSON{
 				Type: teamJSONTypeMemberAdd,
@@ -801,6 +1175,24 @@ func jsonGoldenSuccessOutputExamples() map[string]jsonOperationOutput {
 		"team remove-member": newJSONOperationOutput(teamMemberRemoveInput{Email: "ada@example.com"}, []jsonOperationResult{
 			newJSONOperationResult(teamJSONStatusRemoved, teamJSONKindTeamMember, teamMemberRemoveInput{Email: "ada@example.com"}, teamMemberMutationJSON{Type: teamJSONTypeMemberRemove, Tag: "complete", AsyncJobID: "async-job-id"}),
 		}, nil),
+		"team share-links audit": newJSONOperationOutput(teamShareLinkAuditInput{Policy: "link-policy.json"}, []jsonOperationResult{
+			newJSONOperationResult(teamShareLinkAuditStatusViolation, "file", nil, teamShareLinkAuditJSON{Type: "file", TeamMemberID: "dbmid:ada", Email: "ada@example.com", URL: "https://www.dropbox.com/s/example/report.pdf", PathLower: "/reports/report.pdf", Audience: "public", AccessLevel: "viewer", AllowDownload: true, Violations: []string{"public-links-expire"}}),
+		}, nil),
+		"temp-link get": newJSONOperationOutput(tempLinkInput{Path: "/Builds/app.tar.gz"}, []jsonOperationResult{
+			newJSONOperationResult(tempLinkStatusCreated, tempLinkKindDownload, tempLinkInput{Path: "/Builds/app.tar.gz"}, tempLinkJSON{Link: "https://uc.dl.dropboxusercontent.com/cd/0/get/abc/file", Expires: "2026-05-01T16:00:00Z", Metadata: &file}),
+		}, nil),
+		"temp-link upload": newJSONOperationOutput(tempLinkUploadInput{Path: "/Builds/app.tar.gz", IfExists: putIfExistsOverwrite, Duration: "4h0m0s"}, []jsonOperationResult{
+			newJSONOperationResult(tempLinkStatusCreated, tempLinkKindUpload, tempLinkInput{Path: "/Builds/app.tar.gz"}, tempLinkJSON{Link: "https://content.dropboxapi.com/apitul/1/abc", Expires: "2026-05-01T16:00:00Z"}),
+		}, nil),
+		"thumbnail": newJSONOperationOutput(thumbnailInput{Paths: []string{"/Photos/cover.jpg", "/Photos/notes.txt"}, OutDir: "thumbs", Size: "w256h256", Format: "jpeg", Mode: "strict"}, []jsonOperationResult{
+			newJSONOperationResult(getStatusDownloaded, thumbnailKind, getResultInput{Source: "/Photos/cover.jpg", Target: "thumbs/cover.jpg"}, sampleJSONFileMetadata("/Photos/cover.jpg")),
+		}, []jsonWarning{{Code: jsonWarningCodeThumbnailFailed, Message: "thumbnail /Photos/notes.txt: unsupported_extension", Path: "/Photos/notes.txt"}}),
+		"undelete": newJSONOperationOutput(undeleteInput{Path: "/Reports", Since: "2026-06-01T00:00:00Z", Match: "*.pdf", Workers: 4}, []jsonOperationResult{
+			newJSONOperationResult(restoreStatusRestored, restoreKindFile, restoreInput{Path: "/Reports/old.pdf", Revision: "015f"}, file),
+		}, nil),
+		"unlock": newJSONOperationOutput(nil, []jsonOperationResult{
+			newJSONOperationResult(lockStatusUnlocked, lockKindFile, lockInput{Path: "/Reports/old.pdf"}, fileLockJSON{Metadata: file}),
+		}, nil),
 		"version": newJSONOperationOutput(versionInput{}, []jsonOperationResult{
 			newJSONOperationResult(versionJSONStatusReported, versionKindVersion, versionInput{}, versionOutput{Version: "1.2.3", SDKVersion: "sdk-version", SpecVersion: "spec-version"}),
 		}, nil),
@@ -839,6 +1231,10 @@ func jsonGoldenErrorOutputExamples() map[string]jsonErrorResponse {
 				pathErrorDetails("/Reports/old.pdf"),
 			),
 		),
+		"hash_mismatch": newJSONErrorResponse(
+			jsonErrorExampleCommand("hash"),
+			newCodedError(jsonErrorCodeHashMismatch, errors.New("hash check failed: 1 of 3 file(s) do not match Dropbox, starting with photos/cat.jpg"), mergeJSONErrorDetails(operationErrorDetails(hashOperationCheck), pathErrorDetails("photos/cat.jpg"), hashCheckFailureErrorDetails([]hashResult{{input: hashResultInput{Path: "photos/cat.jpg", Remote: "/Photos/cat.jpg"}, check: hashCheckFailed}}))),
+		),
 		"invalid_arguments": newJSONErrorResponse(
 			jsonErrorExampleCommand("put"),
 			invalidArgumentsErrorfWithDetails("invalid --if-exists %q (use overwrite, skip, autorename, or fail)", flagValueErrorDetails("if-exists", "replace"), "replace"),
@@ -864,6 +1260,13 @@ func jsonGoldenErrorOutputExamples() map[string]jsonErrorResponse {
 				pathErrorDetails("/upload.bin"),
 			),
 		),
+		"rev_conflict": newJSONErrorResponse(
+			jsonErrorExampleCommand("put"),
+			withJSONErrorDetails(
+				revConflictError("/config.yaml", "015f3a2b1c0d0000001", errors.New("/config.yaml no longer matches revision 015f3a2b1c0d0000000; current revision is 015f3a2b1c0d0000001")),
+				operationErrorDetails("upload"),
+			),
+		),
 		"restore_revision": newJSONErrorResponse(
 			jsonErrorExampleCommand("restore"),
 			withJSONErrorDetails(
@@ -965,6 +1368,13 @@ func sampleShareFolderJSONMetadata() shareFolderJSONMetadata {
 		ParentFolderName:     "Parent",
 		TimeInvited:          jsonContractStringPtr("2026-06-25T10:00:00Z"),
 		AccessInheritance:    "inherit",
+		Policy: &shareFolderPolicyJSON{
+			MemberPolicy:         "anyone",
+			ResolvedMemberPolicy: "team",
+			AclUpdatePolicy:      "owner",
+			SharedLinkPolicy:     "members",
+			ViewerInfoPolicy:     "enabled",
+		},
 	}
 }
 
@@ -1029,82 +1439,168 @@ func jsonContractStringPtr(value string) *string {
 
 func jsonContractDefinitions() map[string][]string {
 	return normalizeStringSliceMap(map[string][]string{
-		"account":                        jsonFieldNames[jsonAccount](),
-		"account_auth":                   jsonFieldNames[accountAuth](),
-		"account_input":                  jsonFieldNames[accountInput](),
-		"account_name":                   jsonFieldNames[jsonAccountName](),
-		"account_team":                   jsonFieldNames[jsonAccountTeam](),
-		"du_allocation":                  jsonFieldNames[duAllocation](),
-		"du_output":                      jsonFieldNames[duOutput](),
-		"empty":                          {},
-		"command_arg":                    jsonFieldNames[jsonCommandArg](),
-		"command_example":                jsonFieldNames[jsonCommandExample](),
-		"command_flag":                   jsonFieldNames[jsonCommandFlag](),
-		"command_input_property":         jsonFieldNames[jsonCommandInputProperty](),
-		"command_input_schema":           jsonFieldNames[jsonCommandInputSchema](),
-		"command_manifest":               jsonFieldNames[jsonCommandManifest](),
-		"command_schema_refs":            jsonFieldNames[jsonCommandSchemaRefs](),
-		"command_stdin_stdout":           jsonFieldNames[jsonCommandStdinStdout](),
-		"get_input":                      jsonFieldNames[getCommandInput](),
-		"get_result_input":               jsonFieldNames[getResultInput](),
-		"help_input":                     jsonFieldNames[jsonHelpInput](),
-		"ls_input":                       jsonFieldNames[lsInput](),
-		"logout_result":                  jsonFieldNames[logoutResult](),
-		"metadata":                       jsonFieldNames[jsonMetadata](),
-		"mkdir_input":                    jsonFieldNames[mkdirInput](),
-		"operation_output":               jsonFieldNames[jsonOperationOutput](),
-		"operation_result":               jsonFieldNames[jsonOperationResult](),
-		"put_input":                      jsonFieldNames[putCommandInput](),
-		"put_result_input":               jsonFieldNames[putResultInput](),
-		"relocation_input":               jsonFieldNames[relocationInput](),
-		"remove_input":                   jsonFieldNames[removeInput](),
-		"restore_input":                  jsonFieldNames[restoreInput](),
-		"revs_input":                     jsonFieldNames[revsInput](),
-		"search_input":                   jsonFieldNames[searchInput](),
-		"share_folder":                   jsonFieldNames[shareFolderJSONMetadata](),
-		"share_link_create_input":        jsonFieldNames[shareLinkCreateInput](),
-		"share_link_create_result_input": jsonFieldNames[shareLinkCreateResultInput](),
-		"share_link_download_input":      jsonFieldNames[shareLinkDownloadInput](),
-		"share_link_download_result":     jsonFieldNames[shareLinkDownloadResult](),
-		"share_link_info_input":          jsonFieldNames[shareLinkInfoInput](),
-		"share_link_list_input":          jsonFieldNames[shareLinkListInput](),
-		"share_link_metadata":            jsonFieldNames[shareLinkJSONMetadata](),
-		"share_link_permissions":         jsonFieldNames[shareLinkJSONPermissions](),
-		"share_link_revoke_input":        jsonFieldNames[shareLinkRevokeInput](),
-		"share_link_revoke_result_input": jsonFieldNames[shareLinkRevokeResultInput](),
-		"share_link_revoke_result":       jsonFieldNames[shareLinkRevokeResult](),
-		"share_link_update_input":        jsonFieldNames[shareLinkUpdateInput](),
-		"share_link_update_result_input": jsonFieldNames[shareLinkUpdateResultInput](),
-		"team_group":                     jsonFieldNames[teamGroupJSON](),
-		"team_info":                      jsonFieldNames[teamInfoJSON](),
-		"team_member":                    jsonFieldNames[teamMemberJSON](),
-		"team_member_add_input":          jsonFieldNames[teamMemberAddInput](),
-		"team_member_add_item":           jsonFieldNames[teamMemberAddItemJSON](),
-		"team_member_mutation":           jsonFieldNames[teamMemberMutationJSON](),
-		"team_member_remove_input":       jsonFieldNames[teamMemberRemoveInput](),
-		"version":                        jsonFieldNames[versionOutput](),
+		"account":                          jsonFieldNames[jsonAccount](),
+		"account_auth":                     jsonFieldNames[accountAuth](),
+		"account_input":                    jsonFieldNames[accountInput](),
+		"account_name":                     jsonFieldNames[jsonAccountName](),
+		"account_team":                     jsonFieldNames[jsonAccountTeam](),
+		"du_allocation":                    jsonFieldNames[duAllocation](),
+		"du_output":                        jsonFieldNames[duOutput](),
+		"empty":                            {},
+		"file_request":                     jsonFieldNames[jsonFileRequest](),
+		"file_request_create_input":        jsonFieldNames[fileRequestCreateInput](),
+		"file_request_delete_input":        jsonFieldNames[fileRequestDeleteInput](),
+		"file_request_input":               jsonFieldNames[fileRequestInput](),
+		"file_request_list_input":          jsonFieldNames[fileRequestListInput](),
+		"file_request_result_input":        jsonFieldNames[fileRequestResultInput](),
+		"file_request_update_input":        jsonFieldNames[fileRequestUpdateInput](),
+		"command_arg":                      jsonFieldNames[jsonCommandArg](),
+		"command_example":                  jsonFieldNames[jsonCommandExample](),
+		"command_flag":                     jsonFieldNames[jsonCommandFlag](),
+		"command_input_property":           jsonFieldNames[jsonCommandInputProperty](),
+		"command_input_schema":             jsonFieldNames[jsonCommandInputSchema](),
+		"command_manifest":                 jsonFieldNames[jsonCommandManifest](),
+		"command_schema_refs":              jsonFieldNames[jsonCommandSchemaRefs](),
+		"command_stdin_stdout":             jsonFieldNames[jsonCommandStdinStdout](),
+		"file_lock":                        jsonFieldNames[fileLockJSON](),
+		"get_input":                        jsonFieldNames[getCommandInput](),
+		"get_result_input":                 jsonFieldNames[getResultInput](),
+		"hash":                             jsonFieldNames[hashJSON](),
+		"hash_input":                       jsonFieldNames[hashInput](),
+		"hash_result_input":                jsonFieldNames[hashResultInput](),
+		"help_input":                       jsonFieldNames[jsonHelpInput](),
+		"lock_input":                       jsonFieldNames[lockInput](),
+		"ls_input":                         jsonFieldNames[lsInput](),
+		"logout_result":                    jsonFieldNames[logoutResult](),
+		"metadata":                         jsonFieldNames[jsonMetadata](),
+		"mkdir_input":                      jsonFieldNames[mkdirInput](),
+		"operation_output":                 jsonFieldNames[jsonOperationOutput](),
+		"operation_result":                 jsonFieldNames[jsonOperationResult](),
+		"path_tags":                        jsonFieldNames[pathTagsResult](),
+		"property_field":                   jsonFieldNames[jsonPropertyField](),
+		"property_field_template":          jsonFieldNames[jsonPropertyFieldTemplate](),
+		"property_group":                   jsonFieldNames[jsonPropertyGroup](),
+		"property_group_result":            jsonFieldNames[propertyGroupResult](),
+		"property_template":                jsonFieldNames[jsonPropertyTemplate](),
+		"preview_input":                    jsonFieldNames[previewInput](),
+		"props_get_input":                  jsonFieldNames[propsGetInput](),
+		"props_input":                      jsonFieldNames[propsInput](),
+		"props_templates_input":            jsonFieldNames[propsTemplatesInput](),
+		"put_input":                        jsonFieldNames[putCommandInput](),
+		"put_result_input":                 jsonFieldNames[putResultInput](),
+		"relocation_input":                 jsonFieldNames[relocationInput](),
+		"remove_input":                     jsonFieldNames[removeInput](),
+		"restore_input":                    jsonFieldNames[restoreInput](),
+		"revs_input":                       jsonFieldNames[revsInput](),
+		"save_url":                         jsonFieldNames[saveURLJSON](),
+		"save_url_input":                   jsonFieldNames[saveURLInput](),
+		"save_url_result_input":            jsonFieldNames[saveURLResultInput](),
+		"save_url_status_input":            jsonFieldNames[saveURLStatusInput](),
+		"search_input":                     jsonFieldNames[searchInput](),
+		"share_file_input":                 jsonFieldNames[shareFileInput](),
+		"share_file_member_change":         jsonFieldNames[shareFileMemberChangeJSON](),
+		"share_file_members_input":         jsonFieldNames[shareFileMembersInput](),
+		"share_file_membership_input":      jsonFieldNames[shareFileMembershipInput](),
+		"share_folder":                     jsonFieldNames[shareFolderJSONMetadata](),
+		"share_folder_list_input":          jsonFieldNames[shareFolderListFoldersInput](),
+		"share_folder_member":              jsonFieldNames[shareFolderMemberJSON](),
+		"share_folder_member_change":       jsonFieldNames[shareFolderMemberChangeJSON](),
+		"share_folder_member_input":        jsonFieldNames[shareFolderMemberInput](),
+		"share_folder_members_input":       jsonFieldNames[shareFolderMembersInput](),
+		"share_folder_membership_input":    jsonFieldNames[shareFolderMembershipInput](),
+		"share_folder_create_input":        jsonFieldNames[shareFolderCreateInput](),
+		"share_folder_mount_input":         jsonFieldNames[shareFolderMountInput](),
+		"share_folder_policy":              jsonFieldNames[shareFolderPolicyJSON](),
+		"share_folder_ref":                 jsonFieldNames[shareFolderRefJSON](),
+		"share_folder_unshare_input":       jsonFieldNames[shareFolderUnshareInput](),
+		"share_folder_update_policy_input": jsonFieldNames[shareFolderUpdatePolicyInput](),
+		"share_link_create_input":          jsonFieldNames[shareLinkCreateInput](),
+		"share_link_create_result_input":   jsonFieldNames[shareLinkCreateResultInput](),
+		"share_link_download_input":        jsonFieldNames[shareLinkDownloadInput](),
+		"share_link_download_result":       jsonFieldNames[shareLinkDownloadResult](),
+		"share_link_info_input":            jsonFieldNames[shareLinkInfoInput](),
+		"share_link_list_input":            jsonFieldNames[shareLinkListInput](),
+		"share_link_ls_input":              jsonFieldNames[shareLinkLsInput](),
+		"share_link_metadata":              jsonFieldNames[shareLinkJSONMetadata](),
+		"share_link_permissions":           jsonFieldNames[shareLinkJSONPermissions](),
+		"share_link_revoke_input":          jsonFieldNames[shareLinkRevokeInput](),
+		"share_link_revoke_result_input":   jsonFieldNames[shareLinkRevokeResultInput](),
+		"share_link_revoke_result":         jsonFieldNames[shareLinkRevokeResult](),
+		"share_link_update_input":          jsonFieldNames[shareLinkUpdateInput](),
+		"share_link_update_result_input":   jsonFieldNames[shareLinkUpdateResultInput](),
+		"tag_input":                        jsonFieldNames[tagInput](),
+		"tag_result":                       jsonFieldNames[tagResult](),
+		"team_group":                       jsonFieldNames[teamGroupJSON](),
+		"team_info":                        jsonFieldNames[teamInfoJSON](),
+		"team_member":                      jsonFieldNames[teamMemberJSON](),
+		"team_member_add_input":            jsonFieldNames[teamMemberAddInput](),
+		"team_member_add_item":             jsonFieldNames[teamMemberAddItemJSON](),
+		"team_member_mutation":             jsonFieldNames[teamMemberMutationJSON](),
+		"team_member_remove_input":         jsonFieldNames[teamMemberRemoveInput](),
+		"team_share_link_audit":            jsonFieldNames[teamShareLinkAuditJSON](),
+		"team_share_link_audit_input":      jsonFieldNames[teamShareLinkAuditInput](),
+		"temp_link":                        jsonFieldNames[tempLinkJSON](),
+		"temp_link_input":                  jsonFieldNames[tempLinkInput](),
+		"temp_link_upload_input":           jsonFieldNames[tempLinkUploadInput](),
+		"thumbnail_input":                  jsonFieldNames[thumbnailInput](),
+		"undelete_input":                   jsonFieldNames[undeleteInput](),
+		"version":                          jsonFieldNames[versionOutput](),
 	})
 }
 
 func jsonCommandSchemas() map[string]jsonGoldenCommandSchema {
 	return map[string]jsonGoldenCommandSchema{
-		"account":           operationSchema("account_input", schemaRef("account_input"), "account", []string{accountJSONStatusFound}, []string{accountKindAccount}, nil),
-		"cp":                operationSchema("empty", schemaRef("relocation_input"), "metadata", []string{relocationJSONStatusAutorenamed, relocationJSONStatusCopied, relocationJSONStatusSkipped, jsonStatusPlanned}, metadataKinds(), nil),
-		"du":                operationSchema("empty", schemaRef("empty"), "du_output", []string{duJSONStatusReported}, []string{duKindSpaceUsage}, nil),
-		"get":               operationSchema("get_input", schemaRef("get_result_input"), "metadata", []string{getStatusCreated, getStatusDownloaded, getStatusExisting}, []string{getKindFile, getKindFolder}, nil),
-		"help":              operationSchema("help_input", schemaRef("empty"), "command_manifest", []string{jsonHelpStatusDescribed}, []string{jsonHelpKindCommand}, nil),
-		"ls":                operationSchema("ls_input", schemaRef("empty"), "metadata", []string{lsJSONStatusListed}, metadataKinds(), nil),
-		"logout":            operationSchema("empty", schemaRef("empty"), "logout_result", []string{logoutStatusAlreadyLoggedOut, logoutStatusLoggedOut}, []string{logoutKindAuth}, []string{jsonWarningCodeTokenRevokeFailed}),
-		"mkdir":             operationSchema("mkdir_input", schemaRef("mkdir_input"), "metadata", []string{mkdirStatusCreated, mkdirStatusExisting, jsonStatusPlanned}, []string{mkdirKindFolder}, nil),
-		"mv":                operationSchema("empty", schemaRef("relocation_input"), "metadata", []string{relocationJSONStatusAutorenamed, relocationJSONStatusMoved, relocationJSONStatusSkipped, jsonStatusPlanned}, metadataKinds(), nil),
-		"put":               operationSchema("put_input", schemaRef("put_result_input"), "metadata", []string{putStatusAutorenamed, putStatusCreated, putStatusExisting, putStatusSkipped, putStatusUploaded, jsonStatusPlanned}, []string{putKindFile, putKindFolder}, []string{jsonWarningCodeSkippedSymlink}),
-		"restore":           operationSchema("restore_input", schemaRef("restore_input"), "metadata", []string{restoreStatusRestored, jsonStatusPlanned}, []string{restoreKindFile}, nil),
-		"revs":              operationSchema("revs_input", schemaRef("empty"), "metadata", []string{revsJSONStatusRevision}, []string{"file"}, nil),
-		"rm":                operationSchema("empty", schemaRef("remove_input"), "metadata", []string{removeJSONStatusDeleted, removeJSONStatusPermanentlyDeleted, jsonStatusPlanned}, metadataKinds(), nil),
-		"search":            operationSchema("search_input", schemaRef("empty"), "metadata", []string{searchJSONStatusFound}, metadataKinds(), nil),
-		"share list folder": operationSchema("empty", schemaRef("empty"), "share_folder", []string{shareFolderJSONStatusListed}, []string{shareFolderJSONKindFolder}, nil),
-		"share list link":   operationSchema("share_link_list_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusListed}, shareLinkKinds(), []string{jsonWarningCodeDeprecatedCommand}),
-		"share-link create": operationSchema("share_link_create_input", schemaRef("share_link_create_result_input"), "share_link_metadata", []string{shareLinkJSONStatusCreated, shareLinkJSONStatusExisting, jsonStatusPlanned}, shareLinkKinds(), nil),
+		"account":                    operationSchema("account_input", schemaRef("account_input"), "account", []string{accountJSONStatusFound}, []string{accountKindAccount}, nil),
+		"cp":                         operationSchema("empty", schemaRef("relocation_input"), "metadata", []string{relocationJSONStatusAutorenamed, relocationJSONStatusCopied, relocationJSONStatusSkipped, jsonStatusPlanned}, metadataKinds(), nil),
+		"file-request close":         operationSchema("empty", schemaRef("file_request_input"), "file_request", []string{fileRequestStatusClosed, jsonStatusPlanned}, []string{fileRequestKind}, nil),
+		"file-request create":        operationSchema("file_request_create_input", schemaRef("file_request_result_input"), "file_request", []string{fileRequestStatusCreated, jsonStatusPlanned}, []string{fileRequestKind}, nil),
+		"file-request delete":        operationSchema("file_request_delete_input", schemaRef("file_request_input"), "file_request", []string{fileRequestStatusDeleted, jsonStatusPlanned}, []string{fileRequestKind}, nil),
+		"file-request get":           operationSchema("file_request_input", schemaRef("empty"), "file_request", []string{fileRequestStatusFound}, []string{fileRequestKind}, nil),
+		"file-request list":          operationSchema("file_request_list_input", schemaRef("empty"), "file_request", []string{fileRequestStatusListed}, []string{fileRequestKind}, nil),
+		"file-request update":        operationSchema("file_request_update_input", schemaRef("file_request_result_input"), "file_request", []string{fileRequestStatusUpdated, jsonStatusPlanned}, []string{fileRequestKind}, nil),
+		"du":                         operationSchema("empty", schemaRef("empty"), "du_output", []string{duJSONStatusReported}, []string{duKindSpaceUsage}, nil),
+		"get":                        operationSchema("get_input", schemaRef("get_result_input"), "metadata", []string{getStatusCreated, getStatusDownloaded, getStatusExisting}, []string{getKindFile, getKindFolder, getKindZip}, nil),
+		"hash":                       operationSchema("hash_input", schemaRef("hash_result_input"), "hash", []string{hashStatusHashed, hashStatusMatched}, []string{hashKindFile}, nil),
+		"help":                       operationSchema("help_input", schemaRef("empty"), "command_manifest", []string{jsonHelpStatusDescribed}, []string{jsonHelpKindCommand}, nil),
+		"ls":                         operationSchema("ls_input", schemaRef("empty"), "metadata", []string{lsJSONStatusListed}, metadataKinds(), nil),
+		"lock":                       operationSchema("empty", schemaRef("lock_input"), "file_lock", []string{lockStatusLocked}, []string{lockKindFile}, []string{jsonWarningCodeFileLockFailed}),
+		"lock status":                operationSchema("empty", schemaRef("lock_input"), "file_lock", []string{lockStatusLocked, lockStatusUnlocked}, []string{lockKindFile}, []string{jsonWarningCodeFileLockFailed}),
+		"logout":                     operationSchema("empty", schemaRef("empty"), "logout_result", []string{logoutStatusAlreadyLoggedOut, logoutStatusLoggedOut}, []string{logoutKindAuth}, []string{jsonWarningCodeTokenRevokeFailed}),
+		"mkdir":                      operationSchema("mkdir_input", schemaRef("mkdir_input"), "metadata", []string{mkdirStatusCreated, mkdirStatusExisting, jsonStatusPlanned}, []string{mkdirKindFolder}, nil),
+		"mv":                         operationSchema("empty", schemaRef("relocation_input"), "metadata", []string{relocationJSONStatusAutorenamed, relocationJSONStatusMoved, relocationJSONStatusSkipped, jsonStatusPlanned}, metadataKinds(), nil),
+		"preview":                    operationSchema("preview_input", schemaRef("get_result_input"), "metadata", []string{getStatusDownloaded}, []string{previewKind}, nil),
+		"props get":                  operationSchema("props_get_input", schemaRef("empty"), "metadata", []string{propsStatusFound}, []string{"file", "folder"}, nil),
+		"props remove":               operationSchema("empty", schemaRef("props_input"), "property_group_result", []string{propsStatusRemoved, jsonStatusPlanned}, []string{propsKindPropertyGroup}, nil),
+		"props set":                  operationSchema("empty", schemaRef("props_input"), "property_group_result", []string{propsStatusSet, jsonStatusPlanned}, []string{propsKindPropertyGroup}, nil),
+		"props templates add":        operationSchema("props_templates_input", schemaRef("empty"), "property_template", []string{propsTemplateStatusAdded}, []string{propsKindPropertyTemplate}, nil),
+		"props templates get":        operationSchema("props_templates_input", schemaRef("empty"), "property_template", []string{propsTemplateStatusFound}, []string{propsKindPropertyTemplate}, nil),
+		"props templates list":       operationSchema("props_templates_input", schemaRef("empty"), "property_template", []string{propsTemplateStatusListed}, []string{propsKindPropertyTemplate}, nil),
+		"props update":               operationSchema("empty", schemaRef("props_input"), "property_group_result", []string{propsStatusUpdated, jsonStatusPlanned}, []string{propsKindPropertyGroup}, nil),
+		"put":                        operationSchema("put_input", schemaRef("put_result_input"), "metadata", []string{putStatusAutorenamed, putStatusCreated, putStatusExisting, putStatusSkipped, putStatusUploaded, jsonStatusPlanned}, []string{putKindFile, putKindFolder}, []string{jsonWarningCodeSkippedLink, jsonWarningCodeSkippedSymlink, jsonWarningCodeSkippedUnsupportedEntry, jsonWarningCodeUploadFailed}),
+		"restore":                    operationSchema("restore_input", schemaRef("restore_input"), "metadata", []string{restoreStatusRestored, jsonStatusPlanned}, []string{restoreKindFile}, nil),
+		"revs":                       operationSchema("revs_input", schemaRef("empty"), "metadata", []string{revsJSONStatusRevision}, []string{"file"}, nil),
+		"rm":                         operationSchema("empty", schemaRef("remove_input"), "metadata", []string{removeJSONStatusDeleted, removeJSONStatusPermanentlyDeleted, jsonStatusPlanned}, metadataKinds(), nil),
+		"save-url":                   operationSchema("save_url_input", schemaRef("save_url_result_input"), "save_url", []string{saveURLStatusAutorenamed, saveURLStatusSaved, saveURLStatusSkipped, saveURLStatusStarted, jsonStatusPlanned}, []string{saveURLKindFile}, nil),
+		"save-url status":            operationSchema("save_url_status_input", schemaRef("empty"), "save_url", []string{saveURLStatusInProgress, saveURLStatusSaved}, []string{saveURLKindFile}, nil),
+		"search":                     operationSchema("search_input", schemaRef("empty"), "metadata", []string{searchJSONStatusFound}, metadataKinds(), nil),
+		"share file invite":          operationSchema("share_file_membership_input", schemaRef("share_folder_member_input"), "share_file_member_change", []string{shareFolderMemberStatusInvited, jsonStatusPlanned}, []string{shareFolderMemberKindGroup, shareFolderMemberKindUser}, []string{jsonWarningCodeFileSharingFailed}),
+		"share file members":         operationSchema("share_file_members_input", schemaRef("share_file_input"), "share_folder_member", []string{shareFolderMemberStatusListed}, []string{shareFolderMemberKindGroup, shareFolderMemberKindInvitee, shareFolderMemberKindUser}, []string{jsonWarningCodeFileSharingFailed}),
+		"share file remove":          operationSchema("share_file_membership_input", schemaRef("share_folder_member_input"), "share_file_member_change", []string{shareFolderMemberStatusRemoved, jsonStatusPlanned}, []string{shareFolderMemberKindGroup, shareFolderMemberKindUser}, nil),
+		"share file unshare":         operationSchema("share_file_input", schemaRef("share_file_input"), "share_file_member_change", []string{shareFileStatusUnshared, jsonStatusPlanned}, []string{shareFileKindFile}, nil),
+		"share folder create":        operationSchema("share_folder_create_input", schemaRef("share_folder_create_input"), "share_folder", []string{shareFolderStatusShared, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder invite":        operationSchema("share_folder_membership_input", schemaRef("share_folder_member_input"), "share_folder_member_change", []string{shareFolderMemberStatusInvited, jsonStatusPlanned}, []string{shareFolderMemberKindGroup, shareFolderMemberKindUser}, nil),
+		"share folder leave":         operationSchema("share_folder_mount_input", schemaRef("share_folder_mount_input"), "share_folder_ref", []string{shareFolderStatusLeft, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder list":          operationSchema("share_folder_list_input", schemaRef("empty"), "share_folder", []string{shareFolderJSONStatusListed}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder members":       operationSchema("share_folder_members_input", schemaRef("empty"), "share_folder_member", []string{shareFolderMemberStatusListed}, []string{shareFolderMemberKindGroup, shareFolderMemberKindInvitee, shareFolderMemberKindUser}, nil),
+		"share folder mount":         operationSchema("share_folder_mount_input", schemaRef("share_folder_mount_input"), "share_folder", []string{shareFolderStatusMounted, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder remove":        operationSchema("share_folder_membership_input", schemaRef("share_folder_member_input"), "share_folder_member_change", []string{shareFolderMemberStatusRemoved, jsonStatusPlanned}, []string{shareFolderMemberKindGroup, shareFolderMemberKindUser}, []string{jsonWarningCodeMemberRemoveFailed}),
+		"share folder set-access":    operationSchema("share_folder_membership_input", schemaRef("share_folder_member_input"), "share_folder_member_change", []string{shareFolderMemberStatusUpdated, jsonStatusPlanned}, []string{shareFolderMemberKindGroup, shareFolderMemberKindUser}, nil),
+		"share folder unmount":       operationSchema("share_folder_mount_input", schemaRef("share_folder_mount_input"), "share_folder_ref", []string{shareFolderStatusUnmounted, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder unshare":       operationSchema("share_folder_unshare_input", schemaRef("share_folder_unshare_input"), "share_folder_ref", []string{shareFolderStatusUnshared, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share folder update-policy": operationSchema("share_folder_update_policy_input", schemaRef("share_folder_update_policy_input"), "share_folder", []string{shareFolderStatusUpdated, jsonStatusPlanned}, []string{shareFolderJSONKindFolder}, nil),
+		"share list folder":          operationSchema("empty", schemaRef("empty"), "share_folder", []string{shareFolderJSONStatusListed}, []string{shareFolderJSONKindFolder}, nil),
+		"share list link":            operationSchema("share_link_list_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusListed}, shareLinkKinds(), []string{jsonWarningCodeDeprecatedCommand}),
+		"share-link create":          operationSchema("share_link_create_input", schemaRef("share_link_create_result_input"), "share_link_metadata", []string{shareLinkJSONStatusCreated, shareLinkJSONStatusExisting, jsonStatusPlanned}, shareLinkKinds(), []string{jsonWarningCodeShareLinkCreateFailed}),
 		"share-link download": operationSchema(
 			"share_link_download_input",
 			schemaRef("empty"),
@@ -1113,16 +1609,26 @@ func jsonCommandSchemas() map[string]jsonGoldenCommandSchema {
 			shareLinkKinds(),
 			nil,
 		),
-		"share-link info":    operationSchema("share_link_info_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusFound}, shareLinkKinds(), nil),
-		"share-link list":    operationSchema("share_link_list_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusListed}, shareLinkKinds(), nil),
-		"share-link revoke":  operationSchema("share_link_revoke_input", schemaRef("share_link_revoke_result_input"), "share_link_revoke_result", []string{shareLinkJSONStatusRevoked, jsonStatusPlanned}, append(shareLinkKinds(), shareLinkJSONKindSharedLink), nil),
-		"share-link update":  operationSchema("share_link_update_input", schemaRef("share_link_update_result_input"), "share_link_metadata", []string{shareLinkJSONStatusUpdated, jsonStatusPlanned}, shareLinkKinds(), nil),
-		"team add-member":    operationSchema("team_member_add_input", schemaRef("team_member_add_input"), "team_member_mutation", []string{teamJSONStatusAdded, teamJSONStatusCompleted, teamJSONStatusStarted}, []string{teamJSONKindTeamMember}, nil),
-		"team info":          operationSchema("empty", schemaRef("empty"), "team_info", []string{teamJSONStatusFound}, []string{teamJSONKindTeam}, nil),
-		"team list-groups":   operationSchema("empty", schemaRef("empty"), "team_group", []string{teamJSONStatusListed}, []string{teamJSONKindTeamGroup}, nil),
-		"team list-members":  operationSchema("empty", schemaRef("empty"), "team_member", []string{teamJSONStatusListed}, []string{teamJSONKindTeamMember}, nil),
-		"team remove-member": operationSchema("team_member_remove_input", schemaRef("team_member_remove_input"), "team_member_mutation", []string{teamJSONStatusCompleted, teamJSONStatusRemoved, teamJSONStatusStarted}, []string{teamJSONKindTeamMember}, nil),
-		"version":            operationSchema("empty", schemaRef("empty"), "version", []string{versionJSONStatusReported}, []string{versionKindVersion}, nil),
+		"share-link info":        operationSchema("share_link_info_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusFound}, shareLinkKinds(), nil),
+		"share-link list":        operationSchema("share_link_list_input", schemaRef("empty"), "share_link_metadata", []string{shareLinkJSONStatusListed}, shareLinkKinds(), nil),
+		"share-link ls":          operationSchema("share_link_ls_input", schemaRef("empty"), "metadata", []string{lsJSONStatusListed}, []string{"file", "folder"}, nil),
+		"share-link revoke":      operationSchema("share_link_revoke_input", schemaRef("share_link_revoke_result_input"), "share_link_revoke_result", []string{shareLinkJSONStatusRevoked, jsonStatusPlanned}, append(shareLinkKinds(), shareLinkJSONKindSharedLink), []string{jsonWarningCodeShareLinkRevokeFailed}),
+		"share-link update":      operationSchema("share_link_update_input", schemaRef("share_link_update_result_input"), "share_link_metadata", []string{shareLinkJSONStatusUpdated, jsonStatusPlanned}, shareLinkKinds(), nil),
+		"tag add":                operationSchema("empty", schemaRef("tag_input"), "tag_result", []string{tagStatusAdded, jsonStatusPlanned}, []string{tagKindTag}, []string{jsonWarningCodeTagFailed}),
+		"tag list":               operationSchema("empty", schemaRef("empty"), "path_tags", []string{tagStatusListed}, []string{"file", "folder"}, nil),
+		"tag remove":             operationSchema("empty", schemaRef("tag_input"), "tag_result", []string{tagStatusRemoved, jsonStatusPlanned}, []string{tagKindTag}, []string{jsonWarningCodeTagFailed}),
+		"team add-member":        operationSchema("team_member_add_input", schemaRef("team_member_add_input"), "team_member_mutation", []string{teamJSONStatusAdded, teamJSONStatusCompleted, teamJSONStatusStarted}, []string{teamJSONKindTeamMember}, nil),
+		"team info":              operationSchema("empty", schemaRef("empty"), "team_info", []string{teamJSONStatusFound}, []string{teamJSONKindTeam}, nil),
+		"team list-groups":       operationSchema("empty", schemaRef("empty"), "team_group", []string{teamJSONStatusListed}, []string{teamJSONKindTeamGroup}, nil),
+		"team list-members":      operationSchema("empty", schemaRef("empty"), "team_member", []string{teamJSONStatusListed}, []string{teamJSONKindTeamMember}, nil),
+		"team share-links audit": operationSchema("team_share_link_audit_input", schemaRef("empty"), "team_share_link_audit", []string{teamShareLinkAuditStatusCompliant, teamShareLinkAuditStatusListed, teamShareLinkAuditStatusViolation}, shareLinkKinds(), []string{jsonWarningCodeMemberAuditFailed}),
+		"team remove-member":     operationSchema("team_member_remove_input", schemaRef("team_member_remove_input"), "team_member_mutation", []string{teamJSONStatusCompleted, teamJSONStatusRemoved, teamJSONStatusStarted}, []string{teamJSONKindTeamMember}, nil),
+		"temp-link get":          operationSchema("temp_link_input", schemaRef("temp_link_input"), "temp_link", []string{tempLinkStatusCreated}, []string{tempLinkKindDownload}, nil),
+		"temp-link upload":       operationSchema("temp_link_upload_input", schemaRef("temp_link_input"), "temp_link", []string{tempLinkStatusCreated, tempLinkStatusSkipped}, []string{tempLinkKindUpload}, nil),
+		"thumbnail":              operationSchema("thumbnail_input", schemaRef("get_result_input"), "metadata", []string{getStatusDownloaded}, []string{thumbnailKind}, []string{jsonWarningCodeThumbnailFailed}),
+		"undelete":               operationSchema("undelete_input", schemaRef("restore_input"), "metadata", []string{restoreStatusRestored, jsonStatusPlanned}, []string{restoreKindFile}, []string{jsonWarningCodeRestoreFailed}),
+		"unlock":                 operationSchema("empty", schemaRef("lock_input"), "file_lock", []string{lockStatusUnlocked}, []string{lockKindFile}, []string{jsonWarningCodeFileLockFailed}),
+		"version":                operationSchema("empty", schemaRef("empty"), "version", []string{versionJSONStatusReported}, []string{versionKindVersion}, nil),
 	}
 }
 
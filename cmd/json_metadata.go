@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 )
 
@@ -17,6 +18,23 @@ type jsonMetadata struct {
 	ServerModified *string `json:"server_modified,omitempty"`
 	ClientModified *string `json:"client_modified,omitempty"`
 	Deleted        bool    `json:"deleted,omitempty"`
+	// ExportAs and ExportOptions are only present for export-only files such
+	// as Paper docs, which `get` exports rather than downloads.
+	ExportAs      string   `json:"export_as,omitempty"`
+	ExportOptions []string `json:"export_options,omitempty"`
+	// PropertyGroups is only present when the command requested property
+	// templates, such as `ls --props` or `props get`.
+	PropertyGroups []jsonPropertyGroup `json:"property_groups,omitempty"`
+}
+
+type jsonPropertyGroup struct {
+	TemplateID string              `json:"template_id"`
+	Fields     []jsonPropertyField `json:"fields"`
+}
+
+type jsonPropertyField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 func jsonMetadataFromDropbox(metadata files.IsMetadata) (jsonMetadata, error) {
@@ -35,16 +53,20 @@ func jsonMetadataFromDropbox(metadata files.IsMetadata) (jsonMetadata, error) {
 			Size:           &size,
 			ServerModified: jsonTime(time.Time(m.ServerModified)),
 			ClientModified: jsonTime(time.Time(m.ClientModified)),
+			ExportAs:       exportFormatFor(m, ""),
+			ExportOptions:  exportFormats(m),
+			PropertyGroups: jsonPropertyGroupsFromDropbox(m.PropertyGroups),
 		}, nil
 	case *files.FolderMetadata:
 		if m == nil {
 			return jsonMetadata{}, fmt.Errorf("unexpected nil Dropbox folder metadata")
 		}
 		return jsonMetadata{
-			Type:        "folder",
-			PathDisplay: m.PathDisplay,
-			PathLower:   m.PathLower,
-			ID:          m.Id,
+			Type:           "folder",
+			PathDisplay:    m.PathDisplay,
+			PathLower:      m.PathLower,
+			ID:             m.Id,
+			PropertyGroups: jsonPropertyGroupsFromDropbox(m.PropertyGroups),
 		}, nil
 	case *files.DeletedMetadata:
 		if m == nil {
@@ -73,6 +95,33 @@ func jsonMetadataListFromDropbox(entries []files.IsMetadata) ([]jsonMetadata, er
 	return result, nil
 }
 
+func jsonPropertyGroupsFromDropbox(groups []*file_properties.PropertyGroup) []jsonPropertyGroup {
+	if len(groups) == 0 {
+		return nil
+	}
+	result := make([]jsonPropertyGroup, 0, len(groups))
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		result = append(result, jsonPropertyGroup{
+			TemplateID: group.TemplateId,
+			Fields:     jsonPropertyFieldsFromDropbox(group.Fields),
+		})
+	}
+	return result
+}
+
+func jsonPropertyFieldsFromDropbox(fields []*file_properties.PropertyField) []jsonPropertyField {
+	result := make([]jsonPropertyField, 0, len(fields))
+	for _, field := range fields {
+		if field != nil {
+			result = append(result, jsonPropertyField{Name: field.Name, Value: field.Value})
+		}
+	}
+	return result
+}
+
 func jsonTime(t time.Time) *string {
 	if t.IsZero() {
 		return nil
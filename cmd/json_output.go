@@ -27,9 +27,21 @@ type jsonWarning struct {
 }
 
 const (
-	jsonWarningCodeDeprecatedCommand = "deprecated_command"
-	jsonWarningCodeSkippedSymlink    = "skipped_symlink"
-	jsonWarningCodeTokenRevokeFailed = "token_revoke_failed"
+	jsonWarningCodeDeprecatedCommand       = "deprecated_command"
+	jsonWarningCodeFileLockFailed          = "file_lock_failed"
+	jsonWarningCodeFileSharingFailed       = "file_sharing_failed"
+	jsonWarningCodeMemberAuditFailed       = "member_audit_failed"
+	jsonWarningCodeMemberRemoveFailed      = "member_remove_failed"
+	jsonWarningCodeRestoreFailed           = "restore_failed"
+	jsonWarningCodeShareLinkCreateFailed   = "share_link_create_failed"
+	jsonWarningCodeShareLinkRevokeFailed   = "share_link_revoke_failed"
+	jsonWarningCodeSkippedLink             = "skipped_link"
+	jsonWarningCodeSkippedSymlink          = "skipped_symlink"
+	jsonWarningCodeSkippedUnsupportedEntry = "skipped_unsupported_entry"
+	jsonWarningCodeTagFailed               = "tag_failed"
+	jsonWarningCodeThumbnailFailed         = "thumbnail_failed"
+	jsonWarningCodeTokenRevokeFailed       = "token_revoke_failed"
+	jsonWarningCodeUploadFailed            = "upload_failed"
 )
 
 type jsonOperationOutput struct {
@@ -0,0 +1,439 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	lockStatusLocked   = "locked"
+	lockStatusUnlocked = "unlocked"
+	lockKindFile       = "file"
+
+	lockOperationLock   = "lock"
+	lockOperationUnlock = "unlock"
+	lockOperationStatus = "lock_status"
+)
+
+type lockInput struct {
+	Path string `json:"path"`
+}
+
+// fileLockJSON is the script-facing lock state of one file.
+type fileLockJSON struct {
+	Locked              bool         `json:"locked"`
+	IsLockholder        bool         `json:"is_lockholder"`
+	LockholderName      string       `json:"lockholder_name,omitempty"`
+	LockholderAccountID string       `json:"lockholder_account_id,omitempty"`
+	Created             *string      `json:"created,omitempty"`
+	Metadata            jsonMetadata `json:"metadata"`
+}
+
+type lockResult struct {
+	Status string       `json:"status"`
+	Input  lockInput    `json:"input"`
+	Result fileLockJSON `json:"result"`
+}
+
+func lock(cmd *cobra.Command, args []string) error {
+	paths, err := validateLockPaths("lock", args)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*files.LockFileArg, 0, len(paths))
+	for _, path := range paths {
+		entries = append(entries, files.NewLockFileArg(path))
+	}
+
+	dbx := filesNewFunc(config)
+	res, err := dbx.LockFileBatchContext(currentContext(), files.NewLockFileBatchArg(entries))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(lockOperationLock), lockPathsErrorDetails(paths))
+	}
+
+	results, warnings, err := lockResultsFromBatch(dbx, lockOperationLock, paths, res)
+	if err != nil {
+		return err
+	}
+	return renderLockResults(cmd, results, warnings, "Locked")
+}
+
+func unlock(cmd *cobra.Command, args []string) error {
+	paths, err := validateLockPaths("unlock", args)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*files.UnlockFileArg, 0, len(paths))
+	for _, path := range paths {
+		entries = append(entries, files.NewUnlockFileArg(path))
+	}
+
+	dbx := filesNewFunc(config)
+	res, err := dbx.UnlockFileBatchContext(currentContext(), files.NewUnlockFileBatchArg(entries))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(lockOperationUnlock), lockPathsErrorDetails(paths))
+	}
+
+	results, warnings, err := lockResultsFromBatch(dbx, lockOperationUnlock, paths, res)
+	if err != nil {
+		return err
+	}
+	return renderLockResults(cmd, results, warnings, "Unlocked")
+}
+
+func lockStatus(cmd *cobra.Command, args []string) error {
+	paths, err := validateLockPaths("lock status", args)
+	if err != nil {
+		return err
+	}
+
+	dbx := filesNewFunc(config)
+	res, err := getFileLocks(dbx, paths)
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(lockOperationStatus), lockPathsErrorDetails(paths))
+	}
+
+	results, warnings, err := lockResultsFromBatch(dbx, lockOperationStatus, paths, res)
+	if err != nil {
+		return err
+	}
+
+	printLockWarnings(cmd, warnings)
+	return renderOperation(cmd, nil, lockOperationResults(results), warnings, func(w io.Writer) error {
+		for _, result := range results {
+			if err := renderLockStatus(w, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func validateLockPaths(command string, args []string) ([]string, error) {
+	if len(args) < 1 {
+		return nil, invalidArgumentsErrorfWithDetails("`%s` requires at least one `path` argument", argumentErrorDetails("path"), command)
+	}
+	paths := make([]string, 0, len(args))
+	for _, arg := range args {
+		path, err := validatePath(arg)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func getFileLocks(dbx filesClient, paths []string) (*files.LockFileBatchResult, error) {
+	entries := make([]*files.LockFileArg, 0, len(paths))
+	for _, path := range paths {
+		entries = append(entries, files.NewLockFileArg(path))
+	}
+	return dbx.GetFileLockBatchContext(currentContext(), files.NewLockFileBatchArg(entries))
+}
+
+// lockResultsFromBatch pairs batch entries with the requested paths. Each
+// failed entry becomes a warning next to the paths that succeeded; the
+// command fails only when every path failed.
+func lockResultsFromBatch(dbx filesClient, operation string, paths []string, res *files.LockFileBatchResult) ([]lockResult, []jsonWarning, error) {
+	if res == nil || len(res.Entries) != len(paths) {
+		return nil, nil, commandFailedErrorfWithDetails("%s: Dropbox returned an unexpected number of results", operationErrorDetails(operation), operation)
+	}
+
+	results := make([]lockResult, 0, len(paths))
+	var warnings []jsonWarning
+	var failures []error
+	for i, entry := range res.Entries {
+		path := paths[i]
+		if entry == nil || entry.Tag != files.LockFileResultEntrySuccess || entry.Success == nil {
+			var failure *files.LockFileError
+			if entry != nil {
+				failure = entry.Failure
+			}
+			err := lockEntryError(dbx, operation, path, failure)
+			failures = append(failures, err)
+			warnings = append(warnings, jsonWarning{Code: jsonWarningCodeFileLockFailed, Message: err.Error(), Path: path})
+			continue
+		}
+
+		result, err := fileLockFromDropbox(path, entry.Success)
+		if err != nil {
+			return nil, nil, withJSONErrorDetails(err, operationErrorDetails(operation), pathErrorDetails(path))
+		}
+		results = append(results, lockResult{
+			Status: lockResultStatus(operation, result),
+			Input:  lockInput{Path: path},
+			Result: result,
+		})
+	}
+
+	if len(failures) > 0 && len(results) == 0 {
+		return nil, nil, batchFailuresError(operation, failures)
+	}
+	return results, warnings, nil
+}
+
+func lockResultStatus(operation string, result fileLockJSON) string {
+	switch operation {
+	case lockOperationLock:
+		return lockStatusLocked
+	case lockOperationUnlock:
+		return lockStatusUnlocked
+	}
+	if result.Locked {
+		return lockStatusLocked
+	}
+	return lockStatusUnlocked
+}
+
+func lockOperationVerb(operation string) string {
+	if operation == lockOperationStatus {
+		return "get lock status for"
+	}
+	return operation
+}
+
+func fileLockFromDropbox(path string, res *files.LockFileResult) (fileLockJSON, error) {
+	metadata, err := jsonMetadataFromDropbox(res.Metadata)
+	if err != nil {
+		return fileLockJSON{}, err
+	}
+	metadata.PathDisplay = metadataDisplayPath(path, metadata.PathDisplay)
+
+	result := fileLockJSON{Metadata: metadata}
+	if file, ok := res.Metadata.(*files.FileMetadata); ok && file.FileLockInfo != nil {
+		info := file.FileLockInfo
+		result.Locked = true
+		result.IsLockholder = info.IsLockholder
+		result.LockholderName = info.LockholderName
+		result.LockholderAccountID = info.LockholderAccountId
+		if info.Created != nil {
+			result.Created = jsonTime(time.Time(*info.Created))
+		}
+		return result, nil
+	}
+	if lock := singleUserLock(res.Lock); lock != nil {
+		result.Locked = true
+		result.LockholderAccountID = lock.LockHolderAccountId
+		result.Created = jsonTime(time.Time(lock.Created))
+	}
+	return result, nil
+}
+
+func singleUserLock(lock *files.FileLock) *files.SingleUserLock {
+	if lock == nil || lock.Content == nil || lock.Content.Tag != files.FileLockContentSingleUser {
+		return nil
+	}
+	return lock.Content.SingleUser
+}
+
+// lockEntryError maps a per-file batch failure to a coded error. Lock
+// conflicts report the current holder, looking up the display name when
+// Dropbox only returned an account ID.
+func lockEntryError(dbx filesClient, operation, path string, failure *files.LockFileError) error {
+	details := mergeJSONErrorDetails(operationErrorDetails(operation), pathErrorDetails(path))
+	if failure == nil {
+		return commandFailedErrorfWithDetails("%s %s: Dropbox returned no result", details, lockOperationVerb(operation), path)
+	}
+
+	summary := failure.Tag
+	code := jsonErrorCodeDropboxAPIError
+	reason := failure.Tag
+	switch failure.Tag {
+	case files.LockFileErrorLockConflict:
+		code = jsonErrorCodeFileLocked
+		holder := lockConflictHolder(dbx, path, failure.LockConflict)
+		details = mergeJSONErrorDetails(details, lockHolderErrorDetails(holder))
+		reason = "file is locked by " + lockHolderDisplay(holder)
+	case files.LockFileErrorPathLookup:
+		if failure.PathLookup != nil {
+			summary += "/" + failure.PathLookup.Tag
+			reason = failure.PathLookup.Tag
+		}
+		if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+			code = mapped
+		}
+	case files.LockFileErrorNoWritePermission:
+		code = jsonErrorCodePermissionDenied
+	case files.LockFileErrorTooManyWriteOperations:
+		code = jsonErrorCodeRateLimited
+	}
+	details["api_summary"] = summary + "/"
+
+	return newCodedError(code, fmt.Errorf("%s %s: %s", lockOperationVerb(operation), path, reason), details)
+}
+
+func lockConflictHolder(dbx filesClient, path string, conflict *files.LockConflictError) fileLockJSON {
+	var holder fileLockJSON
+	if conflict != nil {
+		if lock := singleUserLock(conflict.Lock); lock != nil {
+			holder.Locked = true
+			holder.LockholderAccountID = lock.LockHolderAccountId
+			holder.Created = jsonTime(time.Time(lock.Created))
+		}
+	}
+
+	res, err := getFileLocks(dbx, []string{path})
+	if err != nil || res == nil || len(res.Entries) != 1 || res.Entries[0] == nil || res.Entries[0].Success == nil {
+		return holder
+	}
+	current, err := fileLockFromDropbox(path, res.Entries[0].Success)
+	if err != nil || !current.Locked {
+		return holder
+	}
+	return current
+}
+
+func lockHolderErrorDetails(holder fileLockJSON) map[string]any {
+	details := map[string]any{}
+	if holder.LockholderName != "" {
+		details["lockholder_name"] = holder.LockholderName
+	}
+	if holder.LockholderAccountID != "" {
+		details["lockholder_account_id"] = holder.LockholderAccountID
+	}
+	if holder.Created != nil {
+		details["lock_created"] = *holder.Created
+	}
+	return details
+}
+
+func lockPathsErrorDetails(paths []string) map[string]any {
+	if len(paths) != 1 {
+		return nil
+	}
+	return pathErrorDetails(paths[0])
+}
+
+func lockHolderDisplay(holder fileLockJSON) string {
+	switch {
+	case holder.IsLockholder:
+		return "you"
+	case holder.LockholderName != "" && holder.LockholderAccountID != "":
+		return fmt.Sprintf("%s (%s)", holder.LockholderName, holder.LockholderAccountID)
+	case holder.LockholderName != "":
+		return holder.LockholderName
+	case holder.LockholderAccountID != "":
+		return holder.LockholderAccountID
+	default:
+		return "another user"
+	}
+}
+
+func lockOperationResults(results []lockResult) []jsonOperationResult {
+	operationResults := make([]jsonOperationResult, 0, len(results))
+	for _, result := range results {
+		operationResults = append(operationResults, newJSONOperationResult(result.Status, lockKindFile, result.Input, result.Result))
+	}
+	return operationResults
+}
+
+func renderLockResults(cmd *cobra.Command, results []lockResult, warnings []jsonWarning, verb string) error {
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	printLockWarnings(cmd, warnings)
+	return renderOperation(cmd, nil, lockOperationResults(results), warnings, func(w io.Writer) error {
+		if !verbose {
+			return nil
+		}
+		for _, result := range results {
+			if _, err := fmt.Fprintf(w, "%s %s\n", verb, lockResultDisplayPath(result)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func printLockWarnings(cmd *cobra.Command, warnings []jsonWarning) {
+	if commandOutputFormat(cmd) != output.FormatText {
+		return
+	}
+	for _, warning := range warnings {
+		commandOutput(cmd).Warn("%s", warning.Message)
+	}
+}
+
+func renderLockStatus(w io.Writer, result lockResult) error {
+	path := lockResultDisplayPath(result)
+	if !result.Result.Locked {
+		_, err := fmt.Fprintf(w, "%s: unlocked\n", path)
+		return err
+	}
+
+	line := fmt.Sprintf("%s: locked by %s", path, lockHolderDisplay(result.Result))
+	if result.Result.Created != nil {
+		line += " since " + *result.Result.Created
+	}
+	_, err := fmt.Fprintln(w, line)
+	return err
+}
+
+func lockResultDisplayPath(result lockResult) string {
+	if result.Result.Metadata.PathDisplay != "" {
+		return result.Result.Metadata.PathDisplay
+	}
+	return result.Input.Path
+}
+
+// lockCmd represents the lock command
+var lockCmd = &cobra.Command{
+	Use:   "lock [flags] <path>...",
+	Short: "Lock files for editing",
+	Long: `Lock one or more Dropbox files so other users cannot edit them.
+
+Files that are already locked by someone else fail with the file_locked error
+code, and the error reports the current lock holder. When several files are
+given, files that cannot be locked are reported as warnings while the rest are
+locked; the command fails only when no file could be locked.`,
+	Example: `  dbxcli lock /Design/logo.psd
+  dbxcli lock status /Design/logo.psd
+  dbxcli unlock /Design/logo.psd`,
+	RunE: lock,
+}
+
+var unlockCmd = &cobra.Command{
+	Use:     "unlock [flags] <path>...",
+	Short:   "Unlock files locked for editing",
+	Long:    `Release edit locks on one or more Dropbox files.`,
+	Example: `  dbxcli unlock /Design/logo.psd /Design/banner.psd`,
+	RunE:    unlock,
+}
+
+var lockStatusCmd = &cobra.Command{
+	Use:   "status [flags] <path>...",
+	Short: "Show file lock holders",
+	Long: `Show whether Dropbox files are locked, including the lock holder name,
+account ID, and the time the lock was created.`,
+	Example: `  dbxcli lock status /Design/logo.psd`,
+	RunE:    lockStatus,
+}
+
+func init() {
+	RootCmd.AddCommand(lockCmd)
+	RootCmd.AddCommand(unlockCmd)
+	lockCmd.AddCommand(lockStatusCmd)
+	enableStructuredOutput(lockCmd)
+	enableStructuredOutput(unlockCmd)
+	enableStructuredOutput(lockStatusCmd)
+}
@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestLockArgValidation(t *testing.T) {
+	for _, run := range []func(*cobra.Command, []string) error{lock, unlock, lockStatus} {
+		cmd, _ := testLockCmd()
+		err := run(cmd, nil)
+		if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+			t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeInvalidArguments, err)
+		}
+	}
+}
+
+func TestLockSendsBatchAndIsQuiet(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	var got *files.LockFileBatchArg
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			got = arg
+			return lockBatchSuccess(arg.Entries, lockTestInfo(true)), nil
+		},
+	})
+
+	if err := lock(cmd, []string{"/Design/logo.psd", "/Design/banner.psd"}); err != nil {
+		t.Fatalf("lock error: %v", err)
+	}
+	if got == nil || len(got.Entries) != 2 || got.Entries[0].Path != "/Design/logo.psd" || got.Entries[1].Path != "/Design/banner.psd" {
+		t.Fatalf("lock arg = %#v, want both paths in one batch", got)
+	}
+	if stdout.String() != "" {
+		t.Fatalf("stdout = %q, want quiet success", stdout.String())
+	}
+}
+
+func TestLockVerbosePrintsLockedFiles(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	if err := cmd.Flags().Set("verbose", "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return lockBatchSuccess(arg.Entries, lockTestInfo(true)), nil
+		},
+	})
+
+	if err := lock(cmd, []string{"/Design/logo.psd"}); err != nil {
+		t.Fatalf("lock error: %v", err)
+	}
+	if got, want := stdout.String(), "Locked /Design/logo.psd\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestLockJSONOutputsLockedFiles(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return lockBatchSuccess(arg.Entries, lockTestInfo(true)), nil
+		},
+	})
+
+	if err := lock(cmd, []string{"/Design/logo.psd"}); err != nil {
+		t.Fatalf("lock error: %v", err)
+	}
+
+	results := decodeLockOutput(t, stdout)
+	if len(results) != 1 {
+		t.Fatalf("results len = %d, want 1", len(results))
+	}
+	result := results[0]
+	if result.Status != lockStatusLocked || result.Input.Path != "/Design/logo.psd" {
+		t.Fatalf("result = %#v, want locked /Design/logo.psd", result)
+	}
+	if !result.Result.Locked || !result.Result.IsLockholder || result.Result.LockholderName != "Ada Lovelace" || result.Result.LockholderAccountID != "dbid:ada" {
+		t.Fatalf("lock = %#v, want lock held by caller", result.Result)
+	}
+	if result.Result.Created == nil || *result.Result.Created != "2026-01-02T03:04:05Z" {
+		t.Fatalf("created = %v, want lock creation time", result.Result.Created)
+	}
+	if result.Result.Metadata.Type != "file" || result.Result.Metadata.PathDisplay != "/Design/logo.psd" {
+		t.Fatalf("metadata = %#v, want file metadata", result.Result.Metadata)
+	}
+}
+
+func TestLockConflictReturnsFileLockedWithHolder(t *testing.T) {
+	cmd, _ := testLockCmd()
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return &files.LockFileBatchResult{Entries: []*files.LockFileResultEntry{lockConflictEntry()}}, nil
+		},
+		getFileLockBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return lockBatchSuccess(arg.Entries, lockTestInfo(false)), nil
+		},
+	})
+
+	err := lock(cmd, []string{"/Design/logo.psd"})
+	if err == nil {
+		t.Fatal("expected lock conflict error")
+	}
+	if code := jsonErrorCode(err); code != jsonErrorCodeFileLocked {
+		t.Fatalf("code = %q, want %q", code, jsonErrorCodeFileLocked)
+	}
+	if code := exitCodeForError(err); code != exitCodeConflict {
+		t.Fatalf("exit code = %d, want %d", code, exitCodeConflict)
+	}
+	if !strings.Contains(err.Error(), "locked by Ada Lovelace (dbid:ada)") {
+		t.Fatalf("err = %v, want lock holder in message", err)
+	}
+	details := jsonErrorDetails(err)
+	if details["path"] != "/Design/logo.psd" || details["operation"] != "lock" ||
+		details["lockholder_name"] != "Ada Lovelace" || details["lockholder_account_id"] != "dbid:ada" ||
+		details["lock_created"] != "2026-01-02T03:04:05Z" || details["api_summary"] != "lock_conflict/" {
+		t.Fatalf("details = %#v, want path, operation, lock holder, and summary", details)
+	}
+}
+
+func TestLockConflictFallsBackToConflictLockWhenStatusFails(t *testing.T) {
+	cmd, _ := testLockCmd()
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return &files.LockFileBatchResult{Entries: []*files.LockFileResultEntry{lockConflictEntry()}}, nil
+		},
+		getFileLockBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return nil, fmt.Errorf("network down")
+		},
+	})
+
+	err := lock(cmd, []string{"/Design/logo.psd"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeFileLocked {
+		t.Fatalf("code = %q, want %q", code, jsonErrorCodeFileLocked)
+	}
+	details := jsonErrorDetails(err)
+	if details["lockholder_account_id"] != "dbid:ada" || details["lock_created"] != "2026-01-02T03:04:05Z" {
+		t.Fatalf("details = %#v, want holder from conflict lock", details)
+	}
+	if _, ok := details["lockholder_name"]; ok {
+		t.Fatalf("details = %#v, did not expect holder name", details)
+	}
+}
+
+func TestLockReportsLockedFilesAndWarnsOnFailures(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			res := lockBatchSuccess(arg.Entries, lockTestInfo(true))
+			res.Entries[1] = lockConflictEntry()
+			return res, nil
+		},
+		getFileLockBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return nil, fmt.Errorf("status unavailable")
+		},
+	})
+
+	if err := lock(cmd, []string{"/a.psd", "/b.psd", "/c.psd"}); err != nil {
+		t.Fatalf("lock error: %v", err)
+	}
+
+	results, warnings := decodeLockOutputWithWarnings(t, stdout)
+	if len(results) != 2 || results[0].Input.Path != "/a.psd" || results[1].Input.Path != "/c.psd" {
+		t.Fatalf("results = %+v, want the locked files", results)
+	}
+	if len(warnings) != 1 || warnings[0].Code != jsonWarningCodeFileLockFailed || warnings[0].Path != "/b.psd" {
+		t.Fatalf("warnings = %+v, want file_lock_failed for /b.psd", warnings)
+	}
+}
+
+func TestLockAggregatesFailuresWhenEveryFileFails(t *testing.T) {
+	cmd, _ := testLockCmd()
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			res := lockBatchSuccess(arg.Entries, lockTestInfo(true))
+			res.Entries[0] = lockConflictEntry()
+			res.Entries[1] = &files.LockFileResultEntry{
+				Tagged: dropbox.Tagged{Tag: files.LockFileResultEntryFailure},
+				Failure: &files.LockFileError{
+					Tagged:     dropbox.Tagged{Tag: files.LockFileErrorPathLookup},
+					PathLookup: &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFound}},
+				},
+			}
+			return res, nil
+		},
+	})
+
+	err := lock(cmd, []string{"/a.psd", "/b.psd"})
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want aggregated failure", err)
+	}
+	if code := jsonErrorCode(err); code != jsonErrorCodeCommandFailed {
+		t.Fatalf("code = %q, want %q for mixed failures", code, jsonErrorCodeCommandFailed)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != lockOperationLock {
+		t.Fatalf("details = %#v, want lock operation", details)
+	}
+}
+
+func TestLockPathLookupFailureMapsToNotFound(t *testing.T) {
+	cmd, _ := testLockCmd()
+	stubFilesClient(t, &mockFilesClient{
+		lockFileBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			return &files.LockFileBatchResult{Entries: []*files.LockFileResultEntry{{
+				Tagged: dropbox.Tagged{Tag: files.LockFileResultEntryFailure},
+				Failure: &files.LockFileError{
+					Tagged:     dropbox.Tagged{Tag: files.LockFileErrorPathLookup},
+					PathLookup: &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFound}},
+				},
+			}}}, nil
+		},
+	})
+
+	err := lock(cmd, []string{"/missing.psd"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeNotFound {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeNotFound, err)
+	}
+	if details := jsonErrorDetails(err); details["api_summary"] != "path_lookup/not_found/" {
+		t.Fatalf("details = %#v, want path lookup summary", details)
+	}
+}
+
+func TestUnlockJSONOutputsUnlockedFiles(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var got *files.UnlockFileBatchArg
+	stubFilesClient(t, &mockFilesClient{
+		unlockFileBatchFn: func(arg *files.UnlockFileBatchArg) (*files.LockFileBatchResult, error) {
+			got = arg
+			entries := make([]*files.LockFileArg, 0, len(arg.Entries))
+			for _, entry := range arg.Entries {
+				entries = append(entries, files.NewLockFileArg(entry.Path))
+			}
+			return lockBatchSuccess(entries, nil), nil
+		},
+	})
+
+	if err := unlock(cmd, []string{"/Design/logo.psd"}); err != nil {
+		t.Fatalf("unlock error: %v", err)
+	}
+	if got == nil || len(got.Entries) != 1 || got.Entries[0].Path != "/Design/logo.psd" {
+		t.Fatalf("unlock arg = %#v, want requested path", got)
+	}
+
+	results := decodeLockOutput(t, stdout)
+	if len(results) != 1 || results[0].Status != lockStatusUnlocked || results[0].Result.Locked {
+		t.Fatalf("results = %#v, want one unlocked file", results)
+	}
+}
+
+func TestLockStatusTextShowsHolder(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	stubFilesClient(t, &mockFilesClient{
+		getFileLockBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			res := lockBatchSuccess(arg.Entries, lockTestInfo(false))
+			res.Entries[1] = lockBatchSuccess(arg.Entries[1:], nil).Entries[0]
+			return res, nil
+		},
+	})
+
+	if err := lockStatus(cmd, []string{"/Design/logo.psd", "/Design/banner.psd"}); err != nil {
+		t.Fatalf("lock status error: %v", err)
+	}
+	want := "/Design/logo.psd: locked by Ada Lovelace (dbid:ada) since 2026-01-02T03:04:05Z\n" +
+		"/Design/banner.psd: unlocked\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestLockStatusJSONOutputsLockHolders(t *testing.T) {
+	cmd, stdout := testLockCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		getFileLockBatchFn: func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+			res := lockBatchSuccess(arg.Entries, lockTestInfo(false))
+			res.Entries[1] = lockBatchSuccess(arg.Entries[1:], nil).Entries[0]
+			return res, nil
+		},
+	})
+
+	if err := lockStatus(cmd, []string{"/Design/logo.psd", "/Design/banner.psd"}); err != nil {
+		t.Fatalf("lock status error: %v", err)
+	}
+
+	results := decodeLockOutput(t, stdout)
+	if len(results) != 2 {
+		t.Fatalf("results len = %d, want 2", len(results))
+	}
+	if results[0].Status != lockStatusLocked || results[0].Result.IsLockholder || results[0].Result.LockholderName != "Ada Lovelace" {
+		t.Fatalf("first result = %#v, want locked by another user", results[0])
+	}
+	if results[1].Status != lockStatusUnlocked || results[1].Result.Locked || results[1].Result.Created != nil {
+		t.Fatalf("second result = %#v, want unlocked", results[1])
+	}
+}
+
+func TestLockStatusUsesDeprecatedLockWhenInfoMissing(t *testing.T) {
+	created := dropbox.DBXTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	got, err := fileLockFromDropbox("/a.psd", &files.LockFileResult{
+		Metadata: &files.FileMetadata{Metadata: files.Metadata{PathDisplay: "/a.psd"}},
+		Lock: &files.FileLock{Content: &files.FileLockContent{
+			Tagged:     dropbox.Tagged{Tag: files.FileLockContentSingleUser},
+			SingleUser: files.NewSingleUserLock(created, "dbid:ada"),
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Locked || got.LockholderAccountID != "dbid:ada" || got.Created == nil {
+		t.Fatalf("lock = %#v, want lock from single-user content", got)
+	}
+}
+
+func TestLockCommandsSupportStructuredOutput(t *testing.T) {
+	for _, cmd := range []*cobra.Command{lockCmd, unlockCmd, lockStatusCmd} {
+		if !commandSupportsStructuredOutput(cmd) {
+			t.Fatalf("%s should support structured output", cmd.CommandPath())
+		}
+	}
+	if lockStatusCmd.Parent() != lockCmd {
+		t.Fatal("lock status should be a subcommand of lock")
+	}
+}
+
+func lockTestInfo(isLockholder bool) *files.FileLockMetadata {
+	created := dropbox.DBXTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	return &files.FileLockMetadata{
+		IsLockholder:        isLockholder,
+		LockholderName:      "Ada Lovelace",
+		LockholderAccountId: "dbid:ada",
+		Created:             &created,
+	}
+}
+
+func lockBatchSuccess(entries []*files.LockFileArg, info *files.FileLockMetadata) *files.LockFileBatchResult {
+	res := &files.LockFileBatchResult{}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, &files.LockFileResultEntry{
+			Tagged: dropbox.Tagged{Tag: files.LockFileResultEntrySuccess},
+			Success: &files.LockFileResult{
+				Metadata: &files.FileMetadata{
+					Metadata:     files.Metadata{PathDisplay: entry.Path, PathLower: strings.ToLower(entry.Path)},
+					Rev:          "015f",
+					FileLockInfo: info,
+				},
+			},
+		})
+	}
+	return res
+}
+
+func lockConflictEntry() *files.LockFileResultEntry {
+	created := dropbox.DBXTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	return &files.LockFileResultEntry{
+		Tagged: dropbox.Tagged{Tag: files.LockFileResultEntryFailure},
+		Failure: &files.LockFileError{
+			Tagged: dropbox.Tagged{Tag: files.LockFileErrorLockConflict},
+			LockConflict: &files.LockConflictError{Lock: &files.FileLock{Content: &files.FileLockContent{
+				Tagged:     dropbox.Tagged{Tag: files.FileLockContentSingleUser},
+				SingleUser: files.NewSingleUserLock(created, "dbid:ada"),
+			}}},
+		},
+	}
+}
+
+func testLockCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "lock"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	return cmd, &stdout
+}
+
+func decodeLockOutput(t *testing.T, stdout *bytes.Buffer) []lockResult {
+	t.Helper()
+
+	results, warnings := decodeLockOutputWithWarnings(t, stdout)
+	if len(warnings) != 0 {
+		t.Fatalf("warnings = %+v, want empty array", warnings)
+	}
+	return results
+}
+
+func decodeLockOutputWithWarnings(t *testing.T, stdout *bytes.Buffer) ([]lockResult, []jsonWarning) {
+	t.Helper()
+
+	var got struct {
+		Input    any           `json:"input"`
+		Results  []lockResult  `json:"results"`
+		Warnings []jsonWarning `json:"warnings"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if got.Warnings == nil {
+		t.Fatal("warnings = nil, want array")
+	}
+	return got.Results, got.Warnings
+}
@@ -18,10 +18,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
@@ -41,22 +43,23 @@ type lsInput struct {
 	Reverse        bool   `json:"reverse"`
 	Time           string `json:"time,omitempty"`
 	TimeFormat     string `json:"time_format,omitempty"`
+	Props          string `json:"props,omitempty"`
+}
+
+// lsPropertyColumns describes the property fields `ls --props` appends to the
+// long listing. fields is empty when the template was given by ID, in which
+// case columns come from the fields present on the listed entries.
+type lsPropertyColumns struct {
+	ref        string
+	templateID string
+	fields     []string
 }
 
 const lsJSONStatusListed = "listed"
 
 // Sends a get_metadata request for a given path and returns the response
 func getFileMetadata(c filesClient, path string) (files.IsMetadata, error) {
-	arg := files.NewGetMetadataArg(path)
-
-	arg.IncludeDeleted = true
-
-	res, err := c.GetMetadataContext(currentContext(), arg)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return getFileMetadataWithProperties(c, path, nil)
 }
 
 // Invoked by search.go
@@ -79,6 +82,11 @@ func formatFileMetadata(e *files.FileMetadata, longFormat bool) string {
 func formatFileMetadataWithOpts(e *files.FileMetadata, opts listOptions) string {
 	text := fmt.Sprintf("%s\t", e.PathDisplay)
 	if opts.long {
+		// Export-only files such as Paper docs list the formats `get
+		// --export-format` accepts, default first.
+		if formats := exportFormats(e); len(formats) > 0 {
+			text = fmt.Sprintf("%s (export: %s)\t", e.PathDisplay, strings.Join(formats, ", "))
+		}
 		t := getTime(e, opts)
 		text = fmt.Sprintf("%s\t%s\t%s\t", e.Rev, humanize.IBytes(e.Size), formatTime(t, opts)) + text
 	}
@@ -122,6 +130,13 @@ func ls(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
+	if ref, _ := cmd.Flags().GetString("props"); ref != "" {
+		if opts.props, err = lsPropertyColumnsForTemplate(ref); err != nil {
+			return err
+		}
+		opts.long = true
+		arg.IncludePropertyGroups = propertyTemplateFilter(opts.props.templateIDs())
+	}
 	if opts.limit > 0 {
 		if opts.limit > maxListFolderLimit {
 			return invalidArgumentsErrorWithDetails("`ls --limit` is too large", flagErrorDetails("limit"))
@@ -134,7 +149,7 @@ func ls(cmd *cobra.Command, args []string) (err error) {
 	var entries []files.IsMetadata
 	if path != "" {
 		var metaRes files.IsMetadata
-		metaRes, err = getFileMetadata(dbx, path)
+		metaRes, err = getFileMetadataWithProperties(dbx, path, opts.props.templateIDs())
 		if err != nil {
 			return err
 		}
@@ -308,7 +323,83 @@ func newLsInput(path string, arg *files.ListFolderArg, onlyDeleted bool, opts li
 		Reverse:        opts.reverse,
 		Time:           opts.timeField,
 		TimeFormat:     opts.timeFormat,
+		Props:          opts.props.refOrEmpty(),
+	}
+}
+
+func lsPropertyColumnsForTemplate(ref string) (*lsPropertyColumns, error) {
+	if strings.HasPrefix(ref, propertyTemplateIDPrefix) {
+		return &lsPropertyColumns{ref: ref, templateID: ref}, nil
+	}
+	templates, err := listPropertyTemplates(filePropertiesNewFunc(config), false)
+	if err != nil {
+		return nil, err
+	}
+	template, ok := findPropertyTemplate(templates, ref)
+	if !ok {
+		return nil, propertyTemplateNotFoundError(ref, flagErrorDetails("props"))
+	}
+	return &lsPropertyColumns{ref: ref, templateID: template.TemplateID, fields: propertyTemplateFieldNames(template)}, nil
+}
+
+func (p *lsPropertyColumns) templateIDs() []string {
+	if p == nil {
+		return nil
+	}
+	return []string{p.templateID}
+}
+
+func (p *lsPropertyColumns) refOrEmpty() string {
+	if p == nil {
+		return ""
+	}
+	return p.ref
+}
+
+// columns returns the template fields followed by any other field names found
+// on the entries, in first-seen order.
+func (p *lsPropertyColumns) columns(entries []files.IsMetadata) []string {
+	columns := append([]string{}, p.fields...)
+	for _, entry := range entries {
+		for _, field := range metadataPropertyFields(entry, p.templateID) {
+			if !slices.Contains(columns, field.Name) {
+				columns = append(columns, field.Name)
+			}
+		}
 	}
+	return columns
+}
+
+func formatPropertyColumns(entry files.IsMetadata, templateID string, columns []string) string {
+	fields := metadataPropertyFields(entry, templateID)
+	var text strings.Builder
+	for _, column := range columns {
+		value := "-"
+		for _, field := range fields {
+			if field.Name == column {
+				value = field.Value
+				break
+			}
+		}
+		text.WriteString(value + "\t")
+	}
+	return text.String()
+}
+
+func metadataPropertyFields(entry files.IsMetadata, templateID string) []*file_properties.PropertyField {
+	var groups []*file_properties.PropertyGroup
+	switch e := entry.(type) {
+	case *files.FileMetadata:
+		groups = e.PropertyGroups
+	case *files.FolderMetadata:
+		groups = e.PropertyGroups
+	}
+	for _, group := range groups {
+		if group != nil && group.TemplateId == templateID {
+			return group.Fields
+		}
+	}
+	return nil
 }
 
 func jsonMetadataListFromLsEntries(entries []files.IsMetadata) ([]jsonMetadata, error) {
@@ -354,19 +445,34 @@ func renderLsResults(out io.Writer, entries []files.IsMetadata, opts listOptions
 		}
 	}
 
+	var propertyColumns []string
+	if opts.props != nil {
+		propertyColumns = opts.props.columns(entries)
+	}
 	if opts.long {
-		_, _ = fmt.Fprint(w, "Revision\tSize\tLast modified\tPath\n")
+		header := "Revision\tSize\tLast modified\tPath"
+		for _, column := range propertyColumns {
+			header += "\t" + column
+		}
+		_, _ = fmt.Fprintln(w, header)
 	}
 
 	for _, entry := range entries {
+		var text string
 		switch f := entry.(type) {
 		case *files.FileMetadata:
-			printItem(formatFileMetadataWithOpts(f, opts))
+			text = formatFileMetadataWithOpts(f, opts)
 		case *files.FolderMetadata:
-			printItem(formatFolderMetadata(f, opts.long))
+			text = formatFolderMetadata(f, opts.long)
 		case *files.DeletedMetadata:
-			printItem(formatDeletedMetadata(f, opts.long))
+			text = formatDeletedMetadata(f, opts.long)
+		default:
+			continue
+		}
+		if opts.props != nil {
+			text += formatPropertyColumns(entry, opts.props.templateID, propertyColumns)
 		}
+		printItem(text)
 	}
 
 	return finishListOutput(w, itemCounter, opts)
@@ -402,7 +508,8 @@ var lsCmd = &cobra.Command{
 	Example: `  dbxcli ls / # Or just 'ls'
   dbxcli ls /some-folder # Or 'ls some-folder'
   dbxcli ls /some-folder/some-file.pdf
-  dbxcli ls -l`,
+  dbxcli ls -l
+  dbxcli ls -l --props Retention /Contracts`,
 	RunE: ls,
 }
 
@@ -419,5 +526,6 @@ func init() {
 	lsCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
 	lsCmd.Flags().String("time", "server", "Time field: server, client")
 	lsCmd.Flags().String("time-format", "", "Time format: short (2006-01-02 15:04), rfc3339")
+	lsCmd.Flags().String("props", "", "Show fields of a property template (ID or name) as extra columns; implies --long")
 	enableStructuredOutput(lsCmd)
 }
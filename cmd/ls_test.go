@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 	"github.com/spf13/cobra"
 )
@@ -126,6 +127,21 @@ func TestFormatFileMetadataLongIncludesFields(t *testing.T) {
 	}
 }
 
+func TestFormatFileMetadataLongListsExportFormats(t *testing.T) {
+	meta := &files.FileMetadata{
+		Metadata:   files.Metadata{PathDisplay: "/plan.paper"},
+		Rev:        "rev1",
+		ExportInfo: &files.ExportInfo{ExportAs: "markdown", ExportOptions: []string{"markdown", "html"}},
+	}
+
+	if got := formatFileMetadata(meta, true); !contains(got, "/plan.paper (export: markdown, html)\t") {
+		t.Errorf("long format = %q, want export formats after path", got)
+	}
+	if got := formatFileMetadata(meta, false); got != "/plan.paper\t" {
+		t.Errorf("short format = %q, want path only", got)
+	}
+}
+
 func TestGetFileMetadataNotCalledForRoot(t *testing.T) {
 	// This test verifies the ls function logic:
 	// when path is "" (root), getFileMetadata should not be called.
@@ -661,6 +677,83 @@ func TestLsCommandSupportsStructuredOutput(t *testing.T) {
 	}
 }
 
+func TestLsPropsRendersTemplateFieldsAsColumns(t *testing.T) {
+	cmd, stdout := testLsCmd(t)
+	setLsFlag(t, cmd, "props", "Retention")
+	setLsFlag(t, cmd, "time-format", "rfc3339")
+	stubFilePropertiesClient(t, retentionTemplateClient())
+
+	var listArg *files.ListFolderArg
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return &files.FolderMetadata{Metadata: files.Metadata{PathDisplay: "/Contracts"}}, nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			listArg = arg
+			file := &files.FileMetadata{Metadata: files.Metadata{Name: "acme.pdf", PathDisplay: "/Contracts/acme.pdf"}, Rev: "rev1", Size: 1}
+			file.PropertyGroups = []*file_properties.PropertyGroup{retentionPropertyGroup("class", "7y", "owner", "legal")}
+			folder := &files.FolderMetadata{Metadata: files.Metadata{Name: "Old", PathDisplay: "/Contracts/Old"}}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{file, folder}}, nil
+		},
+	})
+
+	if err := ls(cmd, []string{"/Contracts"}); err != nil {
+		t.Fatalf("ls error: %v", err)
+	}
+	if listArg == nil || listArg.IncludePropertyGroups == nil || strings.Join(listArg.IncludePropertyGroups.FilterSome, ",") != testRetentionTemplateID {
+		t.Fatalf("ListFolder include_property_groups = %#v, want resolved template", listArg)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("output = %q, want header and two rows", stdout.String())
+	}
+	if got := strings.Fields(lines[0]); strings.Join(got, " ") != "Revision Size Last modified Path project class owner" {
+		t.Fatalf("header = %q, want template fields then extra fields", lines[0])
+	}
+	if got := strings.Fields(lines[1]); strings.Join(got[len(got)-4:], " ") != "/Contracts/acme.pdf - 7y legal" {
+		t.Fatalf("file row = %q, want property values", lines[1])
+	}
+	if got := strings.Fields(lines[2]); strings.Join(got[len(got)-4:], " ") != "/Contracts/Old - - -" {
+		t.Fatalf("folder row = %q, want empty property values", lines[2])
+	}
+}
+
+func TestLsPropsJSONIncludesPropertyGroups(t *testing.T) {
+	cmd, stdout := testLsCmd(t)
+	setLsOutputJSON(t, cmd)
+	setLsFlag(t, cmd, "props", testRetentionTemplateID)
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		templatesListForUserFn: func() (*file_properties.ListTemplateResult, error) {
+			t.Fatal("template lookup called for a template ID")
+			return nil, nil
+		},
+	})
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			if arg.IncludePropertyGroups == nil {
+				t.Fatal("get_metadata called without include_property_groups")
+			}
+			file := &files.FileMetadata{Metadata: files.Metadata{Name: "acme.pdf", PathDisplay: "/acme.pdf"}, Rev: "rev1"}
+			file.PropertyGroups = []*file_properties.PropertyGroup{retentionPropertyGroup("class", "7y")}
+			return file, nil
+		},
+	})
+
+	if err := ls(cmd, []string{"/acme.pdf"}); err != nil {
+		t.Fatalf("ls error: %v", err)
+	}
+
+	got := decodeLsOutput(t, stdout)
+	if got.Input.Props != testRetentionTemplateID || !got.Input.Long {
+		t.Fatalf("input = %+v, want props and implied long", got.Input)
+	}
+	groups := got.Results[0].Result.PropertyGroups
+	if len(groups) != 1 || groups[0].TemplateID != testRetentionTemplateID || groups[0].Fields[0] != (jsonPropertyField{Name: "class", Value: "7y"}) {
+		t.Fatalf("property_groups = %#v, want retention group", groups)
+	}
+}
+
 func TestIsListFolderNotFolderErrorHandlesWrappedErrors(t *testing.T) {
 	apiErr := files.ListFolderAPIError{
 		EndpointError: &files.ListFolderError{
@@ -731,6 +824,7 @@ func testLsCmd(t *testing.T) (*cobra.Command, *bytes.Buffer) {
 	cmd.Flags().BoolP("reverse", "r", false, "")
 	cmd.Flags().String("time", "server", "")
 	cmd.Flags().String("time-format", "", "")
+	cmd.Flags().String("props", "", "")
 	cmd.Flags().String(outputFlag, "text", "")
 	return cmd, &stdout
 }
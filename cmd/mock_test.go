@@ -10,24 +10,37 @@ import (
 )
 
 type mockFilesClient struct {
-	downloadFn              func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error)
-	exportFn                func(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error)
-	uploadFn                func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error)
-	uploadSessionStartFn    func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error)
-	uploadSessionAppendV2Fn func(arg *files.UploadSessionAppendArg, content io.Reader) error
-	uploadSessionFinishFn   func(arg *files.UploadSessionFinishArg, content io.Reader) (*files.FileMetadata, error)
-	copyV2Fn                func(arg *files.RelocationArg) (*files.RelocationResult, error)
-	createFolderV2Fn        func(arg *files.CreateFolderArg) (*files.CreateFolderResult, error)
-	deleteV2Fn              func(arg *files.DeleteArg) (*files.DeleteResult, error)
-	getMetadataFn           func(arg *files.GetMetadataArg) (files.IsMetadata, error)
-	listFolderFn            func(arg *files.ListFolderArg) (*files.ListFolderResult, error)
-	listFolderContinueFn    func(arg *files.ListFolderContinueArg) (*files.ListFolderResult, error)
-	listRevisionsFn         func(arg *files.ListRevisionsArg) (*files.ListRevisionsResult, error)
-	moveV2Fn                func(arg *files.RelocationArg) (*files.RelocationResult, error)
-	permanentlyDeleteFn     func(arg *files.DeleteArg) error
-	restoreFn               func(arg *files.RestoreArg) (*files.FileMetadata, error)
-	searchV2Fn              func(arg *files.SearchV2Arg) (*files.SearchV2Result, error)
-	searchContinueV2Fn      func(arg *files.SearchV2ContinueArg) (*files.SearchV2Result, error)
+	downloadFn               func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error)
+	downloadZipFn            func(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error)
+	getTemporaryLinkFn       func(arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error)
+	getTemporaryUploadLinkFn func(arg *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error)
+	exportFn                 func(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error)
+	uploadFn                 func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error)
+	uploadSessionStartFn     func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error)
+	uploadSessionAppendV2Fn  func(arg *files.UploadSessionAppendArg, content io.Reader) error
+	uploadSessionFinishFn    func(arg *files.UploadSessionFinishArg, content io.Reader) (*files.FileMetadata, error)
+	copyV2Fn                 func(arg *files.RelocationArg) (*files.RelocationResult, error)
+	createFolderV2Fn         func(arg *files.CreateFolderArg) (*files.CreateFolderResult, error)
+	deleteV2Fn               func(arg *files.DeleteArg) (*files.DeleteResult, error)
+	getFileLockBatchFn       func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error)
+	getMetadataFn            func(arg *files.GetMetadataArg) (files.IsMetadata, error)
+	getPreviewFn             func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error)
+	getThumbnailBatchFn      func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error)
+	listFolderFn             func(arg *files.ListFolderArg) (*files.ListFolderResult, error)
+	listFolderContinueFn     func(arg *files.ListFolderContinueArg) (*files.ListFolderResult, error)
+	listRevisionsFn          func(arg *files.ListRevisionsArg) (*files.ListRevisionsResult, error)
+	lockFileBatchFn          func(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error)
+	moveV2Fn                 func(arg *files.RelocationArg) (*files.RelocationResult, error)
+	permanentlyDeleteFn      func(arg *files.DeleteArg) error
+	restoreFn                func(arg *files.RestoreArg) (*files.FileMetadata, error)
+	saveURLFn                func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error)
+	saveURLCheckJobStatusFn  func(arg *async.PollArg) (*files.SaveUrlJobStatus, error)
+	searchV2Fn               func(arg *files.SearchV2Arg) (*files.SearchV2Result, error)
+	searchContinueV2Fn       func(arg *files.SearchV2ContinueArg) (*files.SearchV2Result, error)
+	tagsAddFn                func(arg *files.AddTagArg) error
+	tagsGetFn                func(arg *files.GetTagsArg) (*files.GetTagsResult, error)
+	tagsRemoveFn             func(arg *files.RemoveTagArg) error
+	unlockFileBatchFn        func(arg *files.UnlockFileBatchArg) (*files.LockFileBatchResult, error)
 }
 
 func (m *mockFilesClient) Download(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
@@ -160,8 +173,15 @@ func (m *mockFilesClient) DeleteBatchCheck(arg *async.PollArg) (*files.DeleteBat
 	return nil, nil
 }
 func (m *mockFilesClient) DownloadZip(arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+	if m.downloadZipFn != nil {
+		return m.downloadZipFn(arg)
+	}
 	return nil, nil, nil
 }
+
+func (m *mockFilesClient) DownloadZipContext(ctx context.Context, arg *files.DownloadZipArg) (*files.DownloadZipResult, io.ReadCloser, error) {
+	return m.DownloadZip(arg)
+}
 func (m *mockFilesClient) Export(arg *files.ExportArg) (*files.ExportResult, io.ReadCloser, error) {
 	if m.exportFn != nil {
 		return m.exportFn(arg)
@@ -177,8 +197,16 @@ func (m *mockFilesClient) ExportContext(
 }
 
 func (m *mockFilesClient) GetFileLockBatch(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+	if m.getFileLockBatchFn != nil {
+		return m.getFileLockBatchFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) GetFileLockBatchContext(ctx context.Context, arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+	return m.GetFileLockBatch(arg)
+}
+
 func (m *mockFilesClient) GetMetadata(arg *files.GetMetadataArg) (files.IsMetadata, error) {
 	if m.getMetadataFn != nil {
 		return m.getMetadataFn(arg)
@@ -190,14 +218,36 @@ func (m *mockFilesClient) GetMetadataContext(ctx context.Context, arg *files.Get
 	return m.GetMetadata(arg)
 }
 func (m *mockFilesClient) GetPreview(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+	if m.getPreviewFn != nil {
+		return m.getPreviewFn(arg)
+	}
 	return nil, nil, nil
 }
+
+func (m *mockFilesClient) GetPreviewContext(ctx context.Context, arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+	return m.GetPreview(arg)
+}
 func (m *mockFilesClient) GetTemporaryLink(arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error) {
+	if m.getTemporaryLinkFn != nil {
+		return m.getTemporaryLinkFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) GetTemporaryLinkContext(ctx context.Context, arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error) {
+	return m.GetTemporaryLink(arg)
+}
+
 func (m *mockFilesClient) GetTemporaryUploadLink(arg *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error) {
+	if m.getTemporaryUploadLinkFn != nil {
+		return m.getTemporaryUploadLinkFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) GetTemporaryUploadLinkContext(ctx context.Context, arg *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error) {
+	return m.GetTemporaryUploadLink(arg)
+}
 func (m *mockFilesClient) GetThumbnail(arg *files.ThumbnailArg) (*files.FileMetadata, io.ReadCloser, error) {
 	return nil, nil, nil
 }
@@ -205,8 +255,15 @@ func (m *mockFilesClient) GetThumbnailV2(arg *files.ThumbnailV2Arg) (*files.Prev
 	return nil, nil, nil
 }
 func (m *mockFilesClient) GetThumbnailBatch(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+	if m.getThumbnailBatchFn != nil {
+		return m.getThumbnailBatchFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) GetThumbnailBatchContext(ctx context.Context, arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+	return m.GetThumbnailBatch(arg)
+}
 func (m *mockFilesClient) ListFolder(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
 	if m.listFolderFn != nil {
 		return m.listFolderFn(arg)
@@ -244,8 +301,16 @@ func (m *mockFilesClient) ListRevisionsContext(ctx context.Context, arg *files.L
 	return m.ListRevisions(arg)
 }
 func (m *mockFilesClient) LockFileBatch(arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+	if m.lockFileBatchFn != nil {
+		return m.lockFileBatchFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) LockFileBatchContext(ctx context.Context, arg *files.LockFileBatchArg) (*files.LockFileBatchResult, error) {
+	return m.LockFileBatch(arg)
+}
+
 func (m *mockFilesClient) MoveV2(arg *files.RelocationArg) (*files.RelocationResult, error) {
 	if m.moveV2Fn != nil {
 		return m.moveV2Fn(arg)
@@ -316,11 +381,26 @@ func (m *mockFilesClient) RestoreContext(ctx context.Context, arg *files.Restore
 	return m.Restore(arg)
 }
 func (m *mockFilesClient) SaveUrl(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+	if m.saveURLFn != nil {
+		return m.saveURLFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) SaveUrlContext(ctx context.Context, arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+	return m.SaveUrl(arg)
+}
+
 func (m *mockFilesClient) SaveUrlCheckJobStatus(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+	if m.saveURLCheckJobStatusFn != nil {
+		return m.saveURLCheckJobStatusFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+	return m.SaveUrlCheckJobStatus(arg)
+}
 func (m *mockFilesClient) Search(arg *files.SearchArg) (*files.SearchResult, error) {
 	return nil, nil
 }
@@ -344,14 +424,51 @@ func (m *mockFilesClient) SearchContinueV2(arg *files.SearchV2ContinueArg) (*fil
 func (m *mockFilesClient) SearchContinueV2Context(ctx context.Context, arg *files.SearchV2ContinueArg) (*files.SearchV2Result, error) {
 	return m.SearchContinueV2(arg)
 }
-func (m *mockFilesClient) TagsAdd(arg *files.AddTagArg) error { return nil }
+
+func (m *mockFilesClient) TagsAdd(arg *files.AddTagArg) error {
+	if m.tagsAddFn != nil {
+		return m.tagsAddFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilesClient) TagsAddContext(ctx context.Context, arg *files.AddTagArg) error {
+	return m.TagsAdd(arg)
+}
+
 func (m *mockFilesClient) TagsGet(arg *files.GetTagsArg) (*files.GetTagsResult, error) {
+	if m.tagsGetFn != nil {
+		return m.tagsGetFn(arg)
+	}
 	return nil, nil
 }
-func (m *mockFilesClient) TagsRemove(arg *files.RemoveTagArg) error { return nil }
+
+func (m *mockFilesClient) TagsGetContext(ctx context.Context, arg *files.GetTagsArg) (*files.GetTagsResult, error) {
+	return m.TagsGet(arg)
+}
+
+func (m *mockFilesClient) TagsRemove(arg *files.RemoveTagArg) error {
+	if m.tagsRemoveFn != nil {
+		return m.tagsRemoveFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilesClient) TagsRemoveContext(ctx context.Context, arg *files.RemoveTagArg) error {
+	return m.TagsRemove(arg)
+}
+
 func (m *mockFilesClient) UnlockFileBatch(arg *files.UnlockFileBatchArg) (*files.LockFileBatchResult, error) {
+	if m.unlockFileBatchFn != nil {
+		return m.unlockFileBatchFn(arg)
+	}
 	return nil, nil
 }
+
+func (m *mockFilesClient) UnlockFileBatchContext(ctx context.Context, arg *files.UnlockFileBatchArg) (*files.LockFileBatchResult, error) {
+	return m.UnlockFileBatch(arg)
+}
+
 func (m *mockFilesClient) UploadSessionAppend(arg *files.UploadSessionCursor, content io.Reader) error {
 	return nil
 }
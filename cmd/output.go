@@ -23,12 +23,15 @@ const (
 	jsonErrorCodeAuthRequired                = "auth_required"
 	jsonErrorCodeDropboxAPIError             = "dropbox_api_error"
 	jsonErrorCodeEnvTokenStillActive         = "env_token_still_active"
+	jsonErrorCodeFileLocked                  = "file_locked"
+	jsonErrorCodeHashMismatch                = "hash_mismatch"
 	jsonErrorCodeInvalidArguments            = "invalid_arguments"
 	jsonErrorCodeNotFound                    = "not_found"
 	jsonErrorCodePartialTransfer             = "partial_transfer"
 	jsonErrorCodePathConflict                = "path_conflict"
 	jsonErrorCodePermissionDenied            = "permission_denied"
 	jsonErrorCodeRateLimited                 = "rate_limited"
+	jsonErrorCodeRevConflict                 = "rev_conflict"
 	jsonErrorCodeStructuredOutputUnsupported = "structured_output_unsupported"
 	jsonErrorCodeUnknownCommand              = "unknown_command"
 	jsonErrorCodeUnknownFlag                 = "unknown_flag"
@@ -367,7 +370,7 @@ func exitCodeForError(err error) int {
 		return exitCodePermissionDenied
 	case jsonErrorCodeNotFound:
 		return exitCodeNotFound
-	case jsonErrorCodePathConflict:
+	case jsonErrorCodePathConflict, jsonErrorCodeFileLocked, jsonErrorCodeRevConflict:
 		return exitCodeConflict
 	case jsonErrorCodeRateLimited:
 		return exitCodeRateLimited
@@ -736,6 +739,20 @@ func isDropboxAPISummarySegment(segment string) bool {
 	return true
 }
 
+// dropboxAPISummaryHasSegment reports whether a slash-separated API summary
+// contains one of the given tags as a whole segment, so "locked" does not
+// match "unlocked".
+func dropboxAPISummaryHasSegment(summary string, tags ...string) bool {
+	for _, segment := range strings.Split(summary, "/") {
+		for _, tag := range tags {
+			if segment == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func dropboxAPIMessageErrorCode(message string) string {
 	lower := strings.ToLower(message)
 	switch {
@@ -746,6 +763,8 @@ func dropboxAPIMessageErrorCode(message string) string {
 		strings.Contains(lower, "rate_limit") ||
 		strings.Contains(lower, "rate_limited"):
 		return jsonErrorCodeRateLimited
+	case dropboxAPISummaryHasSegment(lower, "locked", "lock_conflict"):
+		return jsonErrorCodeFileLocked
 	case strings.Contains(lower, "path/conflict") ||
 		strings.Contains(lower, "to/conflict") ||
 		strings.Contains(lower, "from/conflict"):
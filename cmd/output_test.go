@@ -499,6 +499,18 @@ func TestJSONErrorDetailsIncludesSDKAPISummaries(t *testing.T) {
 			code:    jsonErrorCodePathConflict,
 			summary: "path/conflict/file/",
 		},
+		{
+			name:    "upload locked file",
+			err:     fmt.Errorf("upload: %w", files.UploadAPIError{APIError: dropbox.APIError{ErrorSummary: "path/locked/.."}}),
+			code:    jsonErrorCodeFileLocked,
+			summary: "path/locked/..",
+		},
+		{
+			name:    "lock conflict",
+			err:     fmt.Errorf("upload: %w", files.UploadSessionFinishAPIError{APIError: dropbox.APIError{ErrorSummary: "lookup_failed/lock_conflict/"}}),
+			code:    jsonErrorCodeFileLocked,
+			summary: "lookup_failed/lock_conflict/",
+		},
 		{
 			name:    "sharing create conflict",
 			err:     fmt.Errorf("share: %w", sharing.CreateSharedLinkWithSettingsAPIError{APIError: dropbox.APIError{ErrorSummary: "shared_link_already_exists/."}}),
@@ -1045,6 +1057,8 @@ func TestExitCodeForStableJSONErrorCodes(t *testing.T) {
 		{jsonErrorCodePathConflict, exitCodeConflict},
 		{jsonErrorCodePermissionDenied, exitCodePermissionDenied},
 		{jsonErrorCodeRateLimited, exitCodeRateLimited},
+		{jsonErrorCodeRevConflict, exitCodeConflict},
+		{jsonErrorCodeHashMismatch, exitCodeGenericError},
 		{jsonErrorCodeStructuredOutputUnsupported, exitCodeValidationError},
 		{jsonErrorCodeUnknownCommand, exitCodeValidationError},
 		{jsonErrorCodeUnknownFlag, exitCodeValidationError},
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"bytes"
-	"errors"
 	"io"
 	"os"
 	"strings"
@@ -143,7 +142,7 @@ func TestPutStdinSetsClientModified(t *testing.T) {
 		t.Fatalf("put stdin error: %v", err)
 	}
 	if uploadedClientModified == nil {
-		t.Fatal("ClientModified = nil, want stdin spool modified time")
+		t.Fatal("ClientModified = nil, want upload time")
 	}
 
 	got := time.Time(*uploadedClientModified)
@@ -299,127 +298,46 @@ func TestPutStdin_EmptyUploadsZeroBytes(t *testing.T) {
 	}
 }
 
-func TestPutStdin_CleanupFailureAfterSuccessfulUpload(t *testing.T) {
-	cmd := testPutCmdWithStdin(strings.NewReader("data"))
+func TestPutStdinStreamsLargeInputThroughSession(t *testing.T) {
+	content := bytes.Repeat([]byte("x"), int(putChunkSizeUnit)+10)
+	cmd := testPutCmdWithStdin(bytes.NewReader(content))
+	_ = cmd.Flags().Set("chunksize", "4194304")
+	cmd.SetErr(io.Discard)
 
-	mock := &mockFilesClient{
+	var received int
+	var finished *files.UploadSessionFinishArg
+	stubFilesClient(t, &mockFilesClient{
 		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
 			return nil, &files.GetMetadataAPIError{}
 		},
 		uploadFn: func(arg *files.UploadArg, r io.Reader) (*files.FileMetadata, error) {
-			_, _ = io.ReadAll(r)
-			return &files.FileMetadata{}, nil
+			t.Fatal("stdin larger than one chunk should use an upload session")
+			return nil, nil
 		},
-	}
-	stubFilesClient(t, mock)
-
-	cleanupErr := errors.New("remove failed")
-	var tempPath string
-	origRemoveFile := removeFile
-	removeFile = func(path string) error {
-		tempPath = path
-		return cleanupErr
-	}
-	t.Cleanup(func() {
-		removeFile = origRemoveFile
-		if tempPath != "" {
-			_ = origRemoveFile(tempPath)
-		}
-	})
-
-	origStderr := os.Stderr
-	stderrReader, stderrWriter, err := os.Pipe()
-	if err != nil {
-		t.Fatal(err)
-	}
-	os.Stderr = stderrWriter
-	t.Cleanup(func() {
-		os.Stderr = origStderr
-		_ = stderrReader.Close()
-		_ = stderrWriter.Close()
-	})
-
-	err = put(cmd, []string{"-", "/dest.txt"})
-	_ = stderrWriter.Close()
-	stderr, _ := io.ReadAll(stderrReader)
-	os.Stderr = origStderr
-
-	if err == nil {
-		t.Fatal("expected cleanup failure")
-	}
-	if !errors.Is(err, cleanupErr) {
-		t.Fatalf("error = %v, want wrapped cleanup error", err)
-	}
-	if !strings.Contains(err.Error(), "sensitive stdin data may remain on disk") {
-		t.Errorf("error = %q, want sensitive-data warning", err.Error())
-	}
-	if tempPath == "" {
-		t.Fatal("expected temp path to be captured")
-	}
-	if !strings.Contains(string(stderr), "failed to remove temp file") || !strings.Contains(string(stderr), cleanupErr.Error()) {
-		t.Errorf("stderr = %q, want cleanup failure with underlying error", string(stderr))
-	}
-}
-
-func TestPutStdin_ReportsCleanupFailureAfterUploadFailure(t *testing.T) {
-	cmd := testPutCmdWithStdin(strings.NewReader("data"))
-	uploadErr := errors.New("upload failed")
-
-	mock := &mockFilesClient{
-		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
-			return nil, &files.GetMetadataAPIError{}
+		uploadSessionStartFn: func(arg *files.UploadSessionStartArg, r io.Reader) (*files.UploadSessionStartResult, error) {
+			data, _ := io.ReadAll(r)
+			received += len(data)
+			return &files.UploadSessionStartResult{SessionId: "stdin"}, nil
 		},
-		uploadFn: func(arg *files.UploadArg, r io.Reader) (*files.FileMetadata, error) {
-			_, _ = io.ReadAll(r)
-			return nil, uploadErr
+		uploadSessionAppendV2Fn: func(arg *files.UploadSessionAppendArg, r io.Reader) error {
+			data, _ := io.ReadAll(r)
+			received += len(data)
+			return nil
+		},
+		uploadSessionFinishFn: func(arg *files.UploadSessionFinishArg, r io.Reader) (*files.FileMetadata, error) {
+			finished = arg
+			return &files.FileMetadata{}, nil
 		},
-	}
-	stubFilesClient(t, mock)
-
-	cleanupErr := errors.New("remove failed")
-	var tempPath string
-	origRemoveFile := removeFile
-	removeFile = func(path string) error {
-		tempPath = path
-		return cleanupErr
-	}
-	t.Cleanup(func() {
-		removeFile = origRemoveFile
-		if tempPath != "" {
-			_ = origRemoveFile(tempPath)
-		}
-	})
-
-	origStderr := os.Stderr
-	stderrReader, stderrWriter, err := os.Pipe()
-	if err != nil {
-		t.Fatal(err)
-	}
-	os.Stderr = stderrWriter
-	t.Cleanup(func() {
-		os.Stderr = origStderr
-		_ = stderrReader.Close()
-		_ = stderrWriter.Close()
 	})
 
-	err = put(cmd, []string{"-", "/dest.txt"})
-	_ = stderrWriter.Close()
-	stderr, _ := io.ReadAll(stderrReader)
-	os.Stderr = origStderr
-
-	if !errors.Is(err, uploadErr) {
-		t.Fatalf("error = %v, want upload error", err)
-	}
-	if errors.Is(err, cleanupErr) {
-		t.Fatalf("error = %v, should preserve upload error as return value", err)
+	if err := put(cmd, []string{"-", "/backups/db.sql"}); err != nil {
+		t.Fatalf("put stdin error: %v", err)
 	}
-	if tempPath == "" {
-		t.Fatal("expected temp path to be captured")
+	if received != len(content) {
+		t.Fatalf("received %d bytes, want %d", received, len(content))
 	}
-	if !strings.Contains(string(stderr), "failed to remove temp file") ||
-		!strings.Contains(string(stderr), cleanupErr.Error()) ||
-		!strings.Contains(string(stderr), "sensitive stdin data may remain on disk") {
-		t.Errorf("stderr = %q, want cleanup failure warning", string(stderr))
+	if finished == nil || finished.Cursor.Offset != uint64(len(content)) || finished.Commit.Path != "/backups/db.sql" || finished.Commit.ClientModified == nil {
+		t.Fatalf("finish arg = %#v", finished)
 	}
 }
 
@@ -465,6 +383,8 @@ func TestPutLocalDashFile(t *testing.T) {
 func testGetCmd() *cobra.Command {
 	cmd := &cobra.Command{}
 	cmd.Flags().BoolP("recursive", "r", false, "")
+	cmd.Flags().String("export-format", "", "")
+	cmd.Flags().Bool("zip", false, "")
 	return cmd
 }
 
@@ -561,28 +481,6 @@ func TestGetStdout_RejectsFolder(t *testing.T) {
 	}
 }
 
-func TestGetStdout_RejectsRecursive(t *testing.T) {
-	mock := &mockFilesClient{
-		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
-			return &files.FolderMetadata{Metadata: files.Metadata{PathDisplay: arg.Path}}, nil
-		},
-	}
-	stubFilesClient(t, mock)
-
-	cmd := testGetCmd()
-	_ = cmd.Flags().Set("recursive", "true")
-	var stdout bytes.Buffer
-	cmd.SetOut(&stdout)
-
-	err := get(cmd, []string{"/folder", "-"})
-	if err == nil {
-		t.Fatal("expected error for recursive download to stdout")
-	}
-	if !strings.Contains(err.Error(), "--recursive") {
-		t.Errorf("error = %q, want mention of --recursive", err.Error())
-	}
-}
-
 func TestGetStdout_NoLocalFilesCreated(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Chdir(tmpDir)
@@ -0,0 +1,131 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	previewKind      = "preview"
+	previewOperation = "preview"
+)
+
+// previewHTMLExtensions are the spreadsheet types Dropbox previews as HTML.
+// Every other previewable type is rendered as PDF.
+var previewHTMLExtensions = []string{".csv", ".gsheet", ".ods", ".xls", ".xlsm", ".xlsx"}
+
+type previewInput struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func preview(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return invalidArgumentsErrorWithDetails("`preview` requires a `source` and an optional `target` argument", argumentsErrorDetails("source", "target"))
+	}
+	src := newDropboxReference(args[0]).String()
+
+	target := ""
+	if len(args) == 2 {
+		target = args[1]
+	}
+	if target == "-" && commandOutputFormat(cmd) == output.FormatJSON {
+		return invalidArgumentsErrorWithDetails("`preview --output=json` cannot be used with stdout target `-`", mergeJSONErrorDetails(operationErrorDetails(previewOperation), argumentErrorDetails("target"), flagErrorDetails("output")))
+	}
+
+	dbx := filesNewFunc(config)
+	if target == "-" {
+		err := streamToStdout(cmd.OutOrStdout(), func() (io.ReadCloser, error) {
+			_, contents, err := dbx.GetPreviewContext(currentContext(), files.NewPreviewArg(src))
+			return contents, err
+		})
+		return withJSONErrorDetails(err, operationErrorDetails(previewOperation), pathErrorDetails(src))
+	}
+
+	meta, contents, err := dbx.GetPreviewContext(currentContext(), files.NewPreviewArg(src))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(previewOperation), pathErrorDetails(src))
+	}
+	defer func() { _ = contents.Close() }()
+
+	name := path.Base(src)
+	if meta != nil && meta.Name != "" {
+		name = meta.Name
+	}
+	name = previewFileName(name)
+	if target == "" {
+		target = name
+	} else if info, err := os.Stat(target); err == nil && info.IsDir() {
+		target = filepath.Join(target, name)
+	}
+
+	if err := writeDownloadFile(target, contents); err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(previewOperation), pathErrorDetails(src), relocationErrorDetails(src, target))
+	}
+	commandVerboseStatus(cmd, "Downloaded preview %s -> %s", src, target)
+
+	var metadata files.IsMetadata
+	if meta != nil {
+		metadata = meta
+	}
+	result, err := newGetResult(getStatusDownloaded, previewKind, src, target, metadata)
+	if err != nil {
+		return err
+	}
+	return renderJSONOperationOutput(cmd, previewInput{Source: src, Target: target}, getOperationResults([]getResult{result}))
+}
+
+// previewFileName swaps the extension of a Dropbox file name for the format
+// Dropbox renders its preview in.
+func previewFileName(name string) string {
+	ext := path.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	if slices.Contains(previewHTMLExtensions, strings.ToLower(ext)) {
+		return stem + ".html"
+	}
+	return stem + ".pdf"
+}
+
+// previewCmd represents the preview command
+var previewCmd = &cobra.Command{
+	Use:   "preview [flags] <source> [<target>]",
+	Short: "Download a PDF or HTML preview of a file",
+	Long: `Download the preview Dropbox renders for a document.
+  - Spreadsheets (csv, ods, xls, xlsm, xlsx, gsheet) are previewed as HTML;
+    other documents such as docx, pptx, and rtf are previewed as PDF.
+  - Without a target, the preview is saved in the current folder under the
+    source name with a .html or .pdf extension.
+  - Use - as target to write the preview to stdout.
+`,
+	Example: `  dbxcli preview /Reports/Q3.docx
+  dbxcli preview /Reports/Budget.xlsx ./previews/
+  dbxcli preview /Reports/Q3.pptx - > Q3.pdf`,
+	RunE: preview,
+}
+
+func init() {
+	RootCmd.AddCommand(previewCmd)
+	enableStructuredOutput(previewCmd)
+}
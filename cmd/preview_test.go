@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestPreviewJSONWritesDefaultTarget(t *testing.T) {
+	t.Chdir(t.TempDir())
+	cmd, stdout := testPreviewCmd("json")
+	stubFilesClient(t, &mockFilesClient{
+		getPreviewFn: func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+			if arg.Path != "/Reports/Q3.docx" {
+				t.Fatalf("preview path = %q", arg.Path)
+			}
+			return thumbnailTestFile(arg.Path), io.NopCloser(strings.NewReader("%PDF")), nil
+		},
+	})
+
+	if err := preview(cmd, []string{"Reports/Q3.docx"}); err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if content, err := os.ReadFile("Q3.pdf"); err != nil || string(content) != "%PDF" {
+		t.Fatalf("preview file = %q, %v; want %%PDF", content, err)
+	}
+
+	var got struct {
+		Input   previewInput `json:"input"`
+		Results []getResult  `json:"results"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if got.Input.Source != "/Reports/Q3.docx" || got.Input.Target != "Q3.pdf" {
+		t.Fatalf("input = %#v", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != getStatusDownloaded || got.Results[0].Kind != previewKind || got.Results[0].Result == nil {
+		t.Fatalf("results = %#v, want one downloaded preview", got.Results)
+	}
+}
+
+func TestPreviewSpreadsheetIntoDirectoryUsesHTML(t *testing.T) {
+	dir := t.TempDir()
+	cmd, _ := testPreviewCmd("text")
+	stubFilesClient(t, &mockFilesClient{
+		getPreviewFn: func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+			return thumbnailTestFile("/Reports/Budget.xlsx"), io.NopCloser(strings.NewReader("<html>")), nil
+		},
+	})
+
+	if err := preview(cmd, []string{"id:budget", dir}); err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if content, err := os.ReadFile(filepath.Join(dir, "Budget.html")); err != nil || string(content) != "<html>" {
+		t.Fatalf("preview file = %q, %v; want <html>", content, err)
+	}
+}
+
+func TestPreviewStdout(t *testing.T) {
+	cmd, stdout := testPreviewCmd("text")
+	stubFilesClient(t, &mockFilesClient{
+		getPreviewFn: func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+			return thumbnailTestFile(arg.Path), io.NopCloser(strings.NewReader("%PDF")), nil
+		},
+	})
+
+	if err := preview(cmd, []string{"/Reports/Q3.pptx", "-"}); err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if stdout.String() != "%PDF" {
+		t.Fatalf("stdout = %q, want preview bytes", stdout.String())
+	}
+}
+
+func TestPreviewStdoutFailureAfterOutputIsPartialTransfer(t *testing.T) {
+	retryDelays := stubRetrySleep(t)
+	calls := 0
+	cmd, stdout := testPreviewCmd("text")
+	stubFilesClient(t, &mockFilesClient{
+		getPreviewFn: func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+			calls++
+			return thumbnailTestFile(arg.Path), &failingReadCloser{data: []byte("%PD")}, nil
+		},
+	})
+
+	err := preview(cmd, []string{"/Reports/Q3.pptx", "-"})
+	if code := jsonErrorCode(err); code != jsonErrorCodePartialTransfer {
+		t.Fatalf("code = %q, want partial_transfer (err %v)", code, err)
+	}
+	if details := jsonErrorDetails(err); details["path"] != "/Reports/Q3.pptx" || details["operation"] != previewOperation {
+		t.Fatalf("details = %#v, want preview operation and path", details)
+	}
+	if calls != 1 || len(*retryDelays) != 0 {
+		t.Fatalf("calls = %d, retries = %v; want no retry after partial output", calls, *retryDelays)
+	}
+	if stdout.String() != "%PD" {
+		t.Fatalf("stdout = %q, want the bytes written before the failure", stdout.String())
+	}
+}
+
+func TestPreviewErrors(t *testing.T) {
+	cmd, _ := testPreviewCmd("json")
+	if err := preview(cmd, []string{"/Reports/Q3.docx", "-"}); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("stdout with JSON err = %v, want invalid arguments", err)
+	}
+
+	stubFilesClient(t, &mockFilesClient{
+		getPreviewFn: func(arg *files.PreviewArg) (*files.FileMetadata, io.ReadCloser, error) {
+			return nil, nil, errors.New("unsupported_extension")
+		},
+	})
+	err := preview(cmd, []string{"/photo.heic", t.TempDir()})
+	if details := jsonErrorDetails(err); details["operation"] != previewOperation || details["path"] != "/photo.heic" {
+		t.Fatalf("details = %#v (err %v)", details, err)
+	}
+}
+
+func TestPreviewFileName(t *testing.T) {
+	for name, want := range map[string]string{
+		"Q3.docx":     "Q3.pdf",
+		"Budget.XLSX": "Budget.html",
+		"data.csv":    "data.html",
+		"README":      "README.pdf",
+	} {
+		if got := previewFileName(name); got != want {
+			t.Fatalf("previewFileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func testPreviewCmd(format string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "preview"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, format, "")
+	return cmd, &stdout
+}
@@ -0,0 +1,401 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	propsStatusFound       = "found"
+	propsStatusSet         = "set"
+	propsStatusUpdated     = "updated"
+	propsStatusRemoved     = "removed"
+	propsKindPropertyGroup = "property_group"
+
+	propsOperationGet    = "props_get"
+	propsOperationSet    = "props_set"
+	propsOperationUpdate = "props_update"
+	propsOperationRemove = "props_remove"
+
+	propertyTemplateIDPrefix = "ptid:"
+)
+
+type propsGetInput struct {
+	Path      string   `json:"path"`
+	Templates []string `json:"templates"`
+}
+
+type propsInput struct {
+	Path       string `json:"path"`
+	TemplateID string `json:"template_id"`
+	DryRun     bool   `json:"dry_run,omitempty"`
+}
+
+type propertyGroupResult struct {
+	Path          string              `json:"path"`
+	TemplateID    string              `json:"template_id"`
+	Fields        []jsonPropertyField `json:"fields"`
+	RemovedFields []string            `json:"removed_fields,omitempty"`
+}
+
+func propsGet(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`props get` requires a `path` argument", argumentErrorDetails("path"))
+	}
+	path, err := validatePath(args[0])
+	if err != nil {
+		return err
+	}
+	refs, _ := cmd.Flags().GetStringArray("template")
+
+	templateIDs, err := propsGetTemplateIDs(filePropertiesNewFunc(config), refs)
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(propsOperationGet))
+	}
+
+	metadata, err := getFileMetadataWithProperties(filesNewFunc(config), path, templateIDs)
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(propsOperationGet), pathErrorDetails(path))
+	}
+	result, err := jsonMetadataFromDropbox(metadata)
+	if err != nil {
+		return err
+	}
+
+	input := propsGetInput{Path: path, Templates: refs}
+	if input.Templates == nil {
+		input.Templates = []string{}
+	}
+	results := []jsonOperationResult{newJSONOperationResult(propsStatusFound, result.Type, nil, result)}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		return renderPropertyGroups(w, result.PropertyGroups)
+	})
+}
+
+// propsGetTemplateIDs resolves the --template flags, defaulting to every
+// template the user owns, plus the team's templates when the token can list
+// them, because Dropbox only returns property groups for templates named in
+// the request.
+func propsGetTemplateIDs(client filePropertiesClient, refs []string) ([]string, error) {
+	if len(refs) > 0 {
+		return resolvePropertyTemplateIDs(client, refs, flagErrorDetails("template"))
+	}
+	res, err := client.TemplatesListForUserContext(currentContext())
+	if err != nil {
+		return nil, err
+	}
+	ids := res.TemplateIds
+	if team, err := client.TemplatesListForTeamContext(currentContext()); err == nil {
+		ids = append(ids, team.TemplateIds...)
+	}
+	return ids, nil
+}
+
+func renderPropertyGroups(out io.Writer, groups []jsonPropertyGroup) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, group := range groups {
+		for _, field := range group.Fields {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", group.TemplateID, field.Name, field.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return w.Flush()
+}
+
+type propsMutation struct {
+	operation     string
+	status        string
+	planned       string
+	done          string
+	fields        []*file_properties.PropertyField
+	removedFields []string
+	apply         func(fp filePropertiesClient, path, templateID string) error
+}
+
+func propsSet(cmd *cobra.Command, args []string) error {
+	if len(args) < 3 {
+		return invalidArgumentsErrorWithDetails("`props set` requires `path`, `template`, and at least one `field=value` argument", argumentsErrorDetails("path", "template", "field"))
+	}
+	fields, err := parsePropertyFields(args[2:])
+	if err != nil {
+		return err
+	}
+	return runPropsMutation(cmd, args[0], args[1], propsMutation{
+		operation: propsOperationSet,
+		status:    propsStatusSet,
+		planned:   "set %s properties on",
+		done:      "Set %s properties on %s\n",
+		fields:    fields,
+		apply: func(fp filePropertiesClient, path, templateID string) error {
+			groups := []*file_properties.PropertyGroup{file_properties.NewPropertyGroup(templateID, fields)}
+			err := fp.PropertiesAddContext(currentContext(), file_properties.NewAddPropertiesArg(path, groups))
+			if isPropertyGroupAlreadyExistsError(err) {
+				return fp.PropertiesOverwriteContext(currentContext(), file_properties.NewOverwritePropertyGroupArg(path, groups))
+			}
+			return err
+		},
+	})
+}
+
+func propsUpdate(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`props update` requires `path` and `template` arguments", argumentsErrorDetails("path", "template"))
+	}
+	fields, err := parsePropertyFields(args[2:])
+	if err != nil {
+		return err
+	}
+	removeFields, _ := cmd.Flags().GetStringArray("remove-field")
+	if len(fields) == 0 && len(removeFields) == 0 {
+		return invalidArgumentsErrorWithDetails("`props update` requires at least one `field=value` argument or `--remove-field`", mergeJSONErrorDetails(argumentErrorDetails("field"), flagErrorDetails("remove-field")))
+	}
+	return runPropsMutation(cmd, args[0], args[1], propsMutation{
+		operation:     propsOperationUpdate,
+		status:        propsStatusUpdated,
+		planned:       "update %s properties on",
+		done:          "Updated %s properties on %s\n",
+		fields:        fields,
+		removedFields: removeFields,
+		apply: func(fp filePropertiesClient, path, templateID string) error {
+			update := file_properties.NewPropertyGroupUpdate(templateID)
+			update.AddOrUpdateFields = fields
+			update.RemoveFields = removeFields
+			return fp.PropertiesUpdateContext(currentContext(), file_properties.NewUpdatePropertiesArg(path, []*file_properties.PropertyGroupUpdate{update}))
+		},
+	})
+}
+
+func propsRemove(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return invalidArgumentsErrorWithDetails("`props remove` requires `path` and `template` arguments", argumentsErrorDetails("path", "template"))
+	}
+	return runPropsMutation(cmd, args[0], args[1], propsMutation{
+		operation: propsOperationRemove,
+		status:    propsStatusRemoved,
+		planned:   "remove %s properties from",
+		done:      "Removed %s properties from %s\n",
+		apply: func(fp filePropertiesClient, path, templateID string) error {
+			return fp.PropertiesRemoveContext(currentContext(), file_properties.NewRemovePropertiesArg(path, []string{templateID}))
+		},
+	})
+}
+
+func runPropsMutation(cmd *cobra.Command, pathArg, templateRef string, mutation propsMutation) error {
+	path, err := validatePath(pathArg)
+	if err != nil {
+		return err
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	fp := filePropertiesNewFunc(config)
+	templateIDs, err := resolvePropertyTemplateIDs(fp, []string{templateRef}, argumentErrorDetails("template"))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(mutation.operation))
+	}
+	templateID := templateIDs[0]
+
+	if !dryRun {
+		if err := mutation.apply(fp, path, templateID); err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(mutation.operation), pathErrorDetails(path))
+		}
+	}
+
+	input := propsInput{Path: path, TemplateID: templateID, DryRun: dryRun}
+	result := propertyGroupResult{
+		Path:          path,
+		TemplateID:    templateID,
+		Fields:        jsonPropertyFieldsFromDropbox(mutation.fields),
+		RemovedFields: mutation.removedFields,
+	}
+	results := []jsonOperationResult{newJSONOperationResult(plannedStatus(dryRun, mutation.status), propsKindPropertyGroup, input, result)}
+	return renderOperation(cmd, nil, results, nil, func(w io.Writer) error {
+		if dryRun {
+			return writeDryRunLine(w, fmt.Sprintf(mutation.planned, templateID), path)
+		}
+		if !verbose {
+			return nil
+		}
+		_, err := fmt.Fprintf(w, mutation.done, templateID, path)
+		return err
+	})
+}
+
+// parsePropertyFields parses `name=value` arguments. Values may contain "=".
+func parsePropertyFields(args []string) ([]*file_properties.PropertyField, error) {
+	fields := make([]*file_properties.PropertyField, 0, len(args))
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return nil, invalidArgumentsErrorfWithDetails("invalid property %q: use name=value", mergeJSONErrorDetails(argumentErrorDetails("field"), map[string]any{"value": arg}), arg)
+		}
+		fields = append(fields, file_properties.NewPropertyField(name, value))
+	}
+	return fields, nil
+}
+
+// resolvePropertyTemplateIDs accepts template IDs ("ptid:...") as-is and looks
+// up anything else by name among the user's templates, then the team's.
+// Only team tokens can list team templates; other tokens must reference team
+// templates by ID.
+func resolvePropertyTemplateIDs(client filePropertiesClient, refs []string, details map[string]any) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	var userTemplates, teamTemplates []jsonPropertyTemplate
+	teamListed := false
+	for _, ref := range refs {
+		if strings.HasPrefix(ref, propertyTemplateIDPrefix) {
+			ids = append(ids, ref)
+			continue
+		}
+		if userTemplates == nil {
+			var err error
+			if userTemplates, err = listPropertyTemplates(client, false); err != nil {
+				return nil, err
+			}
+		}
+		template, ok := findPropertyTemplate(userTemplates, ref)
+		if !ok && !teamListed {
+			teamListed = true
+			teamTemplates, _ = listPropertyTemplates(client, true)
+		}
+		if !ok {
+			template, ok = findPropertyTemplate(teamTemplates, ref)
+		}
+		if !ok {
+			return nil, propertyTemplateNotFoundError(ref, details)
+		}
+		ids = append(ids, template.TemplateID)
+	}
+	return ids, nil
+}
+
+func findPropertyTemplate(templates []jsonPropertyTemplate, ref string) (jsonPropertyTemplate, bool) {
+	for _, template := range templates {
+		if template.TemplateID == ref || strings.EqualFold(template.Name, ref) {
+			return template, true
+		}
+	}
+	return jsonPropertyTemplate{}, false
+}
+
+func propertyTemplateNotFoundError(ref string, details map[string]any) error {
+	return newCodedError(jsonErrorCodeNotFound, fmt.Errorf("property template %q not found", ref), mergeJSONErrorDetails(details, map[string]any{"value": ref}))
+}
+
+func isPropertyGroupAlreadyExistsError(err error) bool {
+	var apiErr file_properties.PropertiesAddAPIError
+	return errors.As(err, &apiErr) &&
+		apiErr.EndpointError != nil &&
+		apiErr.EndpointError.Tag == file_properties.AddPropertiesErrorPropertyGroupAlreadyExists
+}
+
+// getFileMetadataWithProperties is getFileMetadata with the property groups of
+// the given templates attached to the returned metadata.
+func getFileMetadataWithProperties(c filesClient, path string, templateIDs []string) (files.IsMetadata, error) {
+	arg := files.NewGetMetadataArg(path)
+	arg.IncludeDeleted = true
+	arg.IncludePropertyGroups = propertyTemplateFilter(templateIDs)
+	return c.GetMetadataContext(currentContext(), arg)
+}
+
+func propertyTemplateFilter(templateIDs []string) *file_properties.TemplateFilterBase {
+	if len(templateIDs) == 0 {
+		return nil
+	}
+	filter := &file_properties.TemplateFilterBase{FilterSome: templateIDs}
+	filter.Tag = file_properties.TemplateFilterBaseFilterSome
+	return filter
+}
+
+var propsCmd = &cobra.Command{
+	Use:   "props",
+	Short: "Custom file property commands",
+	Long: `Read and write custom file properties.
+
+Properties are grouped by template. Templates can be referenced by ID
+("ptid:...") or by name. Names are looked up among your templates, then your
+team's; team templates can only be listed with a team token, so reference
+them by ID otherwise.`,
+}
+
+var propsGetCmd = &cobra.Command{
+	Use:   "get [flags] <path>",
+	Short: "Show property groups on a file or folder",
+	Long: `Show property groups on a Dropbox file or folder.
+
+Without --template, groups for every template you own are shown, along with
+your team's templates when the token can list them.`,
+	Example: `  dbxcli props get /Contracts/acme.pdf
+  dbxcli props get /Contracts/acme.pdf --template Retention`,
+	RunE: propsGet,
+}
+
+var propsSetCmd = &cobra.Command{
+	Use:   "set [flags] <path> <template> <field=value>...",
+	Short: "Set a property group on a file or folder",
+	Long: `Set a property group on a Dropbox file or folder.
+
+Fields not listed are cleared when the group already exists.`,
+	Example: `  dbxcli props set /Contracts/acme.pdf Retention project=P-12 class=7y`,
+	RunE:    propsSet,
+}
+
+var propsUpdateCmd = &cobra.Command{
+	Use:   "update [flags] <path> <template> [<field=value>...]",
+	Short: "Update fields in a property group",
+	Long:  "Add, change, or remove individual fields in an existing property group.",
+	Example: `  dbxcli props update /Contracts/acme.pdf Retention class=10y
+  dbxcli props update /Contracts/acme.pdf Retention --remove-field project`,
+	RunE: propsUpdate,
+}
+
+var propsRemoveCmd = &cobra.Command{
+	Use:     "remove [flags] <path> <template>",
+	Short:   "Remove a property group from a file or folder",
+	Example: `  dbxcli props remove /Contracts/acme.pdf Retention`,
+	RunE:    propsRemove,
+}
+
+func init() {
+	RootCmd.AddCommand(propsCmd)
+	propsCmd.AddCommand(propsGetCmd)
+	propsCmd.AddCommand(propsSetCmd)
+	propsCmd.AddCommand(propsUpdateCmd)
+	propsCmd.AddCommand(propsRemoveCmd)
+
+	propsGetCmd.Flags().StringArray("template", nil, "Template ID or name to show (repeatable)")
+	propsUpdateCmd.Flags().StringArray("remove-field", nil, "Field name to remove (repeatable)")
+
+	for _, cmd := range []*cobra.Command{propsGetCmd, propsSetCmd, propsUpdateCmd, propsRemoveCmd} {
+		enableStructuredOutput(cmd)
+	}
+	for _, cmd := range []*cobra.Command{propsSetCmd, propsUpdateCmd, propsRemoveCmd} {
+		addDryRunFlag(cmd)
+	}
+}
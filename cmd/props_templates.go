@@ -0,0 +1,286 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/spf13/cobra"
+)
+
+const (
+	propsTemplateStatusAdded  = "added"
+	propsTemplateStatusFound  = "found"
+	propsTemplateStatusListed = "listed"
+	propsKindPropertyTemplate = "property_template"
+
+	propertyTemplateOwnerTeam = "team"
+	propertyTemplateOwnerUser = "user"
+)
+
+type propsTemplatesInput struct {
+	TemplateID string `json:"template_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Team       bool   `json:"team"`
+}
+
+type jsonPropertyTemplate struct {
+	TemplateID  string                      `json:"template_id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	Owner       string                      `json:"owner"`
+	Fields      []jsonPropertyFieldTemplate `json:"fields"`
+}
+
+type jsonPropertyFieldTemplate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+func propsTemplatesList(cmd *cobra.Command, args []string) error {
+	team, _ := cmd.Flags().GetBool("team")
+	templates, err := listPropertyTemplates(filePropertiesNewFunc(config), team)
+	if err != nil {
+		return err
+	}
+
+	results := make([]jsonOperationResult, 0, len(templates))
+	for _, template := range templates {
+		results = append(results, newJSONOperationResult(propsTemplateStatusListed, propsKindPropertyTemplate, nil, template))
+	}
+	return renderOperation(cmd, propsTemplatesInput{Team: team}, results, nil, func(w io.Writer) error {
+		return renderPropertyTemplates(w, templates)
+	})
+}
+
+func propsTemplatesGet(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`props templates get` requires a `template-id` argument", argumentErrorDetails("template-id"))
+	}
+	team, _ := cmd.Flags().GetBool("team")
+	template, err := getPropertyTemplate(filePropertiesNewFunc(config), args[0], team)
+	if err != nil {
+		return err
+	}
+
+	results := []jsonOperationResult{newJSONOperationResult(propsTemplateStatusFound, propsKindPropertyTemplate, nil, template)}
+	return renderOperation(cmd, propsTemplatesInput{TemplateID: args[0], Team: team}, results, nil, func(w io.Writer) error {
+		return renderPropertyTemplate(w, template)
+	})
+}
+
+func propsTemplatesAdd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`props templates add` requires a `name` argument", argumentErrorDetails("name"))
+	}
+	team, _ := cmd.Flags().GetBool("team")
+	description, _ := cmd.Flags().GetString("description")
+	specs, _ := cmd.Flags().GetStringArray("field")
+	if len(specs) == 0 {
+		return invalidArgumentsErrorWithDetails("`props templates add` requires at least one `--field`", flagErrorDetails("field"))
+	}
+	fields := make([]*file_properties.PropertyFieldTemplate, 0, len(specs))
+	for _, spec := range specs {
+		name, fieldDescription, _ := strings.Cut(spec, ":")
+		if name == "" {
+			return invalidArgumentsErrorfWithDetails("invalid --field %q: use NAME or NAME:DESCRIPTION", flagValueErrorDetails("field", spec), spec)
+		}
+		fieldType := &file_properties.PropertyType{}
+		fieldType.Tag = file_properties.PropertyTypeString
+		fields = append(fields, file_properties.NewPropertyFieldTemplate(name, fieldDescription, fieldType))
+	}
+
+	client := filePropertiesNewFunc(config)
+	arg := file_properties.NewAddTemplateArg(args[0], description, fields)
+	var res *file_properties.AddTemplateResult
+	var err error
+	if team {
+		res, err = client.TemplatesAddForTeamContext(currentContext(), arg)
+	} else {
+		res, err = client.TemplatesAddForUserContext(currentContext(), arg)
+	}
+	if err != nil {
+		return err
+	}
+
+	template := jsonPropertyTemplateFromDropbox(res.TemplateId, &arg.PropertyGroupTemplate, team)
+	results := []jsonOperationResult{newJSONOperationResult(propsTemplateStatusAdded, propsKindPropertyTemplate, nil, template)}
+	return renderOperation(cmd, propsTemplatesInput{Name: args[0], Team: team}, results, nil, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, template.TemplateID)
+		return err
+	})
+}
+
+func listPropertyTemplates(client filePropertiesClient, team bool) ([]jsonPropertyTemplate, error) {
+	var res *file_properties.ListTemplateResult
+	var err error
+	if team {
+		res, err = client.TemplatesListForTeamContext(currentContext())
+	} else {
+		res, err = client.TemplatesListForUserContext(currentContext())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]jsonPropertyTemplate, 0, len(res.TemplateIds))
+	for _, id := range res.TemplateIds {
+		template, err := getPropertyTemplate(client, id, team)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+func getPropertyTemplate(client filePropertiesClient, templateID string, team bool) (jsonPropertyTemplate, error) {
+	arg := file_properties.NewGetTemplateArg(templateID)
+	var res *file_properties.GetTemplateResult
+	var err error
+	if team {
+		res, err = client.TemplatesGetForTeamContext(currentContext(), arg)
+	} else {
+		res, err = client.TemplatesGetForUserContext(currentContext(), arg)
+	}
+	if err != nil {
+		return jsonPropertyTemplate{}, err
+	}
+	return jsonPropertyTemplateFromDropbox(templateID, &res.PropertyGroupTemplate, team), nil
+}
+
+func jsonPropertyTemplateFromDropbox(templateID string, template *file_properties.PropertyGroupTemplate, team bool) jsonPropertyTemplate {
+	owner := propertyTemplateOwnerUser
+	if team {
+		owner = propertyTemplateOwnerTeam
+	}
+	result := jsonPropertyTemplate{
+		TemplateID:  templateID,
+		Name:        template.Name,
+		Description: template.Description,
+		Owner:       owner,
+		Fields:      make([]jsonPropertyFieldTemplate, 0, len(template.Fields)),
+	}
+	for _, field := range template.Fields {
+		if field == nil {
+			continue
+		}
+		fieldType := file_properties.PropertyTypeString
+		if field.Type != nil && field.Type.Tag != "" {
+			fieldType = field.Type.Tag
+		}
+		result.Fields = append(result.Fields, jsonPropertyFieldTemplate{Name: field.Name, Description: field.Description, Type: fieldType})
+	}
+	return result
+}
+
+func propertyTemplateFieldNames(template jsonPropertyTemplate) []string {
+	names := make([]string, 0, len(template.Fields))
+	for _, field := range template.Fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
+
+func renderPropertyTemplates(out io.Writer, templates []jsonPropertyTemplate) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, template := range templates {
+		line := template.TemplateID + "\t" + template.Name
+		if len(template.Fields) > 0 {
+			line += "\t" + strings.Join(propertyTemplateFieldNames(template), ", ")
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func renderPropertyTemplate(out io.Writer, template jsonPropertyTemplate) error {
+	lines := []string{
+		"Template Id:\t" + template.TemplateID,
+		"Name:\t" + template.Name,
+		"Description:\t" + template.Description,
+		"Owner:\t" + template.Owner,
+	}
+	for _, field := range template.Fields {
+		lines = append(lines, fmt.Sprintf("Field:\t%s (%s)\t%s", field.Name, field.Type, field.Description))
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+var propsTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Property template commands",
+	Long: `List, inspect, and add custom property templates.
+
+Use --team with a team-manage login to work with team-owned templates.`,
+}
+
+var propsTemplatesListCmd = &cobra.Command{
+	Use:   "list [flags]",
+	Short: "List property templates",
+	Example: `  dbxcli props templates list
+  dbxcli props templates list --team`,
+	RunE: propsTemplatesList,
+}
+
+var propsTemplatesGetCmd = &cobra.Command{
+	Use:     "get [flags] <template-id>",
+	Short:   "Show a property template",
+	Example: `  dbxcli props templates get ptid:1a5n2i6d3OYEAAAAAAAAAYa`,
+	RunE:    propsTemplatesGet,
+}
+
+var propsTemplatesAddCmd = &cobra.Command{
+	Use:   "add [flags] <name>",
+	Short: "Add a property template",
+	Long: `Add a custom property template and print its template ID.
+
+Each --field takes NAME or NAME:DESCRIPTION. Fields hold string values.`,
+	Example: `  dbxcli props templates add Retention --field project --field "class:Retention class"
+  dbxcli props templates add Retention --team --description "Records retention" --field class`,
+	RunE: propsTemplatesAdd,
+}
+
+func init() {
+	propsCmd.AddCommand(propsTemplatesCmd)
+	propsTemplatesCmd.AddCommand(propsTemplatesListCmd)
+	propsTemplatesCmd.AddCommand(propsTemplatesGetCmd)
+	propsTemplatesCmd.AddCommand(propsTemplatesAddCmd)
+
+	propsTemplatesAddCmd.Flags().String("description", "", "Template description")
+	propsTemplatesAddCmd.Flags().StringArray("field", nil, "Field as NAME or NAME:DESCRIPTION (repeatable)")
+
+	for _, cmd := range []*cobra.Command{propsTemplatesListCmd, propsTemplatesGetCmd, propsTemplatesAddCmd} {
+		cmd.Flags().Bool("team", false, "Use team templates (requires a team-manage login)")
+		enableStructuredOutput(cmd)
+		setCommandAuthModes(cmd, authTokenTypeName(tokenPersonal), authTokenTypeName(tokenTeamAccess), authTokenTypeName(tokenTeamManage))
+	}
+}
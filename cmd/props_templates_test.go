@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/spf13/cobra"
+)
+
+func testPropsTemplatesCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "templates"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().Bool("team", false, "")
+	cmd.Flags().String("description", "", "")
+	cmd.Flags().StringArray("field", nil, "")
+	return cmd, &stdout
+}
+
+func TestPropsTemplatesListJSONOutputsTemplates(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, retentionTemplateClient())
+
+	if err := propsTemplatesList(cmd, nil); err != nil {
+		t.Fatalf("props templates list error: %v", err)
+	}
+
+	got := decodePropsOutput[struct{}, jsonPropertyTemplate](t, stdout)
+	if string(got.Input) != `{"team":false}` {
+		t.Fatalf("input = %s, want team false", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != propsTemplateStatusListed || got.Results[0].Kind != propsKindPropertyTemplate {
+		t.Fatalf("results = %#v, want one listed template", got.Results)
+	}
+	template := got.Results[0].Result
+	if template.TemplateID != testRetentionTemplateID || template.Name != "Retention" || template.Owner != propertyTemplateOwnerUser {
+		t.Fatalf("template = %#v", template)
+	}
+	if len(template.Fields) != 2 || template.Fields[0] != (jsonPropertyFieldTemplate{Name: "project", Description: "Project code", Type: "string"}) {
+		t.Fatalf("fields = %#v", template.Fields)
+	}
+}
+
+func TestPropsTemplatesListTextOutput(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	stubFilePropertiesClient(t, retentionTemplateClient())
+
+	if err := propsTemplatesList(cmd, nil); err != nil {
+		t.Fatalf("props templates list error: %v", err)
+	}
+	if got, want := stdout.String(), "ptid:retention Retention project, class\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestPropsTemplatesListTeamUsesTeamRoutes(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	if err := cmd.Flags().Set("team", "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		templatesListForUserFn: func() (*file_properties.ListTemplateResult, error) {
+			t.Fatal("user template route called with --team")
+			return nil, nil
+		},
+		templatesListForTeamFn: func() (*file_properties.ListTemplateResult, error) {
+			return file_properties.NewListTemplateResult([]string{"ptid:team"}), nil
+		},
+		templatesGetForTeamFn: func(arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+			return file_properties.NewGetTemplateResult("Legal hold", "", nil), nil
+		},
+	})
+
+	if err := propsTemplatesList(cmd, nil); err != nil {
+		t.Fatalf("props templates list error: %v", err)
+	}
+	if got, want := stdout.String(), "ptid:team Legal hold\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestPropsTemplatesGetJSONOutputsTemplate(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, retentionTemplateClient())
+
+	if err := propsTemplatesGet(cmd, []string{testRetentionTemplateID}); err != nil {
+		t.Fatalf("props templates get error: %v", err)
+	}
+
+	got := decodePropsOutput[struct{}, jsonPropertyTemplate](t, stdout)
+	if string(got.Input) != `{"template_id":"ptid:retention","team":false}` {
+		t.Fatalf("input = %s", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != propsTemplateStatusFound || got.Results[0].Result.Description != "Records retention" {
+		t.Fatalf("results = %#v, want found template", got.Results)
+	}
+}
+
+func TestPropsTemplatesAddJSONOutputsTemplate(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	for _, spec := range []string{"project", "class:Retention class"} {
+		if err := cmd.Flags().Set("field", spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var added *file_properties.AddTemplateArg
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		templatesAddForUserFn: func(arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+			added = arg
+			return file_properties.NewAddTemplateResult(testRetentionTemplateID), nil
+		},
+	})
+
+	if err := propsTemplatesAdd(cmd, []string{"Retention"}); err != nil {
+		t.Fatalf("props templates add error: %v", err)
+	}
+	if added == nil || added.Name != "Retention" || len(added.Fields) != 2 || added.Fields[1].Description != "Retention class" || added.Fields[1].Type.Tag != file_properties.PropertyTypeString {
+		t.Fatalf("templates/add_for_user arg = %#v", added)
+	}
+
+	got := decodePropsOutput[struct{}, jsonPropertyTemplate](t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != propsTemplateStatusAdded || got.Results[0].Result.TemplateID != testRetentionTemplateID {
+		t.Fatalf("results = %#v, want added template", got.Results)
+	}
+}
+
+func TestPropsTemplatesAddTeamPrintsTemplateID(t *testing.T) {
+	cmd, stdout := testPropsTemplatesCmd()
+	if err := cmd.Flags().Set("team", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Flags().Set("field", "class"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		templatesAddForTeamFn: func(arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+			return file_properties.NewAddTemplateResult("ptid:team"), nil
+		},
+	})
+
+	if err := propsTemplatesAdd(cmd, []string{"Retention"}); err != nil {
+		t.Fatalf("props templates add error: %v", err)
+	}
+	if got := stdout.String(); got != "ptid:team\n" {
+		t.Fatalf("stdout = %q, want template ID", got)
+	}
+}
+
+func TestPropsTemplatesAddRequiresField(t *testing.T) {
+	cmd, _ := testPropsTemplatesCmd()
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{})
+
+	err := propsTemplatesAdd(cmd, []string{"Retention"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+		t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+	}
+	if details := jsonErrorDetails(err); details["flag"] != "field" {
+		t.Fatalf("details = %#v, want field flag", details)
+	}
+}
+
+func TestTokenTypeUsesTeamManageForTeamTemplates(t *testing.T) {
+	if got := tokenType(propsTemplatesListCmd); got != tokenPersonal {
+		t.Fatalf("token type = %q, want personal without --team", got)
+	}
+	if err := propsTemplatesListCmd.Flags().Set("team", "true"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = propsTemplatesListCmd.Flags().Set("team", "false") })
+	if got := tokenType(propsTemplatesListCmd); got != tokenTeamManage {
+		t.Fatalf("token type = %q, want team manage with --team", got)
+	}
+}
@@ -0,0 +1,514 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+type mockFilePropertiesClient struct {
+	propertiesAddFn        func(*file_properties.AddPropertiesArg) error
+	propertiesOverwriteFn  func(*file_properties.OverwritePropertyGroupArg) error
+	propertiesRemoveFn     func(*file_properties.RemovePropertiesArg) error
+	propertiesUpdateFn     func(*file_properties.UpdatePropertiesArg) error
+	templatesAddForTeamFn  func(*file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error)
+	templatesAddForUserFn  func(*file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error)
+	templatesGetForTeamFn  func(*file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error)
+	templatesGetForUserFn  func(*file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error)
+	templatesListForTeamFn func() (*file_properties.ListTemplateResult, error)
+	templatesListForUserFn func() (*file_properties.ListTemplateResult, error)
+}
+
+func (m *mockFilePropertiesClient) PropertiesAdd(arg *file_properties.AddPropertiesArg) error {
+	if m.propertiesAddFn != nil {
+		return m.propertiesAddFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilePropertiesClient) PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg) error {
+	return m.PropertiesAdd(arg)
+}
+
+func (m *mockFilePropertiesClient) PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg) error {
+	if m.propertiesOverwriteFn != nil {
+		return m.propertiesOverwriteFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilePropertiesClient) PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg) error {
+	return m.PropertiesOverwrite(arg)
+}
+
+func (m *mockFilePropertiesClient) PropertiesRemove(arg *file_properties.RemovePropertiesArg) error {
+	if m.propertiesRemoveFn != nil {
+		return m.propertiesRemoveFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilePropertiesClient) PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg) error {
+	return m.PropertiesRemove(arg)
+}
+
+func (m *mockFilePropertiesClient) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg) error {
+	if m.propertiesUpdateFn != nil {
+		return m.propertiesUpdateFn(arg)
+	}
+	return nil
+}
+
+func (m *mockFilePropertiesClient) PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg) error {
+	return m.PropertiesUpdate(arg)
+}
+
+func (m *mockFilePropertiesClient) TemplatesAddForTeam(arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+	if m.templatesAddForTeamFn != nil {
+		return m.templatesAddForTeamFn(arg)
+	}
+	return &file_properties.AddTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesAddForTeamContext(ctx context.Context, arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+	return m.TemplatesAddForTeam(arg)
+}
+
+func (m *mockFilePropertiesClient) TemplatesAddForUser(arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+	if m.templatesAddForUserFn != nil {
+		return m.templatesAddForUserFn(arg)
+	}
+	return &file_properties.AddTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesAddForUserContext(ctx context.Context, arg *file_properties.AddTemplateArg) (*file_properties.AddTemplateResult, error) {
+	return m.TemplatesAddForUser(arg)
+}
+
+func (m *mockFilePropertiesClient) TemplatesGetForTeam(arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+	if m.templatesGetForTeamFn != nil {
+		return m.templatesGetForTeamFn(arg)
+	}
+	return &file_properties.GetTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesGetForTeamContext(ctx context.Context, arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+	return m.TemplatesGetForTeam(arg)
+}
+
+func (m *mockFilePropertiesClient) TemplatesGetForUser(arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+	if m.templatesGetForUserFn != nil {
+		return m.templatesGetForUserFn(arg)
+	}
+	return &file_properties.GetTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesGetForUserContext(ctx context.Context, arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+	return m.TemplatesGetForUser(arg)
+}
+
+func (m *mockFilePropertiesClient) TemplatesListForTeam() (*file_properties.ListTemplateResult, error) {
+	if m.templatesListForTeamFn != nil {
+		return m.templatesListForTeamFn()
+	}
+	return &file_properties.ListTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesListForTeamContext(ctx context.Context) (*file_properties.ListTemplateResult, error) {
+	return m.TemplatesListForTeam()
+}
+
+func (m *mockFilePropertiesClient) TemplatesListForUser() (*file_properties.ListTemplateResult, error) {
+	if m.templatesListForUserFn != nil {
+		return m.templatesListForUserFn()
+	}
+	return &file_properties.ListTemplateResult{}, nil
+}
+
+func (m *mockFilePropertiesClient) TemplatesListForUserContext(ctx context.Context) (*file_properties.ListTemplateResult, error) {
+	return m.TemplatesListForUser()
+}
+
+func stubFilePropertiesClient(t *testing.T, client filePropertiesClient) {
+	t.Helper()
+
+	origNew := filePropertiesNewFunc
+	filePropertiesNewFunc = func(_ dropbox.Config) filePropertiesClient { return client }
+	t.Cleanup(func() { filePropertiesNewFunc = origNew })
+}
+
+const testRetentionTemplateID = "ptid:retention"
+
+// retentionTemplateClient serves a single user template named "Retention".
+func retentionTemplateClient() *mockFilePropertiesClient {
+	return &mockFilePropertiesClient{
+		templatesListForUserFn: func() (*file_properties.ListTemplateResult, error) {
+			return file_properties.NewListTemplateResult([]string{testRetentionTemplateID}), nil
+		},
+		templatesGetForUserFn: func(arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+			stringType := &file_properties.PropertyType{}
+			stringType.Tag = file_properties.PropertyTypeString
+			return file_properties.NewGetTemplateResult("Retention", "Records retention", []*file_properties.PropertyFieldTemplate{
+				file_properties.NewPropertyFieldTemplate("project", "Project code", stringType),
+				file_properties.NewPropertyFieldTemplate("class", "Retention class", stringType),
+			}), nil
+		},
+	}
+}
+
+func retentionPropertyGroup(fields ...string) *file_properties.PropertyGroup {
+	group := file_properties.NewPropertyGroup(testRetentionTemplateID, nil)
+	for i := 0; i+1 < len(fields); i += 2 {
+		group.Fields = append(group.Fields, file_properties.NewPropertyField(fields[i], fields[i+1]))
+	}
+	return group
+}
+
+func testPropsCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "props"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().StringArray("template", nil, "")
+	cmd.Flags().StringArray("remove-field", nil, "")
+	addDryRunFlag(cmd)
+	return cmd, &stdout
+}
+
+type propsOperationOutputForTest[I, R any] struct {
+	Input   json.RawMessage `json:"input"`
+	Results []struct {
+		Status string `json:"status"`
+		Kind   string `json:"kind"`
+		Input  I      `json:"input"`
+		Result R      `json:"result"`
+	} `json:"results"`
+}
+
+func decodePropsOutput[I, R any](t *testing.T, stdout *bytes.Buffer) propsOperationOutputForTest[I, R] {
+	t.Helper()
+
+	var got propsOperationOutputForTest[I, R]
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	return got
+}
+
+func TestPropsGetJSONOutputsPropertyGroups(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, retentionTemplateClient())
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			if arg.IncludePropertyGroups == nil || strings.Join(arg.IncludePropertyGroups.FilterSome, ",") != testRetentionTemplateID {
+				t.Fatalf("include_property_groups = %#v, want the user's templates", arg.IncludePropertyGroups)
+			}
+			file := files.NewFileMetadata("acme.pdf", "id:acme", dropbox.DBXTime(time.Time{}), dropbox.DBXTime(time.Time{}), "0123456789abcdef", 10)
+			file.PathDisplay = "/Contracts/acme.pdf"
+			file.PropertyGroups = []*file_properties.PropertyGroup{retentionPropertyGroup("project", "P-12", "class", "7y")}
+			return file, nil
+		},
+	})
+
+	if err := propsGet(cmd, []string{"/Contracts/acme.pdf"}); err != nil {
+		t.Fatalf("props get error: %v", err)
+	}
+
+	got := decodePropsOutput[struct{}, jsonMetadata](t, stdout)
+	if string(got.Input) != `{"path":"/Contracts/acme.pdf","templates":[]}` {
+		t.Fatalf("input = %s, want path and empty templates", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != propsStatusFound || got.Results[0].Kind != "file" {
+		t.Fatalf("results = %#v, want one found file", got.Results)
+	}
+	groups := got.Results[0].Result.PropertyGroups
+	if len(groups) != 1 || groups[0].TemplateID != testRetentionTemplateID || len(groups[0].Fields) != 2 || groups[0].Fields[1] != (jsonPropertyField{Name: "class", Value: "7y"}) {
+		t.Fatalf("property_groups = %#v, want retention group", groups)
+	}
+}
+
+func TestPropsGetTextListsFields(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set("template", "Retention"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, retentionTemplateClient())
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			folder := files.NewFolderMetadata("Contracts", "id:contracts")
+			folder.PathDisplay = "/Contracts"
+			folder.PropertyGroups = []*file_properties.PropertyGroup{retentionPropertyGroup("project", "P-12")}
+			return folder, nil
+		},
+	})
+
+	if err := propsGet(cmd, []string{"/Contracts"}); err != nil {
+		t.Fatalf("props get error: %v", err)
+	}
+	if got, want := stdout.String(), "ptid:retention project P-12\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestPropsGetUnknownTemplateNameIsNotFound(t *testing.T) {
+	cmd, _ := testPropsCmd()
+	if err := cmd.Flags().Set("template", "Missing"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, retentionTemplateClient())
+	stubFilesClient(t, &mockFilesClient{})
+
+	err := propsGet(cmd, []string{"/Contracts/acme.pdf"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeNotFound {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeNotFound, err)
+	}
+	if details := jsonErrorDetails(err); details["flag"] != "template" || details["value"] != "Missing" {
+		t.Fatalf("details = %#v, want template flag and value", details)
+	}
+}
+
+func TestPropsGetFallsBackToTeamTemplates(t *testing.T) {
+	cmd, _ := testPropsCmd()
+	if err := cmd.Flags().Set("template", "Legal hold"); err != nil {
+		t.Fatal(err)
+	}
+	client := retentionTemplateClient()
+	client.templatesListForTeamFn = func() (*file_properties.ListTemplateResult, error) {
+		return file_properties.NewListTemplateResult([]string{"ptid:team"}), nil
+	}
+	client.templatesGetForTeamFn = func(arg *file_properties.GetTemplateArg) (*file_properties.GetTemplateResult, error) {
+		return file_properties.NewGetTemplateResult("Legal hold", "", nil), nil
+	}
+	stubFilePropertiesClient(t, client)
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			if arg.IncludePropertyGroups == nil || strings.Join(arg.IncludePropertyGroups.FilterSome, ",") != "ptid:team" {
+				t.Fatalf("include_property_groups = %#v, want the team template", arg.IncludePropertyGroups)
+			}
+			return files.NewFolderMetadata("Contracts", "id:contracts"), nil
+		},
+	})
+
+	if err := propsGet(cmd, []string{"/Contracts"}); err != nil {
+		t.Fatalf("props get error: %v", err)
+	}
+}
+
+func TestPropsGetDefaultIncludesListableTeamTemplates(t *testing.T) {
+	tests := map[string]struct {
+		listTeam func() (*file_properties.ListTemplateResult, error)
+		want     string
+	}{
+		"team token": {
+			listTeam: func() (*file_properties.ListTemplateResult, error) {
+				return file_properties.NewListTemplateResult([]string{"ptid:team"}), nil
+			},
+			want: testRetentionTemplateID + ",ptid:team",
+		},
+		"user token": {
+			listTeam: func() (*file_properties.ListTemplateResult, error) {
+				return nil, errors.New("team templates need a team token")
+			},
+			want: testRetentionTemplateID,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := retentionTemplateClient()
+			client.templatesListForTeamFn = tt.listTeam
+			ids, err := propsGetTemplateIDs(client, nil)
+			if err != nil {
+				t.Fatalf("propsGetTemplateIDs error: %v", err)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Fatalf("template IDs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPropsSetJSONOutputsResult(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var added *file_properties.AddPropertiesArg
+	client := retentionTemplateClient()
+	client.propertiesAddFn = func(arg *file_properties.AddPropertiesArg) error {
+		added = arg
+		return nil
+	}
+	stubFilePropertiesClient(t, client)
+
+	if err := propsSet(cmd, []string{"/Contracts/acme.pdf", "Retention", "project=P-12", "note=a=b"}); err != nil {
+		t.Fatalf("props set error: %v", err)
+	}
+	if added == nil || added.Path != "/Contracts/acme.pdf" || added.PropertyGroups[0].TemplateId != testRetentionTemplateID {
+		t.Fatalf("properties/add arg = %#v, want resolved template on path", added)
+	}
+	if field := added.PropertyGroups[0].Fields[1]; field.Name != "note" || field.Value != "a=b" {
+		t.Fatalf("field = %#v, want value split on the first '='", field)
+	}
+
+	got := decodePropsOutput[propsInput, propertyGroupResult](t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != propsStatusSet || got.Results[0].Kind != propsKindPropertyGroup {
+		t.Fatalf("results = %#v, want one set property group", got.Results)
+	}
+	if got.Results[0].Input != (propsInput{Path: "/Contracts/acme.pdf", TemplateID: testRetentionTemplateID}) {
+		t.Fatalf("input = %#v", got.Results[0].Input)
+	}
+	if fields := got.Results[0].Result.Fields; len(fields) != 2 || fields[0] != (jsonPropertyField{Name: "project", Value: "P-12"}) {
+		t.Fatalf("fields = %#v", fields)
+	}
+}
+
+func TestPropsSetOverwritesExistingGroup(t *testing.T) {
+	cmd, _ := testPropsCmd()
+	overwritten := false
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		propertiesAddFn: func(arg *file_properties.AddPropertiesArg) error {
+			apiErr := file_properties.PropertiesAddAPIError{EndpointError: &file_properties.AddPropertiesError{}}
+			apiErr.EndpointError.Tag = file_properties.AddPropertiesErrorPropertyGroupAlreadyExists
+			return apiErr
+		},
+		propertiesOverwriteFn: func(arg *file_properties.OverwritePropertyGroupArg) error {
+			overwritten = arg.Path == "/a.pdf" && arg.PropertyGroups[0].TemplateId == testRetentionTemplateID
+			return nil
+		},
+	})
+
+	if err := propsSet(cmd, []string{"/a.pdf", testRetentionTemplateID, "class=7y"}); err != nil {
+		t.Fatalf("props set error: %v", err)
+	}
+	if !overwritten {
+		t.Fatal("expected properties/overwrite after property_group_already_exists")
+	}
+}
+
+func TestPropsSetDryRunSkipsWrites(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set(dryRunFlagName, "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		propertiesAddFn: func(arg *file_properties.AddPropertiesArg) error {
+			t.Fatal("properties/add called during dry-run")
+			return nil
+		},
+	})
+
+	if err := propsSet(cmd, []string{"/a.pdf", testRetentionTemplateID, "class=7y"}); err != nil {
+		t.Fatalf("props set error: %v", err)
+	}
+	if got, want := stdout.String(), "Would set ptid:retention properties on /a.pdf\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestPropsSetPropagatesAPIError(t *testing.T) {
+	cmd, _ := testPropsCmd()
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		propertiesAddFn: func(arg *file_properties.AddPropertiesArg) error {
+			return errors.New("path/not_found/")
+		},
+	})
+
+	err := propsSet(cmd, []string{"/missing.pdf", testRetentionTemplateID, "class=7y"})
+	if details := jsonErrorDetails(err); details["operation"] != propsOperationSet || details["path"] != "/missing.pdf" {
+		t.Fatalf("details = %#v, want operation and path", details)
+	}
+}
+
+func TestPropsMutationArgValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*cobra.Command, []string) error
+		args []string
+	}{
+		{name: "set missing fields", run: propsSet, args: []string{"/a.pdf", testRetentionTemplateID}},
+		{name: "set malformed field", run: propsSet, args: []string{"/a.pdf", testRetentionTemplateID, "class"}},
+		{name: "set empty field name", run: propsSet, args: []string{"/a.pdf", testRetentionTemplateID, "=7y"}},
+		{name: "update without changes", run: propsUpdate, args: []string{"/a.pdf", testRetentionTemplateID}},
+		{name: "remove missing template", run: propsRemove, args: []string{"/a.pdf"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testPropsCmd()
+			stubFilePropertiesClient(t, &mockFilePropertiesClient{})
+			if code := jsonErrorCode(tt.run(cmd, tt.args)); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+		})
+	}
+}
+
+func TestPropsUpdateJSONOutputsResult(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Flags().Set("remove-field", "project"); err != nil {
+		t.Fatal(err)
+	}
+	var update *file_properties.PropertyGroupUpdate
+	stubFilePropertiesClient(t, &mockFilePropertiesClient{
+		propertiesUpdateFn: func(arg *file_properties.UpdatePropertiesArg) error {
+			update = arg.UpdatePropertyGroups[0]
+			return nil
+		},
+	})
+
+	if err := propsUpdate(cmd, []string{"/a.pdf", testRetentionTemplateID, "class=10y"}); err != nil {
+		t.Fatalf("props update error: %v", err)
+	}
+	if update == nil || len(update.AddOrUpdateFields) != 1 || strings.Join(update.RemoveFields, ",") != "project" {
+		t.Fatalf("update = %#v, want one changed and one removed field", update)
+	}
+
+	got := decodePropsOutput[propsInput, propertyGroupResult](t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != propsStatusUpdated {
+		t.Fatalf("results = %#v, want one updated result", got.Results)
+	}
+	if removed := got.Results[0].Result.RemovedFields; len(removed) != 1 || removed[0] != "project" {
+		t.Fatalf("removed_fields = %#v", removed)
+	}
+}
+
+func TestPropsRemoveJSONOutputsResult(t *testing.T) {
+	cmd, stdout := testPropsCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var removed []string
+	client := retentionTemplateClient()
+	client.propertiesRemoveFn = func(arg *file_properties.RemovePropertiesArg) error {
+		removed = arg.PropertyTemplateIds
+		return nil
+	}
+	stubFilePropertiesClient(t, client)
+
+	if err := propsRemove(cmd, []string{"/a.pdf", "retention"}); err != nil {
+		t.Fatalf("props remove error: %v", err)
+	}
+	if strings.Join(removed, ",") != testRetentionTemplateID {
+		t.Fatalf("removed templates = %v, want name resolved case-insensitively", removed)
+	}
+
+	got := decodePropsOutput[propsInput, propertyGroupResult](t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != propsStatusRemoved || got.Results[0].Result.Fields == nil {
+		t.Fatalf("results = %#v, want removed result with empty fields", got.Results)
+	}
+}
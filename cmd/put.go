@@ -204,9 +204,16 @@ type putOptions struct {
 	workers   int
 	debug     bool
 	ifExists  string
-	dryRun    bool
-	output    *output.Renderer
-	errOut    io.Writer
+	// ifRev and ifUnchangedSince make the upload replace only a known
+	// revision of the destination file.
+	ifRev            string
+	ifUnchangedSince time.Time
+	// encrypt, when set, encrypts each file before upload and adds the
+	// encrypted file extension to its Dropbox path.
+	encrypt *encryptionKeys
+	dryRun  bool
+	output  *output.Renderer
+	errOut  io.Writer
 }
 
 const (
@@ -221,12 +228,16 @@ const (
 )
 
 type putCommandInput struct {
-	Source    string `json:"source"`
-	Target    string `json:"target"`
-	Recursive bool   `json:"recursive"`
-	IfExists  string `json:"if_exists"`
-	Stdin     bool   `json:"stdin"`
-	DryRun    bool   `json:"dry_run,omitempty"`
+	Source           string `json:"source"`
+	Target           string `json:"target"`
+	Recursive        bool   `json:"recursive"`
+	IfExists         string `json:"if_exists"`
+	IfRev            string `json:"if_rev,omitempty"`
+	IfUnchangedSince string `json:"if_unchanged_since,omitempty"`
+	Stdin            bool   `json:"stdin"`
+	Untar            bool   `json:"untar"`
+	Encrypt          bool   `json:"encrypt,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
 }
 
 type putResultInput struct {
@@ -302,6 +313,13 @@ func put(cmd *cobra.Command, args []string) (err error) {
 
 	src := args[0]
 
+	if untar, _ := cmd.Flags().GetBool("untar"); untar {
+		if opts.encrypt != nil {
+			return invalidArgumentsErrorWithDetails("`--encrypt` cannot be used with --untar", flagsErrorDetails("encrypt", "untar"))
+		}
+		return putUntar(cmd, args, opts, recursive)
+	}
+
 	if src == "-" {
 		return putStdin(cmd, args, opts, recursive)
 	}
@@ -314,6 +332,9 @@ func put(cmd *cobra.Command, args []string) (err error) {
 	if srcInfo.IsDir() && !recursive {
 		return invalidArgumentsErrorfWithDetails("%s is a directory (use --recursive to upload directories)", mergeJSONErrorDetails(operationErrorDetails("upload"), pathErrorDetails(src)), src)
 	}
+	if srcInfo.IsDir() && opts.hasRevCondition() {
+		return invalidArgumentsErrorWithDetails("`--if-rev` and `--if-unchanged-since` only apply to single-file uploads", mergeJSONErrorDetails(operationErrorDetails("upload"), flagsErrorDetails("recursive", opts.revConditionFlag())))
+	}
 
 	// Default `dst` to the base segment of the source path; use the second argument if provided.
 	dst := "/" + filepath.Base(src)
@@ -327,12 +348,12 @@ func put(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	if !srcInfo.IsDir() {
-		dst = resolveDestination(filesNewFunc(config), src, dst, dstIsDir)
+		dst = putTargetPath(resolveDestination(filesNewFunc(config), src, dst, dstIsDir), opts)
 	}
 
 	if srcInfo.IsDir() {
 		if opts.dryRun {
-			results, warnings, err := plannedPutRecursiveResults(src, dst)
+			results, warnings, err := plannedPutRecursiveResults(src, dst, opts)
 			if err != nil {
 				return withJSONErrorDetails(err, operationErrorDetails("upload"), relocationErrorDetails(src, dst))
 			}
@@ -342,6 +363,7 @@ func put(cmd *cobra.Command, args []string) (err error) {
 				Recursive: true,
 				IfExists:  opts.ifExists,
 				Stdin:     false,
+				Encrypt:   opts.encrypt != nil,
 				DryRun:    true,
 			}, results, warnings)
 		}
@@ -358,6 +380,7 @@ func put(cmd *cobra.Command, args []string) (err error) {
 			Recursive: true,
 			IfExists:  opts.ifExists,
 			Stdin:     false,
+			Encrypt:   opts.encrypt != nil,
 			DryRun:    false,
 		}, results, warnings)
 	}
@@ -365,12 +388,15 @@ func put(cmd *cobra.Command, args []string) (err error) {
 	if opts.dryRun {
 		result := plannedPutFileResult(src, dst)
 		return renderPlannedPutResults(cmd, putCommandInput{
-			Source:    src,
-			Target:    dst,
-			Recursive: false,
-			IfExists:  opts.ifExists,
-			Stdin:     false,
-			DryRun:    true,
+			Source:           src,
+			Target:           dst,
+			Recursive:        false,
+			IfExists:         opts.ifExists,
+			IfRev:            opts.ifRev,
+			IfUnchangedSince: putUnchangedSinceInput(opts),
+			Stdin:            false,
+			Encrypt:          opts.encrypt != nil,
+			DryRun:           true,
 		}, []putResult{result}, nil)
 	}
 
@@ -379,12 +405,15 @@ func put(cmd *cobra.Command, args []string) (err error) {
 		return withJSONErrorDetails(err, operationErrorDetails("upload"), relocationErrorDetails(src, dst))
 	}
 	return renderPutResults(cmd, putCommandInput{
-		Source:    src,
-		Target:    dst,
-		Recursive: false,
-		IfExists:  opts.ifExists,
-		Stdin:     false,
-		DryRun:    false,
+		Source:           src,
+		Target:           dst,
+		Recursive:        false,
+		IfExists:         opts.ifExists,
+		IfRev:            opts.ifRev,
+		IfUnchangedSince: putUnchangedSinceInput(opts),
+		Stdin:            false,
+		Encrypt:          opts.encrypt != nil,
+		DryRun:           false,
 	}, []putResult{result})
 }
 
@@ -405,16 +434,20 @@ func putStdin(cmd *cobra.Command, args []string, opts putOptions, recursive bool
 	if err != nil {
 		return err
 	}
+	dstPath = putTargetPath(dstPath, opts)
 
 	if opts.dryRun {
 		result := plannedPutFileResult("-", dstPath)
 		return renderPlannedPutResults(cmd, putCommandInput{
-			Source:    "-",
-			Target:    dstPath,
-			Recursive: false,
-			IfExists:  opts.ifExists,
-			Stdin:     true,
-			DryRun:    true,
+			Source:           "-",
+			Target:           dstPath,
+			Recursive:        false,
+			IfExists:         opts.ifExists,
+			IfRev:            opts.ifRev,
+			IfUnchangedSince: putUnchangedSinceInput(opts),
+			Stdin:            true,
+			Encrypt:          opts.encrypt != nil,
+			DryRun:           true,
 		}, []putResult{result}, nil)
 	}
 
@@ -430,50 +463,35 @@ func putStdin(cmd *cobra.Command, args []string, opts putOptions, recursive bool
 			return err
 		}
 		return renderPutResults(cmd, putCommandInput{
-			Source:    "-",
-			Target:    dstPath,
-			Recursive: false,
-			IfExists:  opts.ifExists,
-			Stdin:     true,
-			DryRun:    false,
+			Source:           "-",
+			Target:           dstPath,
+			Recursive:        false,
+			IfExists:         opts.ifExists,
+			IfRev:            opts.ifRev,
+			IfUnchangedSince: putUnchangedSinceInput(opts),
+			Stdin:            true,
+			Encrypt:          opts.encrypt != nil,
+			DryRun:           false,
 		}, []putResult{result})
 	}
 
-	tmpPath, _, cleanup, err := spoolStdinToTemp(cmd.InOrStdin())
+	result, err := putStreamWithResult(cmd.InOrStdin(), dstPath, opts)
 	if err != nil {
 		return withJSONErrorDetails(err, operationErrorDetails("upload"), relocationErrorDetails("-", dstPath))
 	}
-
-	result, uploadErr := putFileWithResult(tmpPath, dstPath, opts)
-	cleanupErr := cleanup()
-
-	if uploadErr != nil {
-		if cleanupErr != nil {
-			reportStdinCleanupFailure(opts, tmpPath, cleanupErr)
-		}
-		return withJSONErrorDetails(uploadErr, operationErrorDetails("upload"), relocationErrorDetails("-", dstPath))
-	}
-
-	if cleanupErr != nil {
-		reportStdinCleanupFailure(opts, tmpPath, cleanupErr)
-		return withJSONErrorDetails(fmt.Errorf("failed to remove temp file %s after upload; sensitive stdin data may remain on disk: %w", tmpPath, cleanupErr), operationErrorDetails("upload"), relocationErrorDetails("-", dstPath))
-	}
-
-	result.Input.Source = "-"
 	return renderPutResults(cmd, putCommandInput{
-		Source:    "-",
-		Target:    dstPath,
-		Recursive: false,
-		IfExists:  opts.ifExists,
-		Stdin:     true,
-		DryRun:    false,
+		Source:           "-",
+		Target:           dstPath,
+		Recursive:        false,
+		IfExists:         opts.ifExists,
+		IfRev:            opts.ifRev,
+		IfUnchangedSince: putUnchangedSinceInput(opts),
+		Stdin:            true,
+		Encrypt:          opts.encrypt != nil,
+		DryRun:           false,
 	}, []putResult{result})
 }
 
-func reportStdinCleanupFailure(opts putOptions, tmpPath string, err error) {
-	putOutput(opts).Status("error: failed to remove temp file %s: %v; sensitive stdin data may remain on disk", tmpPath, err)
-}
-
 func resolveDestination(dbx filesClient, src, dst string, dstIsDir bool) string {
 	if dstIsDir {
 		return path.Join("/", dst, filepath.Base(src))
@@ -514,21 +532,97 @@ func parsePutOptions(cmd *cobra.Command) (putOptions, error) {
 	if err != nil {
 		return putOptions{}, err
 	}
+	ifRev, ifUnchangedSince, err := parsePutRevCondition(cmd)
+	if err != nil {
+		return putOptions{}, err
+	}
+	encrypt, err := parseEncryptFlags(cmd)
+	if err != nil {
+		return putOptions{}, err
+	}
 	dryRun, err := dryRunEnabled(cmd)
 	if err != nil {
 		return putOptions{}, err
 	}
 	return putOptions{
-		chunkSize: chunkSize,
-		workers:   workers,
-		debug:     debug,
-		ifExists:  ifExists,
-		dryRun:    dryRun,
-		output:    commandOutput(cmd),
-		errOut:    cmd.ErrOrStderr(),
+		chunkSize:        chunkSize,
+		workers:          workers,
+		debug:            debug,
+		ifExists:         ifExists,
+		ifRev:            ifRev,
+		ifUnchangedSince: ifUnchangedSince,
+		encrypt:          encrypt,
+		dryRun:           dryRun,
+		output:           commandOutput(cmd),
+		errOut:           cmd.ErrOrStderr(),
 	}, nil
 }
 
+// parsePutRevCondition reads --if-rev and --if-unchanged-since. Both replace
+// --if-exists: the upload overwrites only the expected revision.
+func parsePutRevCondition(cmd *cobra.Command) (string, time.Time, error) {
+	ifRev, _ := cmd.Flags().GetString("if-rev")
+	since, _ := cmd.Flags().GetString("if-unchanged-since")
+	ifRev = strings.TrimSpace(ifRev)
+	since = strings.TrimSpace(since)
+	if ifRev == "" && since == "" {
+		return "", time.Time{}, nil
+	}
+	if ifRev != "" && since != "" {
+		return "", time.Time{}, invalidArgumentsErrorWithDetails("`--if-rev` and `--if-unchanged-since` cannot be used together", flagsErrorDetails("if-rev", "if-unchanged-since"))
+	}
+	flag := "if-rev"
+	if since != "" {
+		flag = "if-unchanged-since"
+	}
+	if cmd.Flags().Changed("if-exists") {
+		return "", time.Time{}, invalidArgumentsErrorfWithDetails("`--%s` cannot be used with `--if-exists`", flagsErrorDetails(flag, "if-exists"), flag)
+	}
+	if ifRev != "" {
+		if !isDropboxRevision(ifRev) {
+			return "", time.Time{}, invalidArgumentsErrorfWithDetails("invalid --if-rev %q: use a file revision such as the `rev` field from `ls --output json`", flagValueErrorDetails("if-rev", ifRev), ifRev)
+		}
+		return ifRev, time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return "", time.Time{}, invalidArgumentsErrorfWithDetails("invalid --if-unchanged-since %q: use RFC3339 timestamp", flagValueErrorDetails("if-unchanged-since", since), since)
+	}
+	return "", parsed.UTC(), nil
+}
+
+// isDropboxRevision reports whether rev looks like a Dropbox file revision:
+// at least nine lowercase hex digits.
+func isDropboxRevision(rev string) bool {
+	if len(rev) < 9 {
+		return false
+	}
+	for _, r := range rev {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func (opts putOptions) hasRevCondition() bool {
+	return opts.ifRev != "" || !opts.ifUnchangedSince.IsZero()
+}
+
+func (opts putOptions) revConditionFlag() string {
+	if opts.ifRev != "" {
+		return "if-rev"
+	}
+	return "if-unchanged-since"
+}
+
+func putUnchangedSinceInput(opts putOptions) string {
+	if opts.ifUnchangedSince.IsZero() {
+		return ""
+	}
+	return opts.ifUnchangedSince.Format(time.RFC3339)
+}
+
 func parsePutIfExists(cmd *cobra.Command) (string, error) {
 	ifExists, err := cmd.Flags().GetString("if-exists")
 	if err != nil {
@@ -587,30 +681,112 @@ func putFileWithResult(src, dst string, opts putOptions) (putResult, error) {
 		return putResult{}, err
 	}
 
-	commitInfo := files.NewCommitInfo(dst)
-	commitInfo.Mode.Tag = writeModeForIfExists(ifExists)
-	commitInfo.StrictConflict = ifExists != putIfExistsOverwrite
-	commitInfo.Autorename = ifExists == putIfExistsAutorename
-
+	commitInfo, err := newPutCommitInfo(dbx, dst, ifExists, opts)
+	if err != nil {
+		return putResult{}, err
+	}
 	commitInfo.ClientModified = dropboxClientModified(contentsInfo.ModTime())
 
+	if opts.encrypt != nil {
+		metadata, err := uploadEncrypted(dbx, contents, contentsInfo.Size(), commitInfo, opts)
+		return putUploadResult(dbx, src, dst, ifExists, opts, commitInfo, metadata, err)
+	}
+
+	var metadata *files.FileMetadata
 	if contentsInfo.Size() > singleShotUploadSizeCutoff {
-		metadata, err := uploadChunked(dbx, uploadProgressReader(contents, contentsInfo.Size(), putErrorOutput(opts)), commitInfo, contentsInfo.Size(), opts.workers, opts.chunkSize, opts.debug)
-		if err != nil && ifExists == putIfExistsSkip && isUploadDestinationFileConflict(err) {
-			reportPutSkipped(opts, dst)
-			return newPutResult(putStatusSkipped, putKindFile, src, dst, nil)
+		metadata, err = uploadChunked(dbx, uploadProgressReader(contents, contentsInfo.Size(), putErrorOutput(opts)), commitInfo, contentsInfo.Size(), opts.workers, opts.chunkSize, opts.debug)
+	} else {
+		uploadArg := &files.UploadArg{CommitInfo: *commitInfo}
+		metadata, err = uploadSingleShot(dbx, contents, uploadArg, contentsInfo.Size(), putErrorOutput(opts))
+	}
+	return putUploadResult(dbx, src, dst, ifExists, opts, commitInfo, metadata, err)
+}
+
+// uploadEncrypted uploads the encrypted form of size bytes from contents.
+// The encrypted size is known up front, so large files still go through
+// parallel upload sessions; contents is capped at size so a file that grows
+// during the upload cannot produce a stream that does not match it.
+func uploadEncrypted(dbx filesClient, contents io.Reader, size int64, commitInfo *files.CommitInfo, opts putOptions) (*files.FileMetadata, error) {
+	contents = io.LimitReader(contents, size)
+	encryptedSize := opts.encrypt.encryptedSize(size)
+	if encryptedSize > singleShotUploadSizeCutoff {
+		r, err := newEncryptReader(uploadProgressReader(contents, size, putErrorOutput(opts)), opts.encrypt)
+		if err != nil {
+			return nil, err
 		}
+		return uploadChunked(dbx, r, commitInfo, encryptedSize, opts.workers, opts.chunkSize, opts.debug)
+	}
+	r, err := encryptToMemory(contents, opts.encrypt)
+	if err != nil {
+		return nil, err
+	}
+	return uploadSingleShot(dbx, r, &files.UploadArg{CommitInfo: *commitInfo}, r.Size(), putErrorOutput(opts))
+}
+
+// putTargetPath adds the encrypted file extension to a file target when
+// uploads are encrypted.
+func putTargetPath(dst string, opts putOptions) string {
+	if opts.encrypt == nil {
+		return dst
+	}
+	return encryptedPath(dst)
+}
+
+// putStreamWithResult uploads r to dst as it is read, so stdin never touches
+// the local disk. The destination was already checked by the caller.
+func putStreamWithResult(r io.Reader, dst string, opts putOptions) (putResult, error) {
+	ifExists, err := normalizePutIfExists(opts.ifExists)
+	if err != nil {
+		return putResult{}, err
+	}
+
+	dbx := filesNewFunc(config)
+	commitInfo, err := newPutCommitInfo(dbx, dst, ifExists, opts)
+	if err != nil {
+		return putResult{}, err
+	}
+	commitInfo.ClientModified = dropboxClientModified(time.Now())
+
+	if opts.encrypt != nil {
+		r, err = newEncryptReader(r, opts.encrypt)
 		if err != nil {
 			return putResult{}, err
 		}
-		return newPutResult(putUploadStatus(ifExists, dst, metadata), putKindFile, src, dst, metadata)
 	}
+	metadata, err := uploadStream(dbx, r, commitInfo, opts.chunkSize, putErrorOutput(opts), opts.debug)
+	return putUploadResult(dbx, "-", dst, ifExists, opts, commitInfo, metadata, err)
+}
 
-	uploadArg := &files.UploadArg{CommitInfo: *commitInfo}
-	metadata, err := uploadSingleShot(dbx, contents, uploadArg, contentsInfo.Size(), putErrorOutput(opts))
-	if err != nil && ifExists == putIfExistsSkip && isUploadDestinationFileConflict(err) {
-		reportPutSkipped(opts, dst)
-		return newPutResult(putStatusSkipped, putKindFile, src, dst, nil)
+// newPutCommitInfo maps --if-exists, --if-rev and --if-unchanged-since to the
+// commit write mode.
+func newPutCommitInfo(dbx filesClient, dst, ifExists string, opts putOptions) (*files.CommitInfo, error) {
+	commitInfo := files.NewCommitInfo(dst)
+	commitInfo.Mode.Tag = writeModeForIfExists(ifExists)
+	commitInfo.StrictConflict = ifExists != putIfExistsOverwrite
+	commitInfo.Autorename = ifExists == putIfExistsAutorename
+	if opts.hasRevCondition() {
+		rev, err := expectedPutRevision(dbx, dst, opts)
+		if err != nil {
+			return nil, err
+		}
+		commitInfo.Mode = &files.WriteMode{Tagged: dropbox.Tagged{Tag: files.WriteModeUpdate}, Update: rev}
+		commitInfo.StrictConflict = true
+		commitInfo.Autorename = false
+	}
+	return commitInfo, nil
+}
+
+// putUploadResult turns an upload outcome into a put result. A file conflict
+// is a skip for --if-exists=skip and a rev_conflict for --if-rev.
+func putUploadResult(dbx filesClient, src, dst, ifExists string, opts putOptions, commitInfo *files.CommitInfo, metadata *files.FileMetadata, err error) (putResult, error) {
+	if err != nil && isUploadDestinationFileConflict(err) {
+		if ifExists == putIfExistsSkip {
+			reportPutSkipped(opts, dst)
+			return newPutResult(putStatusSkipped, putKindFile, src, dst, nil)
+		}
+		if opts.hasRevCondition() {
+			return putResult{}, putRevConflictError(dbx, dst, commitInfo.Mode.Update, err)
+		}
 	}
 	if err != nil {
 		return putResult{}, err
@@ -628,6 +804,51 @@ func putUploadStatus(ifExists, dst string, metadata *files.FileMetadata) string
 	return putStatusUploaded
 }
 
+// expectedPutRevision returns the revision the upload must replace. For
+// --if-unchanged-since it is the current revision, provided the file has not
+// been modified after the given time.
+func expectedPutRevision(dbx filesClient, dst string, opts putOptions) (string, error) {
+	if opts.ifRev != "" {
+		return opts.ifRev, nil
+	}
+	meta, exists, err := getDestinationMetadata(dbx, dst)
+	if err != nil {
+		return "", err
+	}
+	file, ok := meta.(*files.FileMetadata)
+	if !exists || !ok {
+		return "", revConflictError(dst, "", fmt.Errorf("%s is not an existing file; it cannot be unchanged since %s", dst, putUnchangedSinceInput(opts)))
+	}
+	modified := time.Time(file.ServerModified)
+	if modified.After(opts.ifUnchangedSince) {
+		return "", revConflictError(dst, file.Rev, fmt.Errorf("%s was modified at %s, after %s; current revision is %s", dst, modified.UTC().Format(time.RFC3339), putUnchangedSinceInput(opts), file.Rev))
+	}
+	return file.Rev, nil
+}
+
+// putRevConflictError reports a stale update:<rev> upload together with the
+// revision now on Dropbox, so callers can re-read the file and retry.
+func putRevConflictError(dbx filesClient, dst, expected string, err error) error {
+	current := ""
+	if meta, exists, metaErr := getDestinationMetadata(dbx, dst); metaErr == nil && exists {
+		if file, ok := meta.(*files.FileMetadata); ok {
+			current = file.Rev
+		}
+	}
+	if current == "" {
+		return revConflictError(dst, "", fmt.Errorf("%s no longer matches revision %s: %w", dst, expected, err))
+	}
+	return revConflictError(dst, current, fmt.Errorf("%s no longer matches revision %s; current revision is %s: %w", dst, expected, current, err))
+}
+
+func revConflictError(dst, current string, err error) error {
+	details := pathErrorDetails(dst)
+	if current != "" {
+		details = mergeJSONErrorDetails(details, revisionErrorDetails(current))
+	}
+	return newCodedError(jsonErrorCodeRevConflict, err, details)
+}
+
 func writeModeForIfExists(ifExists string) string {
 	if ifExists == putIfExistsOverwrite {
 		return files.WriteModeOverwrite
@@ -841,7 +1062,7 @@ func renderPlannedPutResults(cmd *cobra.Command, input putCommandInput, results
 
 // Keep traversal semantics aligned with putRecursiveInternal. Dry-run walks the
 // same local tree but plans results instead of creating Dropbox writes.
-func plannedPutRecursiveResults(src, dst string) ([]putResult, []jsonWarning, error) {
+func plannedPutRecursiveResults(src, dst string, opts putOptions) ([]putResult, []jsonWarning, error) {
 	src = filepath.Clean(src)
 	var results []putResult
 	var warnings []jsonWarning
@@ -870,7 +1091,7 @@ func plannedPutRecursiveResults(src, dst string) ([]putResult, []jsonWarning, er
 			return err
 		}
 		dirsWithFiles[filepath.Dir(filePath)] = true
-		remotePath := path.Join(dst, filepath.ToSlash(relPath))
+		remotePath := putTargetPath(path.Join(dst, filepath.ToSlash(relPath)), opts)
 		results = append(results, plannedPutFileResult(filePath, remotePath))
 		return nil
 	})
@@ -942,7 +1163,7 @@ func putRecursiveInternal(src, dst string, opts putOptions, collectResults bool)
 
 		dirsWithFiles[filepath.Dir(filePath)] = true
 
-		remotePath := path.Join(dst, filepath.ToSlash(relPath))
+		remotePath := putTargetPath(path.Join(dst, filepath.ToSlash(relPath)), opts)
 		putOutput(opts).Status("Processing %s -> %s", filePath, remotePath)
 
 		if collectResults {
@@ -1077,17 +1298,34 @@ var putCmd = &cobra.Command{
   - If target is not provided, uploads to the root of your Dropbox.
   - Use --recursive (-r) to upload entire directories.
   - Use - as source to read from stdin (target is required).
-    Stdin is spooled to a temp file before upload and may use disk
-    space up to the full input size.
+    Stdin is streamed to Dropbox one chunk at a time; it is never written
+    to local disk, and memory use stays at about one chunk (--chunksize).
+  - Use --untar to read a tar stream (from stdin with -, or a local tar
+    file) and upload each entry under the target folder, keeping entry
+    paths and modified times. Links in the stream are skipped, and entries
+    that fail to upload are reported as warnings unless every entry fails.
   - Files larger than 32MiB use Dropbox upload sessions. Each chunk is one
     upload-session request; chunk size must be a multiple of 4MiB and no more
     than 128MiB.
+  - Use --encrypt to encrypt files before upload (AES-256-GCM, in 64KiB
+    authenticated chunks). The key comes from --passphrase-file, the
+    DBXCLI_ENCRYPTION_PASSPHRASE environment variable, or --recipient, a
+    PEM X25519 public key. Encrypted files get a .dbxenc extension; use
+    get --decrypt to read them back.
+  - --if-rev <rev> replaces the destination only while its revision is
+    still <rev>; --if-unchanged-since <time> requires that it was not
+    modified after <time>. Either fails with rev_conflict and the current
+    revision when the file changed, so callers can re-read and retry.
 `,
 	Example: `  dbxcli put file.txt /destination/file.txt
   dbxcli put -r ./project /backup/project
   dbxcli put -w 1 -c 134217728 large.zip /backup/large.zip
   printf 'hello' | dbxcli put - /hello.txt
-  tar cz ./src | dbxcli put - /backups/src.tgz`,
+  tar cz ./src | dbxcli put - /backups/src.tgz
+  tar c -C ./site . | dbxcli put --untar - /Projects/site
+  dbxcli put --if-rev 015f3a2b1c0d0000000 config.yaml /config.yaml
+  dbxcli put --encrypt --passphrase-file ~/.dbxcli-key taxes.pdf /Private/
+  dbxcli put --encrypt --recipient backup.pub.pem -r ./photos /Backups/photos`,
 	RunE: put,
 }
 
@@ -1100,4 +1338,10 @@ func init() {
 	putCmd.Flags().Int64P("chunksize", "c", 1<<24, "Chunk size in bytes for chunked large-file uploads; must be a multiple of 4MiB and no more than 128MiB")
 	putCmd.Flags().BoolP("debug", "d", false, "Print debug timing")
 	putCmd.Flags().String("if-exists", putIfExistsOverwrite, "What to do when the destination file exists: overwrite, skip, autorename, or fail")
+	putCmd.Flags().Bool("untar", false, "Upload each entry of a tar stream under the target folder")
+	putCmd.Flags().String("if-rev", "", "Only replace the destination file if its current revision is this rev")
+	putCmd.Flags().String("if-unchanged-since", "", "Only replace the destination file if it was not modified after this RFC3339 timestamp")
+	putCmd.Flags().Bool("encrypt", false, "Encrypt files before upload and add the .dbxenc extension")
+	putCmd.Flags().String("passphrase-file", "", "Read the --encrypt passphrase from a file (default: $DBXCLI_ENCRYPTION_PASSPHRASE)")
+	putCmd.Flags().String("recipient", "", "Encrypt to this PEM X25519 public key instead of a passphrase")
 }
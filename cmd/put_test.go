@@ -247,6 +247,9 @@ func testPutCmd() *cobra.Command {
 	cmd.Flags().Int64P("chunksize", "c", 1<<24, "Chunk size to use (should be multiple of 4MiB)")
 	cmd.Flags().BoolP("debug", "d", false, "Print debug timing")
 	cmd.Flags().String("if-exists", putIfExistsOverwrite, "What to do when the destination file exists: overwrite, skip, or fail")
+	cmd.Flags().Bool("untar", false, "")
+	cmd.Flags().String("if-rev", "", "")
+	cmd.Flags().String("if-unchanged-since", "", "")
 	return cmd
 }
 
@@ -463,6 +466,30 @@ func TestPutFileDestinationTrailingSlash(t *testing.T) {
 	}
 }
 
+func TestPutLockedFileReturnsFileLocked(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "logo.psd")
+	if err := os.WriteFile(tmpFile, []byte("test"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			return nil, files.UploadAPIError{APIError: dropbox.APIError{ErrorSummary: "path/locked/.."}}
+		},
+	})
+
+	err := put(testPutCmd(), []string{tmpFile, "/Design/logo.psd"})
+	if err == nil {
+		t.Fatal("expected upload error for locked file")
+	}
+	if code := jsonErrorCode(err); code != jsonErrorCodeFileLocked {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeFileLocked, err)
+	}
+	if code := exitCodeForError(err); code != exitCodeConflict {
+		t.Fatalf("exit code = %d, want %d", code, exitCodeConflict)
+	}
+}
+
 func TestPutArgValidation(t *testing.T) {
 	err := put(testPutCmd(), []string{})
 	if err == nil {
@@ -715,6 +742,159 @@ func TestPutIfExistsValidation(t *testing.T) {
 	}
 }
 
+func TestPutIfRevUsesUpdateWriteMode(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(tmpFile, []byte("a: 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var got *files.UploadArg
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			got = arg
+			return putFileMetadata(arg.Path, 4), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := testPutJSONCmd(&stdout, nil)
+	_ = cmd.Flags().Set("if-rev", "015f3a2b1c0d0000000")
+	if err := put(cmd, []string{tmpFile, "/config.yaml"}); err != nil {
+		t.Fatalf("put error: %v", err)
+	}
+	if got == nil || got.Mode.Tag != files.WriteModeUpdate || got.Mode.Update != "015f3a2b1c0d0000000" || got.Autorename {
+		t.Fatalf("upload arg = %#v", got)
+	}
+	if input := decodePutOutput(t, &stdout).Input; input.IfRev != "015f3a2b1c0d0000000" {
+		t.Fatalf("input = %+v, want if_rev", input)
+	}
+}
+
+func TestPutIfRevStaleReturnsRevConflict(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(tmpFile, []byte("a: 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			return nil, uploadPathConflictError(files.WriteConflictErrorFile)
+		},
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			meta := putFileMetadata(arg.Path, 9)
+			meta.Rev = "015f3a2b1c0d0000001"
+			return meta, nil
+		},
+	})
+
+	cmd := testPutCmd()
+	_ = cmd.Flags().Set("if-rev", "015f3a2b1c0d0000000")
+	err := put(cmd, []string{tmpFile, "/config.yaml"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeRevConflict {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeRevConflict, err)
+	}
+	if details := jsonErrorDetails(err); details["revision"] != "015f3a2b1c0d0000001" || details["path"] != "/config.yaml" {
+		t.Fatalf("details = %#v, want current revision", details)
+	}
+	if code := exitCodeForError(err); code != exitCodeConflict {
+		t.Fatalf("exit code = %d, want %d", code, exitCodeConflict)
+	}
+}
+
+func TestPutIfUnchangedSince(t *testing.T) {
+	modified := time.Date(2026, 6, 25, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		since string
+		code  string
+	}{
+		{name: "unchanged", since: "2026-06-25T12:00:00Z"},
+		{name: "modified later", since: "2026-06-25T11:59:59Z", code: jsonErrorCodeRevConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpFile := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(tmpFile, []byte("a: 1"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			var got *files.UploadArg
+			stubFilesClient(t, &mockFilesClient{
+				getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+					meta := putFileMetadata(arg.Path, 9)
+					meta.Rev = "015f3a2b1c0d0000001"
+					meta.ServerModified = dropbox.DBXTime(modified)
+					return meta, nil
+				},
+				uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+					got = arg
+					return putFileMetadata(arg.Path, 4), nil
+				},
+			})
+
+			cmd := testPutCmd()
+			_ = cmd.Flags().Set("if-unchanged-since", tt.since)
+			err := put(cmd, []string{tmpFile, "/config.yaml"})
+			if tt.code != "" {
+				if code := jsonErrorCode(err); code != tt.code {
+					t.Fatalf("code = %q, want %q (err %v)", code, tt.code, err)
+				}
+				if got != nil {
+					t.Fatal("upload should not run after a rev conflict")
+				}
+				if jsonErrorDetails(err)["revision"] != "015f3a2b1c0d0000001" {
+					t.Fatalf("details = %#v, want current revision", jsonErrorDetails(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("put error: %v", err)
+			}
+			if got == nil || got.Mode.Tag != files.WriteModeUpdate || got.Mode.Update != "015f3a2b1c0d0000001" {
+				t.Fatalf("upload arg = %#v, want update to current revision", got)
+			}
+		})
+	}
+}
+
+func TestPutRevConditionValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  map[string]any
+	}{
+		{name: "short rev", flags: map[string]string{"if-rev": "abc"}, want: map[string]any{"flag": "if-rev", "value": "abc"}},
+		{name: "bad time", flags: map[string]string{"if-unchanged-since": "yesterday"}, want: map[string]any{"flag": "if-unchanged-since"}},
+		{name: "both", flags: map[string]string{"if-rev": "015f3a2b1c0d0000000", "if-unchanged-since": "2026-06-25T12:00:00Z"}},
+		{name: "with if-exists", flags: map[string]string{"if-rev": "015f3a2b1c0d0000000", "if-exists": putIfExistsFail}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testPutCmd()
+			for name, value := range tt.flags {
+				_ = cmd.Flags().Set(name, value)
+			}
+			_, err := parsePutOptions(cmd)
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want invalid arguments (err %v)", code, err)
+			}
+			details := jsonErrorDetails(err)
+			for key, value := range tt.want {
+				if details[key] != value {
+					t.Fatalf("details = %#v, want %s=%v", details, key, value)
+				}
+			}
+		})
+	}
+
+	cmd := testPutCmd()
+	_ = cmd.Flags().Set("recursive", "true")
+	_ = cmd.Flags().Set("if-rev", "015f3a2b1c0d0000000")
+	if err := put(cmd, []string{t.TempDir(), "/dest"}); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("recursive err = %v, want invalid arguments", err)
+	}
+}
+
 func TestPutJSONSingleFileOutputsUploadedResult(t *testing.T) {
 	tmpFile := filepath.Join(t.TempDir(), "test.txt")
 	if err := os.WriteFile(tmpFile, []byte("test"), 0644); err != nil {
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2026, 6, 17, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"2026-06-01T00:00:00Z": time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+		"36h":                  now.Add(-36 * time.Hour),
+		"7d":                   time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC),
+	}
+	for value, want := range tests {
+		got, err := parseRelativeTime(value, now)
+		if err != nil {
+			t.Fatalf("parseRelativeTime(%q) error: %v", value, err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("parseRelativeTime(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
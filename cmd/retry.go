@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
@@ -140,3 +141,45 @@ func retryWithBackoffContext(ctx context.Context, fn func() error) error {
 
 	return lastErr
 }
+
+// forEachWorker calls fn for every index in [0, n) using at most
+// workers goroutines. The first failure stops new calls from starting and is
+// returned once in-flight calls finish.
+func forEachWorker(n, workers int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	workCh := make(chan int, n)
+	for i := range n {
+		workCh <- i
+	}
+	close(workCh)
+
+	for range min(workers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range workCh {
+				mu.Lock()
+				failed := firstErr != nil
+				mu.Unlock()
+				if failed {
+					return
+				}
+				if err := fn(i); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -112,6 +112,11 @@ func finishCommandContext() {
 }
 
 func commandSkipsAuth(cmd *cobra.Command) bool {
+	if cmd == hashCmd {
+		// Plain hash only reads local files; --check asks Dropbox.
+		check, _ := cmd.Flags().GetBool("check")
+		return !check
+	}
 	for c := cmd; c != nil; c = c.Parent() {
 		switch c.Name() {
 		case "__complete", "__completeNoDesc", "completion", "help", "version":
@@ -179,9 +184,15 @@ func tokenType(cmd *cobra.Command) string {
 	if cmd.Parent().Name() == "team" {
 		return tokenTeamManage
 	}
+	if team, _ := cmd.Flags().GetBool("team"); team {
+		return tokenTeamManage
+	}
 	if asMember, _ := cmd.Flags().GetString("as-member"); asMember != "" {
 		return tokenTeamAccess
 	}
+	if modes := commandAnnotationList(cmd, commandAuthModesAnnotation); len(modes) == 1 && modes[0] == authTokenTypeName(tokenTeamAccess) {
+		return tokenTeamAccess
+	}
 	return tokenPersonal
 }
 
@@ -258,6 +269,10 @@ func withRootNamespace(cfg dropbox.Config, tokType string) dropbox.Config {
 	if tokType == tokenTeamManage {
 		return cfg
 	}
+	// A team access token without --as-member acts as the team, not a user.
+	if tokType == tokenTeamAccess && cfg.AsMemberID == "" {
+		return cfg
+	}
 
 	account, err := usersNewFunc(cfg).GetCurrentAccountContext(currentContext())
 	if err != nil {
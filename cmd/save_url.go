@@ -0,0 +1,335 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	saveURLStatusSaved       = "saved"
+	saveURLStatusAutorenamed = "autorenamed"
+	saveURLStatusSkipped     = "skipped"
+	saveURLStatusStarted     = "started"
+	saveURLStatusInProgress  = "in_progress"
+	saveURLKindFile          = "file"
+
+	saveURLOperation       = "save_url"
+	saveURLOperationStatus = "save_url_status"
+)
+
+// saveURLPollInterval is the delay between save_url/check_job_status calls
+// while waiting for Dropbox to finish fetching a URL.
+var saveURLPollInterval = 2 * time.Second
+
+type saveURLInput struct {
+	URL      string `json:"url"`
+	Path     string `json:"path"`
+	IfExists string `json:"if_exists"`
+	Wait     bool   `json:"wait"`
+	DryRun   bool   `json:"dry_run,omitempty"`
+}
+
+type saveURLResultInput struct {
+	URL    string `json:"url"`
+	Path   string `json:"path"`
+	DryRun bool   `json:"dry_run,omitempty"`
+}
+
+type saveURLStatusInput struct {
+	AsyncJobID string `json:"async_job_id"`
+}
+
+// saveURLJSON is the script-facing state of a save_url job. The job ID is
+// reported whenever Dropbox ran the fetch asynchronously so scripts can check
+// on it later with `save-url status`.
+type saveURLJSON struct {
+	AsyncJobID string        `json:"async_job_id,omitempty"`
+	Metadata   *jsonMetadata `json:"metadata,omitempty"`
+}
+
+func saveURL(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return invalidArgumentsErrorWithDetails("`save-url` requires `url` and `path` arguments", argumentsErrorDetails("url", "path"))
+	}
+	source, err := validateSaveURL(args[0])
+	if err != nil {
+		return err
+	}
+	dst, err := validatePath(args[1])
+	if err != nil {
+		return err
+	}
+	if dst == "" {
+		return invalidArgumentsErrorWithDetails("`save-url` requires a file `path`, not the root folder", mergeJSONErrorDetails(argumentErrorDetails("path"), pathErrorDetails("/")))
+	}
+
+	ifExists, err := parseRelocationIfExists(cmd)
+	if err != nil {
+		return err
+	}
+	wait, err := saveURLWaitEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := saveURLInput{URL: source, Path: dst, IfExists: ifExists, Wait: wait, DryRun: dryRun}
+	resultInput := saveURLResultInput{URL: source, Path: dst, DryRun: dryRun}
+	details := mergeJSONErrorDetails(operationErrorDetails(saveURLOperation), pathErrorDetails(dst))
+
+	dbx := filesNewFunc(config)
+	if ifExists != relocationIfExistsAutorename {
+		existing, exists, err := getDestinationMetadata(dbx, dst)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		if exists {
+			if _, ok := existing.(*files.FolderMetadata); ok {
+				return pathConflictErrorWithPath(dst, "destination %q is a folder", dst)
+			}
+			if ifExists == relocationIfExistsFail {
+				return pathConflictErrorWithPath(dst, "destination %q already exists", dst)
+			}
+			return renderSaveURLResult(cmd, input, saveURLStatusSkipped, resultInput, "", existing)
+		}
+	}
+
+	if dryRun {
+		planned := plannedMetadata(saveURLKindFile, dst)
+		return renderOperation(cmd, input, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, saveURLKindFile, resultInput, saveURLJSON{Metadata: &planned}),
+		}, nil, func(w io.Writer) error {
+			return writeDryRunRelocationLine(w, "save", source, dst)
+		})
+	}
+
+	res, err := dbx.SaveUrlContext(currentContext(), files.NewSaveUrlArg(dst, source))
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+
+	var jobID string
+	metadata := res.Complete
+	if res.Tag == files.SaveUrlResultAsyncJobId {
+		jobID = res.AsyncJobId
+		if !wait {
+			return renderSaveURLResult(cmd, input, saveURLStatusStarted, resultInput, jobID, nil)
+		}
+		metadata, err = waitForSaveURLJob(dbx, jobID, dst)
+		if err != nil {
+			return err
+		}
+	}
+	if metadata == nil {
+		return commandFailedErrorfWithDetails("save %s: Dropbox returned no file metadata", details, dst)
+	}
+
+	status := saveURLStatusSaved
+	if !sameDropboxMetadataPath(metadata.PathDisplay, metadata.PathLower, dst) {
+		status = saveURLStatusAutorenamed
+	}
+	return renderSaveURLResult(cmd, input, status, resultInput, jobID, metadata)
+}
+
+func saveURLStatus(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || args[0] == "" {
+		return invalidArgumentsErrorWithDetails("`save-url status` requires an `async-job-id` argument", argumentErrorDetails("async-job-id"))
+	}
+	jobID := args[0]
+
+	dbx := filesNewFunc(config)
+	job, err := dbx.SaveUrlCheckJobStatusContext(currentContext(), async.NewPollArg(jobID))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(saveURLOperationStatus), map[string]any{"async_job_id": jobID})
+	}
+
+	input := saveURLStatusInput{AsyncJobID: jobID}
+	switch job.Tag {
+	case files.SaveUrlJobStatusInProgress:
+		return renderOperation(cmd, input, []jsonOperationResult{
+			newJSONOperationResult(saveURLStatusInProgress, saveURLKindFile, nil, saveURLJSON{AsyncJobID: jobID}),
+		}, nil, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "%s: in progress\n", jobID)
+			return err
+		})
+	case files.SaveUrlJobStatusComplete:
+		if job.Complete == nil {
+			break
+		}
+		metadata, err := jsonMetadataFromDropbox(job.Complete)
+		if err != nil {
+			return err
+		}
+		return renderOperation(cmd, input, []jsonOperationResult{
+			newJSONOperationResult(saveURLStatusSaved, saveURLKindFile, nil, saveURLJSON{AsyncJobID: jobID, Metadata: &metadata}),
+		}, nil, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "%s: saved to %s\n", jobID, metadata.PathDisplay)
+			return err
+		})
+	case files.SaveUrlJobStatusFailed:
+		return saveURLJobError(jobID, "", job.Failed)
+	}
+	return commandFailedErrorfWithDetails("save-url job %s: Dropbox returned an unexpected status %q", operationErrorDetails(saveURLOperationStatus), jobID, job.Tag)
+}
+
+func validateSaveURL(value string) (string, error) {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", invalidArgumentsErrorfWithDetails("invalid url %q: use an http or https URL", mergeJSONErrorDetails(argumentErrorDetails("url"), map[string]any{"value": value}), value)
+	}
+	return value, nil
+}
+
+func saveURLWaitEnabled(cmd *cobra.Command) (bool, error) {
+	wait, _ := cmd.Flags().GetBool("wait")
+	noWait, _ := cmd.Flags().GetBool("no-wait")
+	if noWait && cmd.Flags().Changed("wait") && wait {
+		return false, invalidArgumentsErrorWithDetails("`--wait` and `--no-wait` cannot be used together", flagsErrorDetails("wait", "no-wait"))
+	}
+	return wait && !noWait, nil
+}
+
+// waitForSaveURLJob polls save_url/check_job_status until Dropbox finishes
+// fetching the URL. The poll stops early when the command context ends, so
+// --timeout bounds the total wait.
+func waitForSaveURLJob(dbx filesClient, jobID, dst string) (*files.FileMetadata, error) {
+	details := mergeJSONErrorDetails(operationErrorDetails(saveURLOperation), pathErrorDetails(dst), map[string]any{"async_job_id": jobID})
+	for {
+		if err := retrySleep(currentContext(), saveURLPollInterval); err != nil {
+			return nil, withJSONErrorDetails(fmt.Errorf("wait for save-url job %s: %w", jobID, err), details)
+		}
+
+		var job *files.SaveUrlJobStatus
+		err := retryWithBackoff(func() error {
+			var err error
+			job, err = dbx.SaveUrlCheckJobStatusContext(currentContext(), async.NewPollArg(jobID))
+			return err
+		})
+		if err != nil {
+			return nil, withJSONErrorDetails(err, details)
+		}
+
+		switch job.Tag {
+		case files.SaveUrlJobStatusInProgress:
+			continue
+		case files.SaveUrlJobStatusComplete:
+			return job.Complete, nil
+		case files.SaveUrlJobStatusFailed:
+			return nil, saveURLJobError(jobID, dst, job.Failed)
+		default:
+			return nil, commandFailedErrorfWithDetails("save-url job %s: Dropbox returned an unexpected status %q", details, jobID, job.Tag)
+		}
+	}
+}
+
+// saveURLJobError maps a failed save_url job to a coded error. Destination
+// write errors keep their reason so conflicts surface as path_conflict.
+func saveURLJobError(jobID, dst string, failure *files.SaveUrlError) error {
+	details := mergeJSONErrorDetails(operationErrorDetails(saveURLOperation), map[string]any{"async_job_id": jobID})
+	if dst != "" {
+		details = mergeJSONErrorDetails(details, pathErrorDetails(dst))
+	}
+	if failure == nil {
+		return commandFailedErrorfWithDetails("save-url job %s failed", details, jobID)
+	}
+
+	summary := failure.Tag
+	if failure.Tag == files.SaveUrlErrorPath && failure.Path != nil {
+		summary += "/" + failure.Path.Tag
+		if failure.Path.Conflict != nil {
+			summary += "/" + failure.Path.Conflict.Tag
+		}
+	}
+	code := jsonErrorCodeDropboxAPIError
+	if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+		code = mapped
+	}
+	details["api_summary"] = summary + "/"
+	return newCodedError(code, fmt.Errorf("save-url job %s failed: %s", jobID, summary), details)
+}
+
+func renderSaveURLResult(cmd *cobra.Command, input saveURLInput, status string, resultInput saveURLResultInput, jobID string, metadata files.IsMetadata) error {
+	result := saveURLJSON{AsyncJobID: jobID}
+	if metadata != nil {
+		jsonResult, err := jsonMetadataFromDropbox(metadata)
+		if err != nil {
+			return err
+		}
+		result.Metadata = &jsonResult
+	}
+
+	switch status {
+	case saveURLStatusSkipped:
+		commandVerboseStatus(cmd, "Skipped %s: destination already exists", input.Path)
+	case saveURLStatusSaved, saveURLStatusAutorenamed:
+		commandVerboseStatus(cmd, "Saved %s -> %s", input.URL, result.Metadata.PathDisplay)
+	}
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(status, saveURLKindFile, resultInput, result),
+	}, nil, func(w io.Writer) error {
+		if status != saveURLStatusStarted {
+			return nil
+		}
+		_, err := fmt.Fprintln(w, jobID)
+		return err
+	})
+}
+
+// saveURLCmd represents the save-url command
+var saveURLCmd = &cobra.Command{
+	Use:   "save-url [flags] <url> <path>",
+	Short: "Save a URL into Dropbox without downloading it locally",
+	Long: `Have Dropbox fetch a URL on its servers and save it as a file.
+  - By default the command waits for the fetch to finish. Use --no-wait to
+    print the async job ID and return immediately; check on the job later
+    with "save-url status".
+  - --if-exists controls existing destinations: fail (default), skip, or
+    autorename. Dropbox cannot overwrite a file from a URL.
+`,
+	Example: `  dbxcli save-url https://example.com/data.csv /Datasets/data.csv
+  dbxcli save-url --no-wait https://example.com/big.tar.gz /Datasets/big.tar.gz
+  dbxcli save-url status PID_RUvpd3jhU7LYNXJkJ9bGnQ`,
+	RunE: saveURL,
+}
+
+var saveURLStatusCmd = &cobra.Command{
+	Use:     "status [flags] <async-job-id>",
+	Short:   "Check the status of a save-url job",
+	Long:    `Check whether a save-url job started with --no-wait has finished.`,
+	Example: `  dbxcli save-url status PID_RUvpd3jhU7LYNXJkJ9bGnQ`,
+	RunE:    saveURLStatus,
+}
+
+func init() {
+	RootCmd.AddCommand(saveURLCmd)
+	saveURLCmd.AddCommand(saveURLStatusCmd)
+	saveURLCmd.Flags().String("if-exists", relocationIfExistsFail, "What to do when the destination exists: fail, skip, or autorename")
+	saveURLCmd.Flags().Bool("wait", true, "Wait for Dropbox to finish saving the URL")
+	saveURLCmd.Flags().Bool("no-wait", false, "Return the async job ID without waiting")
+	addDryRunFlag(saveURLCmd)
+	enableStructuredOutput(saveURLCmd)
+	enableStructuredOutput(saveURLStatusCmd)
+}
@@ -0,0 +1,325 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const testSaveURL = "https://example.com/data.csv"
+
+type saveURLOutput struct {
+	Input   saveURLInput `json:"input"`
+	Results []struct {
+		Status string             `json:"status"`
+		Kind   string             `json:"kind"`
+		Input  saveURLResultInput `json:"input"`
+		Result saveURLJSON        `json:"result"`
+	} `json:"results"`
+}
+
+func TestSaveURLJSONWaitsForJob(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(map[string]string{outputFlag: "json"})
+	sleeps := stubSaveURLPolling(t)
+	polls := 0
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return nil, saveURLNotFoundError()
+		},
+		saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+			if arg.Url != testSaveURL || arg.Path != "/Datasets/data.csv" {
+				t.Fatalf("save_url arg = %#v", arg)
+			}
+			return &files.SaveUrlResult{Tagged: dropbox.Tagged{Tag: files.SaveUrlResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		saveURLCheckJobStatusFn: func(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+			polls++
+			if arg.AsyncJobId != "job-1" {
+				t.Fatalf("poll job = %q", arg.AsyncJobId)
+			}
+			if polls == 1 {
+				return &files.SaveUrlJobStatus{Tagged: dropbox.Tagged{Tag: files.SaveUrlJobStatusInProgress}}, nil
+			}
+			return &files.SaveUrlJobStatus{Tagged: dropbox.Tagged{Tag: files.SaveUrlJobStatusComplete}, Complete: getTestFileMetadata("/Datasets/data.csv", 12)}, nil
+		},
+	})
+
+	if err := saveURL(cmd, []string{testSaveURL, "Datasets/data.csv"}); err != nil {
+		t.Fatalf("save-url error: %v", err)
+	}
+	if polls != 2 || *sleeps != 2 {
+		t.Fatalf("polls = %d, sleeps = %d; want 2 each", polls, *sleeps)
+	}
+
+	got := decodeSaveURLOutput(t, stdout)
+	if got.Input.IfExists != relocationIfExistsFail || !got.Input.Wait {
+		t.Fatalf("input = %#v", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != saveURLStatusSaved || got.Results[0].Result.AsyncJobID != "job-1" {
+		t.Fatalf("results = %#v, want one saved result", got.Results)
+	}
+	if metadata := got.Results[0].Result.Metadata; metadata == nil || metadata.PathDisplay != "/Datasets/data.csv" {
+		t.Fatalf("metadata = %#v", metadata)
+	}
+}
+
+func TestSaveURLNoWaitPrintsJobID(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(map[string]string{"no-wait": "true", "if-exists": "autorename"})
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			t.Fatal("destination checked with --if-exists=autorename")
+			return nil, nil
+		},
+		saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+			return &files.SaveUrlResult{Tagged: dropbox.Tagged{Tag: files.SaveUrlResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		saveURLCheckJobStatusFn: func(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+			t.Fatal("job polled with --no-wait")
+			return nil, nil
+		},
+	})
+
+	if err := saveURL(cmd, []string{testSaveURL, "/Datasets/data.csv"}); err != nil {
+		t.Fatalf("save-url error: %v", err)
+	}
+	if got, want := stdout.String(), "job-1\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestSaveURLIfExists(t *testing.T) {
+	tests := []struct {
+		ifExists string
+		code     string
+		status   string
+	}{
+		{ifExists: "fail", code: jsonErrorCodePathConflict},
+		{ifExists: "skip", status: saveURLStatusSkipped},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ifExists, func(t *testing.T) {
+			cmd, stdout := testSaveURLCmd(map[string]string{outputFlag: "json", "if-exists": tt.ifExists})
+			stubFilesClient(t, &mockFilesClient{
+				getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+					return getTestFileMetadata(arg.Path, 4), nil
+				},
+				saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+					t.Fatal("save_url called for existing destination")
+					return nil, nil
+				},
+			})
+
+			err := saveURL(cmd, []string{testSaveURL, "/Datasets/data.csv"})
+			if tt.code != "" {
+				if code := jsonErrorCode(err); code != tt.code {
+					t.Fatalf("code = %q, want %q (err %v)", code, tt.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("save-url error: %v", err)
+			}
+			got := decodeSaveURLOutput(t, stdout)
+			if len(got.Results) != 1 || got.Results[0].Status != tt.status || got.Results[0].Result.Metadata == nil {
+				t.Fatalf("results = %#v, want %s with metadata", got.Results, tt.status)
+			}
+		})
+	}
+}
+
+func TestSaveURLCompleteImmediatelyReportsAutorename(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(map[string]string{outputFlag: "json", "if-exists": "autorename"})
+	stubFilesClient(t, &mockFilesClient{
+		saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+			return &files.SaveUrlResult{Tagged: dropbox.Tagged{Tag: files.SaveUrlResultComplete}, Complete: getTestFileMetadata("/Datasets/data (1).csv", 12)}, nil
+		},
+	})
+
+	if err := saveURL(cmd, []string{testSaveURL, "/Datasets/data.csv"}); err != nil {
+		t.Fatalf("save-url error: %v", err)
+	}
+	got := decodeSaveURLOutput(t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Status != saveURLStatusAutorenamed || got.Results[0].Result.AsyncJobID != "" {
+		t.Fatalf("results = %#v, want one autorenamed result", got.Results)
+	}
+}
+
+func TestSaveURLFailedJobMapsWriteConflict(t *testing.T) {
+	cmd, _ := testSaveURLCmd(map[string]string{"if-exists": "autorename"})
+	stubSaveURLPolling(t)
+	stubFilesClient(t, &mockFilesClient{
+		saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+			return &files.SaveUrlResult{Tagged: dropbox.Tagged{Tag: files.SaveUrlResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		saveURLCheckJobStatusFn: func(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+			return &files.SaveUrlJobStatus{
+				Tagged: dropbox.Tagged{Tag: files.SaveUrlJobStatusFailed},
+				Failed: &files.SaveUrlError{
+					Tagged: dropbox.Tagged{Tag: files.SaveUrlErrorPath},
+					Path: &files.WriteError{
+						Tagged:   dropbox.Tagged{Tag: files.WriteErrorConflict},
+						Conflict: &files.WriteConflictError{Tagged: dropbox.Tagged{Tag: files.WriteConflictErrorFolder}},
+					},
+				},
+			}, nil
+		},
+	})
+
+	err := saveURL(cmd, []string{testSaveURL, "/Datasets/data.csv"})
+	if code := jsonErrorCode(err); code != jsonErrorCodePathConflict {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodePathConflict, err)
+	}
+	details := jsonErrorDetails(err)
+	if details["async_job_id"] != "job-1" || details["api_summary"] != "path/conflict/folder" || details["path"] != "/Datasets/data.csv" {
+		t.Fatalf("details = %#v", details)
+	}
+}
+
+func TestSaveURLDryRunSkipsSave(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(map[string]string{dryRunFlagName: "true"})
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return nil, saveURLNotFoundError()
+		},
+		saveURLFn: func(arg *files.SaveUrlArg) (*files.SaveUrlResult, error) {
+			t.Fatal("save_url called during dry-run")
+			return nil, nil
+		},
+	})
+
+	if err := saveURL(cmd, []string{testSaveURL, "/Datasets/data.csv"}); err != nil {
+		t.Fatalf("save-url error: %v", err)
+	}
+	if got, want := stdout.String(), "Would save "+testSaveURL+" to /Datasets/data.csv\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestSaveURLValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+		want  map[string]any
+	}{
+		{name: "missing path", args: []string{testSaveURL}, want: map[string]any{"arguments": nil}},
+		{name: "bad scheme", args: []string{"ftp://example.com/x", "/x"}, want: map[string]any{"argument": "url", "value": "ftp://example.com/x"}},
+		{name: "root path", args: []string{testSaveURL, "/"}, want: map[string]any{"argument": "path"}},
+		{name: "overwrite", args: []string{testSaveURL, "/x"}, flags: map[string]string{"if-exists": "overwrite"}, want: map[string]any{"flag": "if-exists", "value": "overwrite"}},
+		{name: "wait and no-wait", args: []string{testSaveURL, "/x"}, flags: map[string]string{"wait": "true", "no-wait": "true"}, want: map[string]any{"flags": nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testSaveURLCmd(tt.flags)
+			stubFilesClient(t, &mockFilesClient{})
+
+			err := saveURL(cmd, tt.args)
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeInvalidArguments, err)
+			}
+			details := jsonErrorDetails(err)
+			for key, value := range tt.want {
+				if _, ok := details[key]; !ok || (value != nil && details[key] != value) {
+					t.Fatalf("details = %#v, want %s=%v", details, key, value)
+				}
+			}
+		})
+	}
+}
+
+func TestSaveURLStatus(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(nil)
+	stubFilesClient(t, &mockFilesClient{
+		saveURLCheckJobStatusFn: func(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+			return &files.SaveUrlJobStatus{Tagged: dropbox.Tagged{Tag: files.SaveUrlJobStatusInProgress}}, nil
+		},
+	})
+	if err := saveURLStatus(cmd, []string{"job-1"}); err != nil {
+		t.Fatalf("save-url status error: %v", err)
+	}
+	if got, want := stdout.String(), "job-1: in progress\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestSaveURLStatusJSONOutputsSavedFile(t *testing.T) {
+	cmd, stdout := testSaveURLCmd(map[string]string{outputFlag: "json"})
+	stubFilesClient(t, &mockFilesClient{
+		saveURLCheckJobStatusFn: func(arg *async.PollArg) (*files.SaveUrlJobStatus, error) {
+			return &files.SaveUrlJobStatus{Tagged: dropbox.Tagged{Tag: files.SaveUrlJobStatusComplete}, Complete: getTestFileMetadata("/Datasets/data.csv", 12)}, nil
+		},
+	})
+	if err := saveURLStatus(cmd, []string{"job-1"}); err != nil {
+		t.Fatalf("save-url status error: %v", err)
+	}
+
+	var got struct {
+		Input   saveURLStatusInput `json:"input"`
+		Results []struct {
+			Status string      `json:"status"`
+			Result saveURLJSON `json:"result"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if got.Input.AsyncJobID != "job-1" || len(got.Results) != 1 || got.Results[0].Status != saveURLStatusSaved || got.Results[0].Result.Metadata == nil {
+		t.Fatalf("output = %#v", got)
+	}
+}
+
+func testSaveURLCmd(flags map[string]string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "save-url"}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().String("if-exists", relocationIfExistsFail, "")
+	cmd.Flags().Bool("wait", true, "")
+	cmd.Flags().Bool("no-wait", false, "")
+	addDryRunFlag(cmd)
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			panic(err)
+		}
+	}
+	return cmd, &stdout
+}
+
+// stubSaveURLPolling replaces the poll delay with a counter so tests never
+// sleep.
+func stubSaveURLPolling(t *testing.T) *int {
+	t.Helper()
+	sleeps := 0
+	orig := retrySleep
+	retrySleep = func(ctx context.Context, delay time.Duration) error {
+		sleeps++
+		return nil
+	}
+	t.Cleanup(func() { retrySleep = orig })
+	return &sleeps
+}
+
+func saveURLNotFoundError() error {
+	return files.GetMetadataAPIError{EndpointError: &files.GetMetadataError{
+		Tagged: dropbox.Tagged{Tag: files.GetMetadataErrorPath},
+		Path:   &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFound}},
+	}}
+}
+
+func decodeSaveURLOutput(t *testing.T, stdout *bytes.Buffer) saveURLOutput {
+	t.Helper()
+	var got saveURLOutput
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	return got
+}
@@ -17,6 +17,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
@@ -32,6 +33,7 @@ type searchInput struct {
 	Content    bool   `json:"content"`
 	Limit      uint64 `json:"limit,omitempty"`
 	OrderBy    string `json:"order_by,omitempty"`
+	Tag        string `json:"tag,omitempty"`
 	Long       bool   `json:"long"`
 	Sort       string `json:"sort,omitempty"`
 	Reverse    bool   `json:"reverse"`
@@ -46,6 +48,7 @@ type searchCommandOptions struct {
 	content bool
 	limit   uint64
 	orderBy string
+	tag     string
 }
 
 func search(cmd *cobra.Command, args []string) (err error) {
@@ -77,7 +80,10 @@ func search(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	var entries []files.IsMetadata
-	entries = appendSearchMatches(entries, res.Matches, opts.limit)
+	entries, err = appendTaggedSearchMatches(dbx, entries, res.Matches, opts)
+	if err != nil {
+		return err
+	}
 
 	for res.HasMore && !searchLimitReached(entries, opts.limit) {
 		contArg := files.NewSearchV2ContinueArg(res.Cursor)
@@ -85,7 +91,10 @@ func search(cmd *cobra.Command, args []string) (err error) {
 		if err != nil {
 			return err
 		}
-		entries = appendSearchMatches(entries, res.Matches, opts.limit)
+		entries, err = appendTaggedSearchMatches(dbx, entries, res.Matches, opts)
+		if err != nil {
+			return err
+		}
 	}
 
 	sortEntries(entries, opts.list)
@@ -97,6 +106,7 @@ func parseSearchOptions(cmd *cobra.Command) (searchCommandOptions, error) {
 	content, _ := cmd.Flags().GetBool("content")
 	limit, _ := cmd.Flags().GetUint64("limit")
 	orderBy, _ := cmd.Flags().GetString("order-by")
+	tag, _ := cmd.Flags().GetString("tag")
 	listOpts, err := parseListOptions(cmd)
 	if err != nil {
 		return searchCommandOptions{}, err
@@ -104,12 +114,20 @@ func parseSearchOptions(cmd *cobra.Command) (searchCommandOptions, error) {
 	if !validSearchOrderBy(orderBy) {
 		return searchCommandOptions{}, invalidArgumentsErrorWithDetails("`search --order-by` must be one of: relevance, modified", flagErrorDetails("order-by"))
 	}
+	if tag != "" {
+		normalized, ok := normalizeTag(tag)
+		if !ok {
+			return searchCommandOptions{}, invalidTagError(tag, flagErrorDetails("tag"))
+		}
+		tag = normalized
+	}
 
 	return searchCommandOptions{
 		list:    listOpts,
 		content: content,
 		limit:   limit,
 		orderBy: orderBy,
+		tag:     tag,
 	}, nil
 }
 
@@ -158,6 +176,52 @@ func appendSearchMatches(entries []files.IsMetadata, matches []*files.SearchMatc
 	return entries
 }
 
+// appendTaggedSearchMatches keeps only matches carrying opts.tag when --tag is
+// set. Dropbox search has no tag filter, so each page is checked with tags/get
+// before the limit is applied.
+func appendTaggedSearchMatches(dbx filesClient, entries []files.IsMetadata, matches []*files.SearchMatchV2, opts searchCommandOptions) ([]files.IsMetadata, error) {
+	if opts.tag == "" {
+		return appendSearchMatches(entries, matches, opts.limit), nil
+	}
+
+	var paths []string
+	for _, m := range matches {
+		if path, ok := searchMatchPath(m); ok {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return entries, nil
+	}
+	tags, err := getPathTags(dbx, paths)
+	if err != nil {
+		return nil, withJSONErrorDetails(err, operationErrorDetails("search"), flagValueErrorDetails("tag", opts.tag))
+	}
+
+	tagged := make([]*files.SearchMatchV2, 0, len(matches))
+	for _, m := range matches {
+		path, ok := searchMatchPath(m)
+		if ok && slices.Contains(tags[strings.ToLower(path)], opts.tag) {
+			tagged = append(tagged, m)
+		}
+	}
+	return appendSearchMatches(entries, tagged, opts.limit), nil
+}
+
+func searchMatchPath(m *files.SearchMatchV2) (string, bool) {
+	if m == nil || m.Metadata == nil || m.Metadata.Metadata == nil {
+		return "", false
+	}
+	switch e := m.Metadata.Metadata.(type) {
+	case *files.FileMetadata:
+		return e.PathLower, true
+	case *files.FolderMetadata:
+		return e.PathLower, true
+	default:
+		return "", false
+	}
+}
+
 func searchLimitReached(entries []files.IsMetadata, limit uint64) bool {
 	return limit > 0 && uint64(len(entries)) >= limit
 }
@@ -186,6 +250,7 @@ func newSearchInput(query, scope string, opts searchCommandOptions) searchInput
 		Content:    opts.content,
 		Limit:      opts.limit,
 		OrderBy:    opts.orderBy,
+		Tag:        opts.tag,
 		Long:       opts.list.long,
 		Sort:       opts.list.sortBy,
 		Reverse:    opts.list.reverse,
@@ -228,6 +293,7 @@ func init() {
 	searchCmd.Flags().BoolP("content", "c", false, "Search file contents in addition to filenames")
 	searchCmd.Flags().Uint64("limit", 0, "Maximum number of matches to return")
 	searchCmd.Flags().String("order-by", "", "Server-side search ordering: relevance, modified")
+	searchCmd.Flags().String("tag", "", "Only return matches that carry this user tag")
 	searchCmd.Flags().BoolP("long", "l", false, "Long listing")
 	searchCmd.Flags().String("sort", "", "Sort by: name, size, time, type")
 	searchCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
@@ -360,6 +360,75 @@ func TestSearchLimitCapsResultsAndStopsPagination(t *testing.T) {
 	}
 }
 
+func TestSearchTagFiltersMatchesAcrossPages(t *testing.T) {
+	cmd, stdout := testSearchCmd()
+	setSearchFlag(t, cmd, "tag", "#Approved")
+	setSearchFlag(t, cmd, "limit", "2")
+	setSearchOutputJSON(t, cmd)
+
+	var tagCalls [][]string
+	mock := &mockFilesClient{
+		searchV2Fn: func(arg *files.SearchV2Arg) (*files.SearchV2Result, error) {
+			res := files.NewSearchV2Result([]*files.SearchMatchV2{
+				searchMatch(searchTagFile("/docs/a.txt")),
+				searchMatch(searchTagFile("/docs/b.txt")),
+			}, true)
+			res.Cursor = "cursor-1"
+			return res, nil
+		},
+		searchContinueV2Fn: func(arg *files.SearchV2ContinueArg) (*files.SearchV2Result, error) {
+			return files.NewSearchV2Result([]*files.SearchMatchV2{
+				searchMatch(searchTagFile("/docs/c.txt")),
+				searchMatch(searchTagFile("/docs/d.txt")),
+			}, true), nil
+		},
+		tagsGetFn: func(arg *files.GetTagsArg) (*files.GetTagsResult, error) {
+			tagCalls = append(tagCalls, arg.Paths)
+			res := &files.GetTagsResult{}
+			for _, path := range arg.Paths {
+				var tags []*files.Tag
+				if path == "/docs/b.txt" || path == "/docs/c.txt" || path == "/docs/d.txt" {
+					tags = append(tags, userTag("approved"))
+				}
+				res.PathsToTags = append(res.PathsToTags, files.NewPathToTags(path, tags))
+			}
+			return res, nil
+		},
+	}
+	stubFilesClient(t, mock)
+
+	if err := search(cmd, []string{"needle"}); err != nil {
+		t.Fatalf("search error: %v", err)
+	}
+	if len(tagCalls) != 2 {
+		t.Fatalf("tags/get calls = %v, want one per search page", tagCalls)
+	}
+	got := decodeSearchOutput(t, stdout)
+	if got.Input.Tag != "approved" {
+		t.Fatalf("input tag = %q, want normalized approved", got.Input.Tag)
+	}
+	var paths []string
+	for _, result := range got.Results {
+		paths = append(paths, result.Result.PathDisplay)
+	}
+	if strings.Join(paths, ",") != "/docs/b.txt,/docs/c.txt" {
+		t.Fatalf("paths = %v, want tagged matches capped by --limit", paths)
+	}
+}
+
+func TestSearchRejectsInvalidTag(t *testing.T) {
+	cmd, _ := testSearchCmd()
+	setSearchFlag(t, cmd, "tag", "not a tag")
+
+	err := search(cmd, []string{"needle"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeInvalidArguments, err)
+	}
+	if details := jsonErrorDetails(err); details["flag"] != "tag" || details["value"] != "not a tag" {
+		t.Fatalf("details = %#v, want tag flag and value", details)
+	}
+}
+
 func TestSearchJSONErrorWritesNoOutput(t *testing.T) {
 	cmd, stdout := testSearchCmd()
 	setSearchOutputJSON(t, cmd)
@@ -392,6 +461,7 @@ func testSearchCmd() (*cobra.Command, *bytes.Buffer) {
 	cmd.Flags().BoolP("content", "c", false, "")
 	cmd.Flags().Uint64("limit", 0, "")
 	cmd.Flags().String("order-by", "", "")
+	cmd.Flags().String("tag", "", "")
 	cmd.Flags().BoolP("long", "l", false, "")
 	cmd.Flags().String("sort", "", "")
 	cmd.Flags().BoolP("reverse", "r", false, "")
@@ -425,3 +495,7 @@ func searchMatch(metadata files.IsMetadata) *files.SearchMatchV2 {
 		Metadata: metadata,
 	})
 }
+
+func searchTagFile(path string) *files.FileMetadata {
+	return &files.FileMetadata{Metadata: files.Metadata{PathDisplay: path, PathLower: strings.ToLower(path)}}
+}
@@ -18,9 +18,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"text/tabwriter"
 	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/spf13/cobra"
 )
@@ -28,24 +30,58 @@ import (
 type sharedFolderClient interface {
 	ListFoldersContext(context.Context, *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error)
 	ListFoldersContinueContext(context.Context, *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error)
+	ListFolderMembersContext(context.Context, *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error)
+	ListFolderMembersContinueContext(context.Context, *sharing.ListFolderMembersContinueArg) (*sharing.SharedFolderMembers, error)
+	AddFolderMemberContext(context.Context, *sharing.AddFolderMemberArg) error
+	RemoveFolderMemberContext(context.Context, *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error)
+	CheckRemoveMemberJobStatusContext(context.Context, *async.PollArg) (*sharing.RemoveMemberJobStatus, error)
+	UpdateFolderMemberContext(context.Context, *sharing.UpdateFolderMemberArg) (*sharing.MemberAccessLevelResult, error)
+	GetFolderMetadataContext(context.Context, *sharing.GetMetadataArgs) (*sharing.SharedFolderMetadata, error)
+	ShareFolderContext(context.Context, *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error)
+	CheckShareJobStatusContext(context.Context, *async.PollArg) (*sharing.ShareFolderJobStatus, error)
+	UnshareFolderContext(context.Context, *sharing.UnshareFolderArg) (*async.LaunchEmptyResult, error)
+	CheckJobStatusContext(context.Context, *async.PollArg) (*sharing.JobStatus, error)
+	UpdateFolderPolicyContext(context.Context, *sharing.UpdateFolderPolicyArg) (*sharing.SharedFolderMetadata, error)
+	ListMountableFoldersContext(context.Context, *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error)
+	ListMountableFoldersContinueContext(context.Context, *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error)
+	MountFolderContext(context.Context, *sharing.MountFolderArg) (*sharing.SharedFolderMetadata, error)
+	UnmountFolderContext(context.Context, *sharing.UnmountFolderArg) error
+	RelinquishFolderMembershipContext(context.Context, *sharing.RelinquishFolderMembershipArg) (*async.LaunchEmptyResult, error)
+	AddFileMemberContext(context.Context, *sharing.AddFileMemberArgs) ([]*sharing.FileMemberActionResult, error)
+	ListFileMembersBatchContext(context.Context, *sharing.ListFileMembersBatchArg) ([]*sharing.ListFileMembersBatchResult, error)
+	ListFileMembersContinueContext(context.Context, *sharing.ListFileMembersContinueArg) (*sharing.SharedFileMembers, error)
+	RemoveFileMember2Context(context.Context, *sharing.RemoveFileMemberArg) (*sharing.FileMemberRemoveActionResult, error)
+	UnshareFileContext(context.Context, *sharing.UnshareFileArg) error
 }
 
 type shareFolderListInput struct{}
 
 type shareFolderJSONMetadata struct {
-	Type                 string   `json:"type"`
-	Name                 string   `json:"name"`
-	PathLower            string   `json:"path_lower,omitempty"`
-	SharedFolderID       string   `json:"shared_folder_id"`
-	PreviewURL           string   `json:"preview_url,omitempty"`
-	AccessType           string   `json:"access_type,omitempty"`
-	IsInsideTeamFolder   bool     `json:"is_inside_team_folder"`
-	IsTeamFolder         bool     `json:"is_team_folder"`
-	OwnerDisplayNames    []string `json:"owner_display_names,omitempty"`
-	ParentSharedFolderID string   `json:"parent_shared_folder_id,omitempty"`
-	ParentFolderName     string   `json:"parent_folder_name,omitempty"`
-	TimeInvited          *string  `json:"time_invited,omitempty"`
-	AccessInheritance    string   `json:"access_inheritance,omitempty"`
+	Type                 string                 `json:"type"`
+	Name                 string                 `json:"name"`
+	PathLower            string                 `json:"path_lower,omitempty"`
+	SharedFolderID       string                 `json:"shared_folder_id"`
+	PreviewURL           string                 `json:"preview_url,omitempty"`
+	AccessType           string                 `json:"access_type,omitempty"`
+	IsInsideTeamFolder   bool                   `json:"is_inside_team_folder"`
+	IsTeamFolder         bool                   `json:"is_team_folder"`
+	OwnerDisplayNames    []string               `json:"owner_display_names,omitempty"`
+	ParentSharedFolderID string                 `json:"parent_shared_folder_id,omitempty"`
+	ParentFolderName     string                 `json:"parent_folder_name,omitempty"`
+	TimeInvited          *string                `json:"time_invited,omitempty"`
+	AccessInheritance    string                 `json:"access_inheritance,omitempty"`
+	Policy               *shareFolderPolicyJSON `json:"policy,omitempty"`
+}
+
+// shareFolderPolicyJSON is the set of policies governing a shared folder.
+// ResolvedMemberPolicy is the member policy in effect after team-wide
+// restrictions; both member policies are present only for team folders.
+type shareFolderPolicyJSON struct {
+	MemberPolicy         string `json:"member_policy,omitempty"`
+	ResolvedMemberPolicy string `json:"resolved_member_policy,omitempty"`
+	AclUpdatePolicy      string `json:"acl_update_policy,omitempty"`
+	SharedLinkPolicy     string `json:"shared_link_policy,omitempty"`
+	ViewerInfoPolicy     string `json:"viewer_info_policy,omitempty"`
 }
 
 const (
@@ -59,6 +95,7 @@ var newSharedFolderClient = func(cfg dropbox.Config) sharedFolderClient {
 
 func shareListFolders(cmd *cobra.Command, args []string) (err error) {
 	arg := sharing.NewListFoldersArgs()
+	long, _ := cmd.Flags().GetBool("long")
 
 	dbx := newSharedFolderClient(config)
 	entries, err := listSharedFolders(dbx, arg)
@@ -68,11 +105,20 @@ func shareListFolders(cmd *cobra.Command, args []string) (err error) {
 
 	commandVerboseStatus(cmd, "Listed %d shared folders", len(entries))
 
+	return renderSharedFolderList(cmd, shareFolderListInput{}, entries, long, renderSharedFolders)
+}
+
+// renderSharedFolderList writes shared folders as plain text, as the --long
+// table, or as a structured listing.
+func renderSharedFolderList(cmd *cobra.Command, input any, entries []*sharing.SharedFolderMetadata, long bool, render func(io.Writer, []*sharing.SharedFolderMetadata) error) error {
 	return commandOutput(cmd).Render(func(w io.Writer) error {
-		return renderSharedFolders(w, entries)
+		if long {
+			return renderSharedFoldersLong(w, entries)
+		}
+		return render(w, entries)
 	}, newJSONCommandOperationOutput(
 		cmd,
-		shareFolderListInput{},
+		input,
 		shareFolderJSONOperationResults(shareFolderJSONMetadataListFromDropbox(entries)),
 		nil,
 	))
@@ -100,6 +146,27 @@ func listSharedFolders(dbx sharedFolderClient, arg *sharing.ListFoldersArgs) ([]
 	return entries, nil
 }
 
+// listMountableSharedFolders lists the shared folders the user has been
+// invited to but has not mounted.
+func listMountableSharedFolders(dbx sharedFolderClient, arg *sharing.ListFoldersArgs) ([]*sharing.SharedFolderMetadata, error) {
+	var entries []*sharing.SharedFolderMetadata
+	res, err := dbx.ListMountableFoldersContext(currentContext(), arg)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, res.Entries...)
+
+	for len(res.Cursor) > 0 {
+		res, err = dbx.ListMountableFoldersContinueContext(currentContext(), sharing.NewListFoldersContinueArg(res.Cursor))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.Entries...)
+	}
+
+	return entries, nil
+}
+
 func renderSharedFolders(out io.Writer, entries []*sharing.SharedFolderMetadata) error {
 	for _, f := range entries {
 		if _, err := fmt.Fprintf(out, "%v\t%v\n", f.PathLower, f.PreviewUrl); err != nil {
@@ -110,6 +177,36 @@ func renderSharedFolders(out io.Writer, entries []*sharing.SharedFolderMetadata)
 	return nil
 }
 
+// renderSharedFoldersLong prints each folder's access level and policies.
+// Unset policies print as "-".
+func renderSharedFoldersLong(out io.Writer, entries []*sharing.SharedFolderMetadata) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Path\tID\tAccess\tMember policy\tACL update policy\tShared link policy\tViewer info policy\tPreview URL")
+	for _, f := range entries {
+		metadata := shareFolderJSONMetadataFromDropbox(f)
+		var policy shareFolderPolicyJSON
+		if metadata.Policy != nil {
+			policy = *metadata.Policy
+		}
+		memberPolicy := policy.ResolvedMemberPolicy
+		if memberPolicy == "" {
+			memberPolicy = policy.MemberPolicy
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			orDash(metadata.PathLower), orDash(metadata.SharedFolderID), orDash(metadata.AccessType), orDash(memberPolicy), orDash(policy.AclUpdatePolicy),
+			orDash(policy.SharedLinkPolicy), orDash(policy.ViewerInfoPolicy), orDash(metadata.PreviewURL))
+	}
+	return w.Flush()
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 func shareFolderJSONMetadataListFromDropbox(entries []*sharing.SharedFolderMetadata) []shareFolderJSONMetadata {
 	result := make([]shareFolderJSONMetadata, 0, len(entries))
 	for _, entry := range entries {
@@ -142,9 +239,33 @@ func shareFolderJSONMetadataFromDropbox(entry *sharing.SharedFolderMetadata) sha
 	if entry.AccessInheritance != nil {
 		result.AccessInheritance = entry.AccessInheritance.Tag
 	}
+	result.Policy = shareFolderPolicyJSONFromDropbox(entry.Policy)
 	return result
 }
 
+func shareFolderPolicyJSONFromDropbox(policy *sharing.FolderPolicy) *shareFolderPolicyJSON {
+	if policy == nil {
+		return nil
+	}
+	var result shareFolderPolicyJSON
+	if policy.MemberPolicy != nil {
+		result.MemberPolicy = policy.MemberPolicy.Tag
+	}
+	if policy.ResolvedMemberPolicy != nil {
+		result.ResolvedMemberPolicy = policy.ResolvedMemberPolicy.Tag
+	}
+	if policy.AclUpdatePolicy != nil {
+		result.AclUpdatePolicy = policy.AclUpdatePolicy.Tag
+	}
+	if policy.SharedLinkPolicy != nil {
+		result.SharedLinkPolicy = policy.SharedLinkPolicy.Tag
+	}
+	if policy.ViewerInfoPolicy != nil {
+		result.ViewerInfoPolicy = policy.ViewerInfoPolicy.Tag
+	}
+	return &result
+}
+
 func shareFolderJSONOperationResults(entries []shareFolderJSONMetadata) []jsonOperationResult {
 	results := make([]jsonOperationResult, 0, len(entries))
 	for _, entry := range entries {
@@ -156,10 +277,13 @@ func shareFolderJSONOperationResults(entries []shareFolderJSONMetadata) []jsonOp
 var shareListFoldersCmd = &cobra.Command{
 	Use:   "folder",
 	Short: "List shared folders",
-	RunE:  shareListFolders,
+	Example: `  dbxcli share list folder
+  dbxcli share list folder --long`,
+	RunE: shareListFolders,
 }
 
 func init() {
 	shareListCmd.AddCommand(shareListFoldersCmd)
+	shareListFoldersCmd.Flags().BoolP("long", "l", false, "Show access levels and folder policies")
 	enableStructuredOutput(shareListFoldersCmd)
 }
@@ -34,6 +34,7 @@ type mockSharedLinkClient struct {
 	createSharedLinkWithSettingsFn    func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error)
 	createSharedLinkWithRawSettingsFn func(path string, settings *rawSharedLinkSettings) (sharing.IsSharedLinkMetadata, error)
 	getSharedLinkFileFn               func(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, io.ReadCloser, error)
+	getSharedLinkFileRangeFn          func(arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error)
 	getSharedLinkMetadataFn           func(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, error)
 	listSharedLinksFn                 func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error)
 	modifySharedLinkSettingsFn        func(arg *sharing.ModifySharedLinkSettingsArgs) (sharing.IsSharedLinkMetadata, error)
@@ -74,6 +75,23 @@ func (m *mockSharedLinkClient) GetSharedLinkFileContext(ctx context.Context, arg
 	return m.GetSharedLinkFile(arg)
 }
 
+// GetSharedLinkFileRangeContext falls back to getSharedLinkFileFn and skips
+// offset bytes of its content, the way the server answers a Range request.
+func (m *mockSharedLinkClient) GetSharedLinkFileRangeContext(ctx context.Context, arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+	if m.getSharedLinkFileRangeFn != nil {
+		return m.getSharedLinkFileRangeFn(arg, offset)
+	}
+	link, contents, err := m.GetSharedLinkFile(arg)
+	if err != nil || contents == nil || offset == 0 {
+		return link, contents, err
+	}
+	if _, err := io.CopyN(io.Discard, contents, offset); err != nil {
+		_ = contents.Close()
+		return nil, nil, err
+	}
+	return link, contents, nil
+}
+
 func (m *mockSharedLinkClient) GetSharedLinkMetadata(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, error) {
 	if m.getSharedLinkMetadataFn != nil {
 		return m.getSharedLinkMetadataFn(arg)
@@ -1353,6 +1371,10 @@ func newShareLinkCreateTestCommand(stdout *bytes.Buffer) *cobra.Command {
 	cmd.Flags().Bool("disallow-download", false, "")
 	cmd.Flags().String("expires", "", "")
 	cmd.Flags().Bool("remove-expiration", false, "")
+	cmd.Flags().String("from-file", "", "")
+	cmd.Flags().Int("workers", defaultShareLinkCreateWorkers, "")
+	cmd.Flags().Bool("csv", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
 	addSharedLinkPasswordFlags(cmd)
 	addDryRunFlag(cmd)
 	if stdout != nil {
@@ -0,0 +1,131 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const (
+	shareFileKindFile       = "file"
+	shareFileStatusUnshared = "unshared"
+)
+
+// shareFileMembershipInput is the command input shared by invite and remove.
+// Fields that a command does not take are omitted.
+type shareFileMembershipInput struct {
+	File        string   `json:"file"`
+	Members     []string `json:"members"`
+	AccessLevel string   `json:"access_level,omitempty"`
+	Message     string   `json:"message,omitempty"`
+	Quiet       bool     `json:"quiet,omitempty"`
+	DryRun      bool     `json:"dry_run,omitempty"`
+}
+
+// shareFileInput identifies the file a result applies to.
+type shareFileInput struct {
+	File   string `json:"file"`
+	DryRun bool   `json:"dry_run,omitempty"`
+}
+
+// shareFileMemberChangeJSON is the outcome of a file membership mutation.
+// InheritedAccess is the access a removed member keeps through a parent
+// folder, when Dropbox reports one.
+type shareFileMemberChangeJSON struct {
+	File            string `json:"file"`
+	AccessLevel     string `json:"access_level,omitempty"`
+	InheritedAccess string `json:"inherited_access,omitempty"`
+	Warning         string `json:"warning,omitempty"`
+}
+
+var shareFileCmd = &cobra.Command{
+	Use:   "file",
+	Short: "Shared file commands",
+	Long: `Share individual files with specific people, separately from shared links.
+
+Commands that take a <file> accept a Dropbox path or a file ID (id:...).`,
+}
+
+// resolveShareFile validates a file argument, which is either a Dropbox file
+// ID or a Dropbox path.
+func resolveShareFile(file string) (string, error) {
+	if strings.HasPrefix(file, "id:") {
+		return file, nil
+	}
+	dropboxPath, err := validatePath(file)
+	if err != nil {
+		return "", err
+	}
+	if dropboxPath == "" {
+		return "", invalidArgumentsErrorWithDetails("Dropbox root is not a file", mergeJSONErrorDetails(argumentErrorDetails("file"), pathErrorDetails("/")))
+	}
+	return dropboxPath, nil
+}
+
+func shareFileDetails(operation, file string) map[string]any {
+	return mergeJSONErrorDetails(operationErrorDetails(operation), pathErrorDetails(file))
+}
+
+// fileMemberActionSummary renders a per-member failure the way Dropbox
+// renders an endpoint error summary, such as "access_error/no_permission".
+func fileMemberActionSummary(failure *sharing.FileMemberActionError) string {
+	if failure == nil {
+		return ""
+	}
+	summary := failure.Tag
+	if failure.AccessError != nil {
+		summary += "/" + failure.AccessError.Tag
+	}
+	return summary
+}
+
+// fileMemberActionError maps a per-member failure to a coded error.
+func fileMemberActionError(verb, member, file string, failure *sharing.FileMemberActionError, details map[string]any) error {
+	if strings.Contains(member, "@") {
+		details = mergeJSONErrorDetails(details, map[string]any{"email": member})
+	}
+	summary := fileMemberActionSummary(failure)
+	return fileSharingError(summary, fmt.Errorf("%s %s on %s: %s", verb, member, file, summary), details)
+}
+
+// fileSharingError codes a failure Dropbox reported inside a successful
+// response the same way it would code the equivalent endpoint error.
+func fileSharingError(summary string, err error, details map[string]any) error {
+	code := jsonErrorCodeDropboxAPIError
+	if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+		code = mapped
+	}
+	if summary != "" {
+		details = mergeJSONErrorDetails(details, map[string]any{"api_summary": summary + "/"})
+	}
+	return newCodedError(code, err, details)
+}
+
+// fileSharingWarnings turns partial failures into warnings about file.
+func fileSharingWarnings(file string, failures []error) []jsonWarning {
+	var warnings []jsonWarning
+	for _, failure := range failures {
+		warnings = append(warnings, jsonWarning{Code: jsonWarningCodeFileSharingFailed, Message: failure.Error(), Path: file})
+	}
+	return warnings
+}
+
+func init() {
+	shareCmd.AddCommand(shareFileCmd)
+}
@@ -0,0 +1,163 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFileOperationInvite = "share_file_invite"
+
+func shareFileInvite(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`share file invite` requires a `file` and at least one `member` argument", argumentsErrorDetails("file", "member"))
+	}
+	file, err := resolveShareFile(args[0])
+	if err != nil {
+		return err
+	}
+	memberArgs := args[1:]
+	accessLevel, err := parseShareFolderAccessLevel(cmd)
+	if err != nil {
+		return err
+	}
+	selectors := make([]*sharing.MemberSelector, 0, len(memberArgs))
+	kinds := make([]string, 0, len(memberArgs))
+	for _, value := range memberArgs {
+		selector, kind, err := parseShareFolderMember(value)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, selector)
+		kinds = append(kinds, kind)
+	}
+	message, _ := cmd.Flags().GetString("message")
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := shareFileMembershipInput{
+		File:        file,
+		Members:     memberArgs,
+		AccessLevel: accessLevel.Tag,
+		Message:     message,
+		Quiet:       quiet,
+		DryRun:      dryRun,
+	}
+
+	// Dropbox reports each member's outcome separately. Members it could not
+	// add become warnings unless every member failed.
+	granted := make([]string, len(memberArgs))
+	for i := range granted {
+		granted[i] = accessLevel.Tag
+	}
+	var warnings []jsonWarning
+	if !dryRun {
+		details := shareFileDetails(shareFileOperationInvite, file)
+		arg := sharing.NewAddFileMemberArgs(file, selectors)
+		arg.AccessLevel = accessLevel
+		arg.CustomMessage = message
+		arg.Quiet = quiet
+
+		dbx := newSharedFolderClient(config)
+		res, err := dbx.AddFileMemberContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		if len(res) != len(memberArgs) {
+			return commandFailedErrorfWithDetails("add file member: Dropbox returned %d results for %d members", details, len(res), len(memberArgs))
+		}
+
+		var failures []error
+		for i, entry := range res {
+			if entry.Result != nil && entry.Result.Tag == sharing.FileMemberActionIndividualResultSuccess {
+				if entry.Result.Success != nil {
+					granted[i] = entry.Result.Success.Tag
+				}
+				continue
+			}
+			var failure *sharing.FileMemberActionError
+			if entry.Result != nil {
+				failure = entry.Result.MemberError
+			}
+			failures = append(failures, fileMemberActionError("invite", memberArgs[i], file, failure, details))
+			granted[i] = ""
+		}
+		if len(failures) == len(memberArgs) {
+			return batchFailuresError(shareFileOperationInvite, failures)
+		}
+		warnings = fileSharingWarnings(file, failures)
+		if commandOutputFormat(cmd) == output.FormatText {
+			for _, warning := range warnings {
+				commandOutput(cmd).Warn("%s", warning.Message)
+			}
+		}
+		commandVerboseStatus(cmd, "Invited %d members to %s", len(memberArgs)-len(failures), file)
+	}
+
+	results := make([]jsonOperationResult, 0, len(memberArgs))
+	for i, value := range memberArgs {
+		if granted[i] == "" {
+			continue
+		}
+		results = append(results, newJSONOperationResult(
+			plannedStatus(dryRun, shareFolderMemberStatusInvited),
+			kinds[i],
+			shareFolderMemberInput{Member: value, DryRun: dryRun},
+			shareFileMemberChangeJSON{File: file, AccessLevel: granted[i]},
+		))
+	}
+	return renderOperation(cmd, input, results, warnings, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		for _, value := range memberArgs {
+			if _, err := fmt.Fprintf(w, "Would invite %s to %s as %s\n", value, file, accessLevel.Tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var shareFileInviteCmd = &cobra.Command{
+	Use:   "invite [flags] <file> <member>...",
+	Short: "Share a file with specific members",
+	Long: `Give users or groups direct access to a single file.
+
+Members are email addresses, account IDs (dbid:...), team member IDs
+(dbmid:...), or group IDs (g:...). Invited users receive an email and device
+notification unless --quiet is set. Members Dropbox could not add are
+reported as warnings; the command fails only when no member was added.`,
+	Example: `  dbxcli share file invite /Contracts/acme.pdf alice@example.com
+  dbxcli share file invite --access editor --quiet id:a4ayc_80_OEAAAAAAAAAXw legal@example.com`,
+	RunE: shareFileInvite,
+}
+
+func init() {
+	shareFileCmd.AddCommand(shareFileInviteCmd)
+	shareFileInviteCmd.Flags().String("access", sharing.AccessLevelViewer, "Access level to grant: viewer, editor, or viewer_no_comment")
+	shareFileInviteCmd.Flags().String("message", "", "Custom message to include in the invitation")
+	shareFileInviteCmd.Flags().Bool("quiet", false, "Do not notify invited members")
+	addDryRunFlag(shareFileInviteCmd)
+	enableStructuredOutput(shareFileInviteCmd)
+}
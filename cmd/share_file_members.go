@@ -0,0 +1,175 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const (
+	shareFileOperationMembers = "share_file_members"
+
+	// shareFileMembersBatchSize is the most files list_file_members/batch
+	// accepts per call.
+	shareFileMembersBatchSize = 100
+)
+
+type shareFileMembersInput struct {
+	Files []string `json:"files"`
+}
+
+// shareFileMembers is the membership of one file, or the reason Dropbox
+// could not list it.
+type shareFileMembers struct {
+	file    string
+	members []shareFolderMemberJSON
+	err     error
+}
+
+func shareFileMembersList(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return invalidArgumentsErrorWithDetails("`share file members` requires at least one `file` argument", argumentErrorDetails("file"))
+	}
+	files := make([]string, 0, len(args))
+	for _, arg := range args {
+		file, err := resolveShareFile(arg)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+	}
+
+	dbx := newSharedFolderClient(config)
+	listed, err := listSharedFileMembers(dbx, files)
+	if err != nil {
+		return err
+	}
+
+	var results []jsonOperationResult
+	var failures []error
+	var warnings []jsonWarning
+	for _, entry := range listed {
+		if entry.err != nil {
+			failures = append(failures, entry.err)
+			warnings = append(warnings, fileSharingWarnings(entry.file, []error{entry.err})...)
+			continue
+		}
+		commandVerboseStatus(cmd, "Listed %d members of %s", len(entry.members), entry.file)
+		for _, member := range entry.members {
+			results = append(results, newJSONOperationResult(shareFolderMemberStatusListed, member.Type, shareFileInput{File: entry.file}, member))
+		}
+	}
+	if len(failures) == len(listed) {
+		return batchFailuresError(shareFileOperationMembers, failures)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+
+	return renderOperation(cmd, shareFileMembersInput{Files: files}, results, warnings, func(w io.Writer) error {
+		for _, entry := range listed {
+			for _, member := range entry.members {
+				name := member.DisplayName
+				if member.Type == shareFolderMemberKindGroup {
+					name = member.GroupName
+				}
+				if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entry.file, member.Type, member.AccessType, name, member.Email); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// listSharedFileMembers lists the members of many files with
+// list_file_members/batch, following each file's cursor with
+// list_file_members/continue. Files Dropbox cannot list become warnings.
+func listSharedFileMembers(dbx sharedFolderClient, files []string) ([]shareFileMembers, error) {
+	listed := make([]shareFileMembers, 0, len(files))
+	for start := 0; start < len(files); start += shareFileMembersBatchSize {
+		batch := files[start:min(start+shareFileMembersBatchSize, len(files))]
+		res, err := dbx.ListFileMembersBatchContext(currentContext(), sharing.NewListFileMembersBatchArg(batch))
+		if err != nil {
+			return nil, withJSONErrorDetails(err, operationErrorDetails(shareFileOperationMembers))
+		}
+		if len(res) != len(batch) {
+			return nil, commandFailedErrorfWithDetails("list file members: Dropbox returned %d results for %d files", operationErrorDetails(shareFileOperationMembers), len(res), len(batch))
+		}
+
+		for i, entry := range res {
+			file := batch[i]
+			if entry == nil || entry.Result == nil || entry.Result.Tag != sharing.ListFileMembersIndividualResultResult || entry.Result.Result == nil {
+				summary := sharing.ListFileMembersIndividualResultOther
+				if entry != nil && entry.Result != nil {
+					summary = entry.Result.Tag
+					if entry.Result.AccessError != nil {
+						summary += "/" + entry.Result.AccessError.Tag
+					}
+				}
+				err := fileSharingError(summary, fmt.Errorf("list members of %s: %s", file, summary), shareFileDetails(shareFileOperationMembers, file))
+				listed = append(listed, shareFileMembers{file: file, err: err})
+				continue
+			}
+
+			page := entry.Result.Result.Members
+			members := appendSharedFileMembers(nil, page)
+			for page != nil && page.Cursor != "" {
+				page, err = dbx.ListFileMembersContinueContext(currentContext(), sharing.NewListFileMembersContinueArg(page.Cursor))
+				if err != nil {
+					return nil, withJSONErrorDetails(err, shareFileDetails(shareFileOperationMembers, file))
+				}
+				members = appendSharedFileMembers(members, page)
+			}
+			listed = append(listed, shareFileMembers{file: file, members: members})
+		}
+	}
+	return listed, nil
+}
+
+func appendSharedFileMembers(members []shareFolderMemberJSON, res *sharing.SharedFileMembers) []shareFolderMemberJSON {
+	if res == nil {
+		return members
+	}
+	folderMembers := &sharing.SharedFolderMembers{Groups: res.Groups, Invitees: res.Invitees}
+	for _, user := range res.Users {
+		folderMembers.Users = append(folderMembers.Users, &user.UserMembershipInfo)
+	}
+	return appendSharedFolderMembers(members, folderMembers)
+}
+
+var shareFileMembersCmd = &cobra.Command{
+	Use:   "members <file>...",
+	Short: "List the members of shared files",
+	Long: `List the users, groups, and pending invitees with access to one or more
+files, including access inherited from a parent shared folder. Text output
+prints one tab-separated line per member: file, type, access level, name, and
+email. Files Dropbox cannot list are reported as warnings.`,
+	Example: `  dbxcli share file members /Contracts/acme.pdf
+  dbxcli share file members /Contracts/*.pdf --output=json`,
+	RunE: shareFileMembersList,
+}
+
+func init() {
+	shareFileCmd.AddCommand(shareFileMembersCmd)
+	enableStructuredOutput(shareFileMembersCmd)
+}
@@ -0,0 +1,122 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFileOperationRemove = "share_file_remove"
+
+func shareFileRemove(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`share file remove` requires a `file` and at least one `member` argument", argumentsErrorDetails("file", "member"))
+	}
+	file, err := resolveShareFile(args[0])
+	if err != nil {
+		return err
+	}
+	memberArgs := args[1:]
+	selectors := make([]*sharing.MemberSelector, 0, len(memberArgs))
+	kinds := make([]string, 0, len(memberArgs))
+	for _, value := range memberArgs {
+		selector, kind, err := parseShareFolderMember(value)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, selector)
+		kinds = append(kinds, kind)
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := shareFileMembershipInput{File: file, Members: memberArgs, DryRun: dryRun}
+	details := shareFileDetails(shareFileOperationRemove, file)
+	dbx := newSharedFolderClient(config)
+	results := make([]jsonOperationResult, 0, len(memberArgs))
+	changes := make([]shareFileMemberChangeJSON, 0, len(memberArgs))
+	for i, value := range memberArgs {
+		change := shareFileMemberChangeJSON{File: file}
+		if !dryRun {
+			res, err := dbx.RemoveFileMember2Context(currentContext(), sharing.NewRemoveFileMemberArg(file, selectors[i]))
+			if err != nil {
+				return withJSONErrorDetails(err, details)
+			}
+			switch res.Tag {
+			case sharing.FileMemberRemoveActionResultSuccess:
+				if res.Success != nil {
+					if res.Success.AccessLevel != nil {
+						change.InheritedAccess = res.Success.AccessLevel.Tag
+					}
+					change.Warning = res.Success.Warning
+				}
+			case sharing.FileMemberRemoveActionResultMemberError:
+				return fileMemberActionError("remove", value, file, res.MemberError, details)
+			default:
+				return commandFailedErrorfWithDetails("remove file member: Dropbox returned an unexpected result %q", details, res.Tag)
+			}
+			commandVerboseStatus(cmd, "Removed %s from %s", value, file)
+		}
+		changes = append(changes, change)
+		results = append(results, newJSONOperationResult(
+			plannedStatus(dryRun, shareFolderMemberStatusRemoved),
+			kinds[i],
+			shareFolderMemberInput{Member: value, DryRun: dryRun},
+			change,
+		))
+	}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		for i, member := range memberArgs {
+			if dryRun {
+				if _, err := fmt.Fprintf(w, "Would remove %s from %s\n", member, file); err != nil {
+					return err
+				}
+				continue
+			}
+			if changes[i].InheritedAccess == "" {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s still has %s access to %s through a parent folder\n", member, changes[i].InheritedAccess, file); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var shareFileRemoveCmd = &cobra.Command{
+	Use:   "remove [flags] <file> <member>...",
+	Short: "Remove members from a shared file",
+	Long: `Remove users, groups, or pending invitees from a file's direct members.
+
+Members are email addresses, account IDs (dbid:...), team member IDs
+(dbmid:...), or group IDs (g:...). A member who still has access through a
+parent shared folder is reported.`,
+	Example: `  dbxcli share file remove /Contracts/acme.pdf alice@example.com
+  dbxcli share file remove id:a4ayc_80_OEAAAAAAAAAXw dbid:AAH4f99T0taONIb-OurWxbNQ6ywGRopQngc`,
+	RunE: shareFileRemove,
+}
+
+func init() {
+	shareFileCmd.AddCommand(shareFileRemoveCmd)
+	addDryRunFlag(shareFileRemoveCmd)
+	enableStructuredOutput(shareFileRemoveCmd)
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestShareFileMembersBatchesAndFollowsCursors(t *testing.T) {
+	args := make([]string, 0, shareFileMembersBatchSize+1)
+	for i := range shareFileMembersBatchSize + 1 {
+		args = append(args, fmt.Sprintf("/Contracts/%03d.pdf", i))
+	}
+	var batches []int
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFileMembersBatchFn: func(arg *sharing.ListFileMembersBatchArg) ([]*sharing.ListFileMembersBatchResult, error) {
+			batches = append(batches, len(arg.Files))
+			res := make([]*sharing.ListFileMembersBatchResult, 0, len(arg.Files))
+			for _, file := range arg.Files {
+				members := &sharing.SharedFileMembers{}
+				switch file {
+				case "/Contracts/000.pdf":
+					members.Users = []*sharing.UserFileMembershipInfo{{UserMembershipInfo: *testShareFolderUser("dbid:alice", "alice@example.com", sharing.AccessLevelOwner)}}
+					members.Cursor = "cursor-1"
+				case "/Contracts/001.pdf":
+					res = append(res, sharing.NewListFileMembersBatchResult(file, &sharing.ListFileMembersIndividualResult{
+						Tagged:      dropbox.Tagged{Tag: sharing.ListFileMembersIndividualResultAccessError},
+						AccessError: &sharing.SharingFileAccessError{Tagged: dropbox.Tagged{Tag: sharing.SharingFileAccessErrorNoPermission}},
+					}))
+					continue
+				}
+				res = append(res, sharing.NewListFileMembersBatchResult(file, &sharing.ListFileMembersIndividualResult{
+					Tagged: dropbox.Tagged{Tag: sharing.ListFileMembersIndividualResultResult},
+					Result: sharing.NewListFileMembersCountResult(members, uint32(len(members.Users))),
+				}))
+			}
+			return res, nil
+		},
+		listFileMembersContinueFn: func(arg *sharing.ListFileMembersContinueArg) (*sharing.SharedFileMembers, error) {
+			if arg.Cursor != "cursor-1" {
+				t.Fatalf("continue cursor = %q", arg.Cursor)
+			}
+			invitee := &sharing.InviteeMembershipInfo{
+				MembershipInfo: sharing.MembershipInfo{AccessType: testAccessLevel(sharing.AccessLevelViewer)},
+				Invitee:        &sharing.InviteeInfo{Tagged: dropbox.Tagged{Tag: sharing.InviteeInfoEmail}, Email: "counsel@example.org"},
+			}
+			return &sharing.SharedFileMembers{Invitees: []*sharing.InviteeMembershipInfo{invitee}}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json"})
+	if err := shareFileMembersList(cmd, args); err != nil {
+		t.Fatalf("share file members error: %v", err)
+	}
+	if len(batches) != 2 || batches[0] != shareFileMembersBatchSize || batches[1] != 1 {
+		t.Fatalf("batches = %v, want %d then 1", batches, shareFileMembersBatchSize)
+	}
+
+	got := decodeShareLinkOperationOutputWithWarnings[shareFileMembersInput, shareFolderMemberJSON](t, stdout.Bytes())
+	if len(got.Input.Files) != len(args) {
+		t.Fatalf("input files = %d, want %d", len(got.Input.Files), len(args))
+	}
+	if len(got.Results) != 2 {
+		t.Fatalf("results = %#v, want owner and invitee", got.Results)
+	}
+	if got.Results[0].Kind != shareFolderMemberKindUser || got.Results[0].Result.AccountID != "dbid:alice" || got.Results[1].Result.Email != "counsel@example.org" {
+		t.Fatalf("results = %#v", got.Results)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != jsonWarningCodeFileSharingFailed || got.Warnings[0].Path != "/Contracts/001.pdf" {
+		t.Fatalf("warnings = %#v, want file_sharing_failed for 001.pdf", got.Warnings)
+	}
+}
+
+func TestShareFileMembersFailsWhenNoFileCanBeListed(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFileMembersBatchFn: func(arg *sharing.ListFileMembersBatchArg) ([]*sharing.ListFileMembersBatchResult, error) {
+			return []*sharing.ListFileMembersBatchResult{sharing.NewListFileMembersBatchResult(arg.Files[0], &sharing.ListFileMembersIndividualResult{
+				Tagged:      dropbox.Tagged{Tag: sharing.ListFileMembersIndividualResultAccessError},
+				AccessError: &sharing.SharingFileAccessError{Tagged: dropbox.Tagged{Tag: sharing.SharingFileAccessErrorNoPermission}},
+			})}, nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFileMembersList(cmd, []string{"/Contracts/acme.pdf"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodePermissionDenied {
+		t.Fatalf("err = %v, want permission_denied", err)
+	}
+	if details := jsonErrorDetails(err); details["path"] != "/Contracts/acme.pdf" || !strings.HasPrefix(fmt.Sprint(details["api_summary"]), "access_error/no_permission") {
+		t.Fatalf("details = %#v", details)
+	}
+}
+
+func TestShareFileInviteWarnsAboutFailedMembers(t *testing.T) {
+	var got *sharing.AddFileMemberArgs
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		addFileMemberFn: func(arg *sharing.AddFileMemberArgs) ([]*sharing.FileMemberActionResult, error) {
+			got = arg
+			return []*sharing.FileMemberActionResult{
+				{Member: arg.Members[0], Result: &sharing.FileMemberActionIndividualResult{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionIndividualResultSuccess}, Success: testAccessLevel(sharing.AccessLevelEditor)}},
+				{Member: arg.Members[1], Result: &sharing.FileMemberActionIndividualResult{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionIndividualResultMemberError}, MemberError: &sharing.FileMemberActionError{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionErrorInvalidMember}}}},
+			}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "access": "editor", "quiet": "true"})
+	if err := shareFileInvite(cmd, []string{"id:a4ayc_80_OEAAAAAAAAAXw", "alice@example.com", "bob@example.com"}); err != nil {
+		t.Fatalf("share file invite error: %v", err)
+	}
+	if got == nil || got.File != "id:a4ayc_80_OEAAAAAAAAAXw" || !got.Quiet || got.AccessLevel.Tag != sharing.AccessLevelEditor || len(got.Members) != 2 {
+		t.Fatalf("add file member arg = %#v", got)
+	}
+
+	out := decodeShareLinkOperationOutputWithWarnings[shareFileMembershipInput, shareFileMemberChangeJSON](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderMemberStatusInvited || out.Results[0].Result.AccessLevel != sharing.AccessLevelEditor {
+		t.Fatalf("results = %#v, want alice invited", out.Results)
+	}
+	if len(out.Warnings) != 1 || !strings.Contains(out.Warnings[0].Message, "bob@example.com") || !strings.Contains(out.Warnings[0].Message, "invalid_member") {
+		t.Fatalf("warnings = %#v, want bob's invalid_member failure", out.Warnings)
+	}
+}
+
+func TestShareFileInviteFailsWhenEveryMemberFails(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		addFileMemberFn: func(arg *sharing.AddFileMemberArgs) ([]*sharing.FileMemberActionResult, error) {
+			return []*sharing.FileMemberActionResult{
+				{Member: arg.Members[0], Result: &sharing.FileMemberActionIndividualResult{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionIndividualResultMemberError}, MemberError: &sharing.FileMemberActionError{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionErrorNoPermission}}}},
+			}, nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(map[string]string{"access": "viewer"})
+	err := shareFileInvite(cmd, []string{"/Contracts/acme.pdf", "alice@example.com"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodePermissionDenied {
+		t.Fatalf("err = %v, want permission_denied", err)
+	}
+	if details := jsonErrorDetails(err); details["email"] != "alice@example.com" || details["path"] != "/Contracts/acme.pdf" {
+		t.Fatalf("details = %#v", details)
+	}
+}
+
+func TestShareFileRemoveReportsInheritedAccess(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFileMemberFn: func(arg *sharing.RemoveFileMemberArg) (*sharing.FileMemberRemoveActionResult, error) {
+			if arg.File != "/Contracts/acme.pdf" || arg.Member.Email != "alice@example.com" {
+				t.Fatalf("remove arg = %#v", arg)
+			}
+			return &sharing.FileMemberRemoveActionResult{
+				Tagged:  dropbox.Tagged{Tag: sharing.FileMemberRemoveActionResultSuccess},
+				Success: &sharing.MemberAccessLevelResult{AccessLevel: testAccessLevel(sharing.AccessLevelViewer)},
+			}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(nil)
+	if err := shareFileRemove(cmd, []string{"/Contracts/acme.pdf", "alice@example.com"}); err != nil {
+		t.Fatalf("share file remove error: %v", err)
+	}
+	if want := "alice@example.com still has viewer access to /Contracts/acme.pdf through a parent folder\n"; stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareFileRemoveMapsMemberError(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFileMemberFn: func(arg *sharing.RemoveFileMemberArg) (*sharing.FileMemberRemoveActionResult, error) {
+			return &sharing.FileMemberRemoveActionResult{
+				Tagged:      dropbox.Tagged{Tag: sharing.FileMemberRemoveActionResultMemberError},
+				MemberError: &sharing.FileMemberActionError{Tagged: dropbox.Tagged{Tag: sharing.FileMemberActionErrorNoPermission}},
+			}, nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFileRemove(cmd, []string{"/Contracts/acme.pdf", "dbid:alice"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodePermissionDenied || jsonErrorDetails(err)["api_summary"] != "no_permission/" {
+		t.Fatalf("err = %v, want permission_denied with api_summary", err)
+	}
+}
+
+func TestShareFileUnshareDryRunDoesNotUnshare(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", dryRunFlagName: "true"})
+	if err := shareFileUnshare(cmd, []string{"/Contracts/acme.pdf"}); err != nil {
+		t.Fatalf("share file unshare error: %v", err)
+	}
+	got := decodeShareLinkOperationOutput[shareFileInput, shareFileMemberChangeJSON](t, stdout.Bytes())
+	if !got.Input.DryRun || len(got.Results) != 1 || got.Results[0].Status != jsonStatusPlanned || got.Results[0].Kind != shareFileKindFile {
+		t.Fatalf("output = %#v", got)
+	}
+}
+
+func TestShareFileRejectsRoot(t *testing.T) {
+	cmd, _ := testShareFolderCmd(nil)
+	if err := shareFileUnshare(cmd, []string{"/"}); err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for root", err)
+	}
+}
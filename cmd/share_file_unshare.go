@@ -0,0 +1,72 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFileOperationUnshare = "share_file_unshare"
+
+func shareFileUnshare(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share file unshare` requires a `file` argument", argumentErrorDetails("file"))
+	}
+	file, err := resolveShareFile(args[0])
+	if err != nil {
+		return err
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := shareFileInput{File: file, DryRun: dryRun}
+	if !dryRun {
+		dbx := newSharedFolderClient(config)
+		if err := dbx.UnshareFileContext(currentContext(), sharing.NewUnshareFileArg(file)); err != nil {
+			return withJSONErrorDetails(err, shareFileDetails(shareFileOperationUnshare, file))
+		}
+		commandVerboseStatus(cmd, "Unshared %s", file)
+	}
+
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(plannedStatus(dryRun, shareFileStatusUnshared), shareFileKindFile, input, shareFileMemberChangeJSON{File: file}),
+	}, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		return writeDryRunLine(w, "unshare file", file)
+	})
+}
+
+var shareFileUnshareCmd = &cobra.Command{
+	Use:   "unshare [flags] <file>",
+	Short: "Remove all direct members of a file",
+	Long: `Remove every direct member and pending invitee of a file. Members who have
+access through a parent shared folder keep it.`,
+	Example: `  dbxcli share file unshare /Contracts/acme.pdf
+  dbxcli share file unshare --dry-run id:a4ayc_80_OEAAAAAAAAAXw`,
+	RunE: shareFileUnshare,
+}
+
+func init() {
+	shareFileCmd.AddCommand(shareFileUnshareCmd)
+	addDryRunFlag(shareFileUnshareCmd)
+	enableStructuredOutput(shareFileUnshareCmd)
+}
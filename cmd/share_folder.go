@@ -0,0 +1,403 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const (
+	shareFolderMemberKindUser    = "user"
+	shareFolderMemberKindGroup   = "group"
+	shareFolderMemberKindInvitee = "invitee"
+
+	shareFolderMemberStatusListed  = "listed"
+	shareFolderMemberStatusInvited = "invited"
+	shareFolderMemberStatusRemoved = "removed"
+	shareFolderMemberStatusUpdated = "updated"
+
+	shareFolderStatusShared    = "shared"
+	shareFolderStatusUnshared  = "unshared"
+	shareFolderStatusUpdated   = "updated"
+	shareFolderStatusMounted   = "mounted"
+	shareFolderStatusUnmounted = "unmounted"
+	shareFolderStatusLeft      = "left"
+)
+
+// shareFolderPollInterval is the delay between job status calls while waiting
+// for Dropbox to finish an asynchronous shared folder operation.
+var shareFolderPollInterval = time.Second
+
+// shareFolderMembershipInput is the command input shared by the membership
+// mutations. Fields that a command does not take are omitted.
+type shareFolderMembershipInput struct {
+	Folder         string   `json:"folder"`
+	SharedFolderID string   `json:"shared_folder_id"`
+	Members        []string `json:"members"`
+	AccessLevel    string   `json:"access_level,omitempty"`
+	Message        string   `json:"message,omitempty"`
+	Quiet          bool     `json:"quiet,omitempty"`
+	LeaveACopy     bool     `json:"leave_a_copy,omitempty"`
+	DryRun         bool     `json:"dry_run,omitempty"`
+}
+
+type shareFolderMemberInput struct {
+	Member string `json:"member"`
+	DryRun bool   `json:"dry_run,omitempty"`
+}
+
+// shareFolderMemberChangeJSON is the outcome of a membership mutation.
+// InheritedAccess is the access a removed or downgraded member keeps through
+// a parent folder, when Dropbox reports one.
+type shareFolderMemberChangeJSON struct {
+	SharedFolderID  string `json:"shared_folder_id"`
+	AccessLevel     string `json:"access_level,omitempty"`
+	InheritedAccess string `json:"inherited_access,omitempty"`
+	Warning         string `json:"warning,omitempty"`
+}
+
+// shareFolderMountInput is the command input shared by mount, unmount, and
+// leave.
+type shareFolderMountInput struct {
+	Folder         string `json:"folder"`
+	SharedFolderID string `json:"shared_folder_id"`
+	KeepCopy       bool   `json:"keep_copy,omitempty"`
+	DryRun         bool   `json:"dry_run,omitempty"`
+}
+
+// shareFolderRefJSON identifies the shared folder an operation acted on when
+// Dropbox returns no folder metadata.
+type shareFolderRefJSON struct {
+	SharedFolderID string `json:"shared_folder_id"`
+}
+
+// shareFolderPolicyFlags holds the folder policy flags shared by create and
+// update-policy. Empty fields leave the policy unchanged.
+type shareFolderPolicyFlags struct {
+	memberPolicy     string
+	aclUpdatePolicy  string
+	sharedLinkPolicy string
+	viewerInfoPolicy string
+}
+
+var shareFolderPolicyFlagValues = []struct {
+	name   string
+	values []string
+}{
+	{"member-policy", []string{sharing.MemberPolicyAnyone, sharing.MemberPolicyTeam}},
+	{"acl-update-policy", []string{sharing.AclUpdatePolicyEditors, sharing.AclUpdatePolicyOwner}},
+	{"shared-link-policy", []string{sharing.SharedLinkPolicyAnyone, sharing.SharedLinkPolicyMembers, sharing.SharedLinkPolicyTeam}},
+	{"viewer-info-policy", []string{sharing.ViewerInfoPolicyDisabled, sharing.ViewerInfoPolicyEnabled}},
+}
+
+var shareFolderCmd = &cobra.Command{
+	Use:   "folder",
+	Short: "Shared folder commands",
+	Long: `Manage shared folders and their members.
+
+Commands that take a <folder> accept a Dropbox path or a numeric shared folder
+ID, as shown by share list folder --output=json.`,
+}
+
+// resolveSharedFolderID returns the shared folder ID for folder, which is
+// either a numeric shared folder ID or the Dropbox path of a shared folder.
+func resolveSharedFolderID(folder string) (string, error) {
+	if folder == "" {
+		return "", invalidArgumentsErrorWithDetails("a non-empty `folder` argument is required", argumentErrorDetails("folder"))
+	}
+	if isSharedFolderID(folder) {
+		return folder, nil
+	}
+	dropboxPath, err := validatePath(folder)
+	if err != nil {
+		return "", err
+	}
+	if dropboxPath == "" {
+		return "", invalidArgumentsErrorWithDetails("Dropbox root is not a shared folder", mergeJSONErrorDetails(argumentErrorDetails("folder"), pathErrorDetails("/")))
+	}
+
+	dbx := filesNewFunc(config)
+	metadata, err := dbx.GetMetadataContext(currentContext(), files.NewGetMetadataArg(dropboxPath))
+	if err != nil {
+		return "", withJSONErrorDetails(fmt.Errorf("get metadata for %s: %w", dropboxPath, err), pathErrorDetails(dropboxPath))
+	}
+	folderMetadata, ok := metadata.(*files.FolderMetadata)
+	if !ok {
+		return "", invalidArgumentsErrorfWithDetails("%s is not a folder", pathErrorDetails(dropboxPath), dropboxPath)
+	}
+	id := folderMetadata.SharedFolderId
+	if folderMetadata.SharingInfo != nil && folderMetadata.SharingInfo.SharedFolderId != "" {
+		id = folderMetadata.SharingInfo.SharedFolderId
+	}
+	if id == "" {
+		return "", invalidArgumentsErrorfWithDetails("%s is not a shared folder", pathErrorDetails(dropboxPath), dropboxPath)
+	}
+	return id, nil
+}
+
+func isSharedFolderID(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// parseShareFolderMember maps a member argument to a Dropbox member selector:
+// email addresses select users or invitees, and Dropbox IDs select accounts
+// (dbid:), team members (dbmid:), or groups (g:).
+func parseShareFolderMember(value string) (*sharing.MemberSelector, string, error) {
+	switch {
+	case strings.Contains(value, "@"):
+		return &sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorEmail}, Email: value}, shareFolderMemberKindUser, nil
+	case strings.HasPrefix(value, "g:"):
+		return &sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorDropboxId}, DropboxId: value}, shareFolderMemberKindGroup, nil
+	case strings.HasPrefix(value, "dbid:"), strings.HasPrefix(value, "dbmid:"):
+		return &sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorDropboxId}, DropboxId: value}, shareFolderMemberKindUser, nil
+	default:
+		return nil, "", invalidArgumentsErrorfWithDetails("invalid member %q: use an email address, an account ID (dbid:), a team member ID (dbmid:), or a group ID (g:)", mergeJSONErrorDetails(argumentErrorDetails("member"), map[string]any{"value": value}), value)
+	}
+}
+
+// parseShareFolderAccessLevel parses --access for member mutations. Dropbox
+// does not allow granting owner access through these endpoints.
+func parseShareFolderAccessLevel(cmd *cobra.Command) (*sharing.AccessLevel, error) {
+	value, _ := cmd.Flags().GetString("access")
+	switch value {
+	case sharing.AccessLevelViewer, sharing.AccessLevelEditor, sharing.AccessLevelViewerNoComment:
+		return &sharing.AccessLevel{Tagged: dropbox.Tagged{Tag: value}}, nil
+	case "":
+		return nil, invalidArgumentsErrorWithDetails("`--access` is required", flagErrorDetails("access"))
+	default:
+		return nil, invalidArgumentsErrorfWithDetails("invalid --access %q: use viewer, editor, or viewer_no_comment", flagValueErrorDetails("access", value), value)
+	}
+}
+
+func addShareFolderPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String("member-policy", "", "Who can be a member: anyone or team")
+	cmd.Flags().String("acl-update-policy", "", "Who can add and remove members: owner or editors")
+	cmd.Flags().String("shared-link-policy", "", "Who shared links can be shared with: anyone, team, or members")
+	cmd.Flags().String("viewer-info-policy", "", "Whether viewer info is enabled or disabled")
+}
+
+func parseShareFolderPolicyFlags(cmd *cobra.Command) (shareFolderPolicyFlags, error) {
+	values := make(map[string]string, len(shareFolderPolicyFlagValues))
+	for _, flag := range shareFolderPolicyFlagValues {
+		value, _ := cmd.Flags().GetString(flag.name)
+		if value != "" && !slices.Contains(flag.values, value) {
+			return shareFolderPolicyFlags{}, invalidArgumentsErrorfWithDetails("invalid --%s %q: use one of %s", flagValueErrorDetails(flag.name, value), flag.name, value, strings.Join(flag.values, ", "))
+		}
+		values[flag.name] = value
+	}
+	return shareFolderPolicyFlags{
+		memberPolicy:     values["member-policy"],
+		aclUpdatePolicy:  values["acl-update-policy"],
+		sharedLinkPolicy: values["shared-link-policy"],
+		viewerInfoPolicy: values["viewer-info-policy"],
+	}, nil
+}
+
+func (p shareFolderPolicyFlags) empty() bool {
+	return p == shareFolderPolicyFlags{}
+}
+
+func (p shareFolderPolicyFlags) memberPolicyArg() *sharing.MemberPolicy {
+	if p.memberPolicy == "" {
+		return nil
+	}
+	return &sharing.MemberPolicy{Tagged: dropbox.Tagged{Tag: p.memberPolicy}}
+}
+
+func (p shareFolderPolicyFlags) aclUpdatePolicyArg() *sharing.AclUpdatePolicy {
+	if p.aclUpdatePolicy == "" {
+		return nil
+	}
+	return &sharing.AclUpdatePolicy{Tagged: dropbox.Tagged{Tag: p.aclUpdatePolicy}}
+}
+
+func (p shareFolderPolicyFlags) sharedLinkPolicyArg() *sharing.SharedLinkPolicy {
+	if p.sharedLinkPolicy == "" {
+		return nil
+	}
+	return &sharing.SharedLinkPolicy{Tagged: dropbox.Tagged{Tag: p.sharedLinkPolicy}}
+}
+
+func (p shareFolderPolicyFlags) viewerInfoPolicyArg() *sharing.ViewerInfoPolicy {
+	if p.viewerInfoPolicy == "" {
+		return nil
+	}
+	return &sharing.ViewerInfoPolicy{Tagged: dropbox.Tagged{Tag: p.viewerInfoPolicy}}
+}
+
+// applyTo overlays the requested policies on a folder's current policy, for
+// dry-run output.
+func (p shareFolderPolicyFlags) applyTo(policy *shareFolderPolicyJSON) *shareFolderPolicyJSON {
+	var planned shareFolderPolicyJSON
+	if policy != nil {
+		planned = *policy
+	}
+	if p.memberPolicy != "" {
+		planned.MemberPolicy = p.memberPolicy
+	}
+	if p.aclUpdatePolicy != "" {
+		planned.AclUpdatePolicy = p.aclUpdatePolicy
+	}
+	if p.sharedLinkPolicy != "" {
+		planned.SharedLinkPolicy = p.sharedLinkPolicy
+	}
+	if p.viewerInfoPolicy != "" {
+		planned.ViewerInfoPolicy = p.viewerInfoPolicy
+	}
+	return &planned
+}
+
+func shareFolderFolderDetails(operation, folder string) map[string]any {
+	details := operationErrorDetails(operation)
+	if !isSharedFolderID(folder) {
+		details = mergeJSONErrorDetails(details, pathErrorDetails(folder))
+	}
+	return details
+}
+
+func shareFolderMemberChange(sharedFolderID, accessLevel string, res *sharing.MemberAccessLevelResult) shareFolderMemberChangeJSON {
+	change := shareFolderMemberChangeJSON{SharedFolderID: sharedFolderID, AccessLevel: accessLevel}
+	if res != nil {
+		if res.AccessLevel != nil {
+			change.InheritedAccess = res.AccessLevel.Tag
+		}
+		change.Warning = res.Warning
+	}
+	return change
+}
+
+// waitForRemoveFolderMemberJob polls check_remove_member_job_status until
+// Dropbox finishes removing a member. The poll stops early when the command
+// context ends, so --timeout bounds the total wait.
+func waitForRemoveFolderMemberJob(dbx sharedFolderClient, jobID string, details map[string]any) (*sharing.MemberAccessLevelResult, error) {
+	details = mergeJSONErrorDetails(details, map[string]any{"async_job_id": jobID})
+	for {
+		if err := retrySleep(currentContext(), shareFolderPollInterval); err != nil {
+			return nil, withJSONErrorDetails(fmt.Errorf("wait for remove member job %s: %w", jobID, err), details)
+		}
+
+		var job *sharing.RemoveMemberJobStatus
+		err := retryWithBackoff(func() error {
+			var err error
+			job, err = dbx.CheckRemoveMemberJobStatusContext(currentContext(), async.NewPollArg(jobID))
+			return err
+		})
+		if err != nil {
+			return nil, withJSONErrorDetails(err, details)
+		}
+
+		switch job.Tag {
+		case sharing.RemoveMemberJobStatusInProgress:
+			continue
+		case sharing.RemoveMemberJobStatusComplete:
+			return job.Complete, nil
+		case sharing.RemoveMemberJobStatusFailed:
+			return nil, removeFolderMemberJobError(jobID, job.Failed, details)
+		default:
+			return nil, commandFailedErrorfWithDetails("remove member job %s: Dropbox returned an unexpected status %q", details, jobID, job.Tag)
+		}
+	}
+}
+
+// waitForSharingJob polls sharing/check_job_status, which reports on the
+// unshare_folder and relinquish_folder_membership jobs, until the job ends.
+func waitForSharingJob(dbx sharedFolderClient, jobID string, details map[string]any) error {
+	details = mergeJSONErrorDetails(details, map[string]any{"async_job_id": jobID})
+	for {
+		if err := retrySleep(currentContext(), shareFolderPollInterval); err != nil {
+			return withJSONErrorDetails(fmt.Errorf("wait for sharing job %s: %w", jobID, err), details)
+		}
+
+		var job *sharing.JobStatus
+		err := retryWithBackoff(func() error {
+			var err error
+			job, err = dbx.CheckJobStatusContext(currentContext(), async.NewPollArg(jobID))
+			return err
+		})
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+
+		switch job.Tag {
+		case sharing.JobStatusInProgress:
+			continue
+		case sharing.JobStatusComplete:
+			return nil
+		case sharing.JobStatusFailed:
+			summary := ""
+			if job.Failed != nil {
+				summary = job.Failed.Tag
+				switch {
+				case job.Failed.UnshareFolderError != nil:
+					summary += "/" + job.Failed.UnshareFolderError.Tag
+				case job.Failed.RemoveFolderMemberError != nil:
+					summary += "/" + job.Failed.RemoveFolderMemberError.Tag
+				case job.Failed.RelinquishFolderMembershipError != nil:
+					summary += "/" + job.Failed.RelinquishFolderMembershipError.Tag
+				}
+			}
+			return sharingJobError(jobID, summary, details)
+		default:
+			return commandFailedErrorfWithDetails("sharing job %s: Dropbox returned an unexpected status %q", details, jobID, job.Tag)
+		}
+	}
+}
+
+// sharingJobError maps a failed sharing job to a coded error the same way a
+// synchronous API error summary would be mapped.
+func sharingJobError(jobID, summary string, details map[string]any) error {
+	if summary == "" {
+		return commandFailedErrorfWithDetails("sharing job %s failed", details, jobID)
+	}
+	code := jsonErrorCodeDropboxAPIError
+	if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+		code = mapped
+	}
+	details = mergeJSONErrorDetails(details, map[string]any{"api_summary": summary + "/"})
+	return newCodedError(code, fmt.Errorf("sharing job %s failed: %s", jobID, summary), details)
+}
+
+// removeFolderMemberJobError maps a failed remove-member job to a coded error.
+func removeFolderMemberJobError(jobID string, failure *sharing.RemoveFolderMemberError, details map[string]any) error {
+	if failure == nil {
+		return commandFailedErrorfWithDetails("remove member job %s failed", details, jobID)
+	}
+	summary := failure.Tag
+	switch {
+	case failure.AccessError != nil:
+		summary += "/" + failure.AccessError.Tag
+	case failure.MemberError != nil:
+		summary += "/" + failure.MemberError.Tag
+	}
+	return sharingJobError(jobID, summary, details)
+}
+
+func init() {
+	shareCmd.AddCommand(shareFolderCmd)
+}
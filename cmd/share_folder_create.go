@@ -0,0 +1,174 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationCreate = "share_folder_create"
+
+type shareFolderCreateInput struct {
+	Path             string `json:"path"`
+	MemberPolicy     string `json:"member_policy,omitempty"`
+	AclUpdatePolicy  string `json:"acl_update_policy,omitempty"`
+	SharedLinkPolicy string `json:"shared_link_policy,omitempty"`
+	ViewerInfoPolicy string `json:"viewer_info_policy,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
+}
+
+func shareFolderCreate(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder create` requires a `path` argument", argumentErrorDetails("path"))
+	}
+	dropboxPath, err := validatePath(args[0])
+	if err != nil {
+		return err
+	}
+	if dropboxPath == "" {
+		return invalidArgumentsErrorWithDetails("cannot share Dropbox root", mergeJSONErrorDetails(operationErrorDetails(shareFolderOperationCreate), pathErrorDetails("/")))
+	}
+	policies, err := parseShareFolderPolicyFlags(cmd)
+	if err != nil {
+		return err
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderCreateInput{
+		Path:             dropboxPath,
+		MemberPolicy:     policies.memberPolicy,
+		AclUpdatePolicy:  policies.aclUpdatePolicy,
+		SharedLinkPolicy: policies.sharedLinkPolicy,
+		ViewerInfoPolicy: policies.viewerInfoPolicy,
+		DryRun:           dryRun,
+	}
+	if dryRun {
+		planned := shareFolderJSONMetadata{
+			Type:      shareFolderJSONKindFolder,
+			Name:      path.Base(dropboxPath),
+			PathLower: strings.ToLower(dropboxPath),
+		}
+		if !policies.empty() {
+			planned.Policy = policies.applyTo(nil)
+		}
+		return renderOperation(cmd, input, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, shareFolderJSONKindFolder, input, planned),
+		}, nil, func(w io.Writer) error {
+			return writeDryRunLine(w, "share folder", dropboxPath)
+		})
+	}
+
+	arg := sharing.NewShareFolderArg(dropboxPath)
+	arg.ForceAsync = true
+	arg.MemberPolicy = policies.memberPolicyArg()
+	arg.AclUpdatePolicy = policies.aclUpdatePolicyArg()
+	arg.SharedLinkPolicy = policies.sharedLinkPolicyArg()
+	arg.ViewerInfoPolicy = policies.viewerInfoPolicyArg()
+
+	details := mergeJSONErrorDetails(operationErrorDetails(shareFolderOperationCreate), pathErrorDetails(dropboxPath))
+	dbx := newSharedFolderClient(config)
+	launch, err := dbx.ShareFolderContext(currentContext(), arg)
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+	folder := launch.Complete
+	if launch.Tag == sharing.ShareFolderLaunchAsyncJobId {
+		folder, err = waitForShareFolderJob(dbx, launch.AsyncJobId, details)
+		if err != nil {
+			return err
+		}
+	}
+	if folder == nil {
+		return commandFailedErrorfWithDetails("share %s: Dropbox returned no shared folder metadata", details, dropboxPath)
+	}
+
+	commandVerboseStatus(cmd, "Shared %s as shared folder %s", dropboxPath, folder.SharedFolderId)
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(shareFolderStatusShared, shareFolderJSONKindFolder, input, shareFolderJSONMetadataFromDropbox(folder)),
+	}, nil, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, folder.SharedFolderId)
+		return err
+	})
+}
+
+// waitForShareFolderJob polls check_share_job_status until Dropbox finishes
+// sharing a folder.
+func waitForShareFolderJob(dbx sharedFolderClient, jobID string, details map[string]any) (*sharing.SharedFolderMetadata, error) {
+	details = mergeJSONErrorDetails(details, map[string]any{"async_job_id": jobID})
+	for {
+		if err := retrySleep(currentContext(), shareFolderPollInterval); err != nil {
+			return nil, withJSONErrorDetails(fmt.Errorf("wait for share job %s: %w", jobID, err), details)
+		}
+
+		var job *sharing.ShareFolderJobStatus
+		err := retryWithBackoff(func() error {
+			var err error
+			job, err = dbx.CheckShareJobStatusContext(currentContext(), async.NewPollArg(jobID))
+			return err
+		})
+		if err != nil {
+			return nil, withJSONErrorDetails(err, details)
+		}
+
+		switch job.Tag {
+		case sharing.ShareFolderJobStatusInProgress:
+			continue
+		case sharing.ShareFolderJobStatusComplete:
+			return job.Complete, nil
+		case sharing.ShareFolderJobStatusFailed:
+			summary := ""
+			if job.Failed != nil {
+				summary = job.Failed.Tag
+				if job.Failed.BadPath != nil {
+					summary += "/" + job.Failed.BadPath.Tag
+				}
+			}
+			return nil, sharingJobError(jobID, summary, details)
+		default:
+			return nil, commandFailedErrorfWithDetails("share job %s: Dropbox returned an unexpected status %q", details, jobID, job.Tag)
+		}
+	}
+}
+
+var shareFolderCreateCmd = &cobra.Command{
+	Use:   "create [flags] <path>",
+	Short: "Share a folder",
+	Long: `Turn a Dropbox folder into a shared folder, creating the folder if it does
+not exist. The command waits for Dropbox to finish sharing and prints the new
+shared folder ID.
+
+Policies default to the Dropbox defaults for your account or team; set them
+now with the policy flags or later with share folder update-policy.`,
+	Example: `  dbxcli share folder create /Projects
+  dbxcli share folder create --acl-update-policy owner --shared-link-policy members /Projects`,
+	RunE: shareFolderCreate,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderCreateCmd)
+	addShareFolderPolicyFlags(shareFolderCreateCmd)
+	addDryRunFlag(shareFolderCreateCmd)
+	enableStructuredOutput(shareFolderCreateCmd)
+}
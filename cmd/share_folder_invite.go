@@ -0,0 +1,127 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationInvite = "share_folder_invite"
+
+func shareFolderInvite(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`share folder invite` requires a `folder` and at least one `member` argument", argumentsErrorDetails("folder", "member"))
+	}
+	folder, memberArgs := args[0], args[1:]
+	accessLevel, err := parseShareFolderAccessLevel(cmd)
+	if err != nil {
+		return err
+	}
+	selectors := make([]*sharing.MemberSelector, 0, len(memberArgs))
+	kinds := make([]string, 0, len(memberArgs))
+	for _, value := range memberArgs {
+		selector, kind, err := parseShareFolderMember(value)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, selector)
+		kinds = append(kinds, kind)
+	}
+	message, _ := cmd.Flags().GetString("message")
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+	input := shareFolderMembershipInput{
+		Folder:         folder,
+		SharedFolderID: sharedFolderID,
+		Members:        memberArgs,
+		AccessLevel:    accessLevel.Tag,
+		Message:        message,
+		Quiet:          quiet,
+		DryRun:         dryRun,
+	}
+
+	if !dryRun {
+		members := make([]*sharing.AddMember, 0, len(selectors))
+		for _, selector := range selectors {
+			member := sharing.NewAddMember(selector)
+			member.AccessLevel = accessLevel
+			members = append(members, member)
+		}
+		arg := sharing.NewAddFolderMemberArg(sharedFolderID, members)
+		arg.CustomMessage = message
+		arg.Quiet = quiet
+
+		dbx := newSharedFolderClient(config)
+		if err := dbx.AddFolderMemberContext(currentContext(), arg); err != nil {
+			return withJSONErrorDetails(err, shareFolderFolderDetails(shareFolderOperationInvite, folder))
+		}
+		commandVerboseStatus(cmd, "Invited %d members to %s", len(members), folder)
+	}
+
+	results := make([]jsonOperationResult, 0, len(memberArgs))
+	for i, value := range memberArgs {
+		results = append(results, newJSONOperationResult(
+			plannedStatus(dryRun, shareFolderMemberStatusInvited),
+			kinds[i],
+			shareFolderMemberInput{Member: value, DryRun: dryRun},
+			shareFolderMemberChange(sharedFolderID, accessLevel.Tag, nil),
+		))
+	}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		for _, value := range memberArgs {
+			if _, err := fmt.Fprintf(w, "Would invite %s to %s as %s\n", value, folder, accessLevel.Tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var shareFolderInviteCmd = &cobra.Command{
+	Use:   "invite [flags] <folder> <member>...",
+	Short: "Invite members to a shared folder",
+	Long: `Invite users or groups to a shared folder.
+
+Members are email addresses, account IDs (dbid:...), team member IDs
+(dbmid:...), or group IDs (g:...). Invited users receive an email and device
+notification unless --quiet is set.`,
+	Example: `  dbxcli share folder invite /Projects alice@example.com bob@example.com
+  dbxcli share folder invite --access editor --message "Welcome aboard" /Projects g:1234567890abcdef`,
+	RunE: shareFolderInvite,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderInviteCmd)
+	shareFolderInviteCmd.Flags().String("access", sharing.AccessLevelViewer, "Access level to grant: viewer, editor, or viewer_no_comment")
+	shareFolderInviteCmd.Flags().String("message", "", "Custom message to include in the invitation")
+	shareFolderInviteCmd.Flags().Bool("quiet", false, "Do not notify invited members")
+	addDryRunFlag(shareFolderInviteCmd)
+	enableStructuredOutput(shareFolderInviteCmd)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationLeave = "share_folder_leave"
+
+func shareFolderLeave(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder leave` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+	folder := args[0]
+	keepCopy, _ := cmd.Flags().GetBool("keep-copy")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderMountInput{Folder: folder, SharedFolderID: sharedFolderID, KeepCopy: keepCopy, DryRun: dryRun}
+	if !dryRun {
+		details := shareFolderFolderDetails(shareFolderOperationLeave, folder)
+		arg := sharing.NewRelinquishFolderMembershipArg(sharedFolderID)
+		arg.LeaveACopy = keepCopy
+
+		dbx := newSharedFolderClient(config)
+		launch, err := dbx.RelinquishFolderMembershipContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+			if err := waitForSharingJob(dbx, launch.AsyncJobId, details); err != nil {
+				return err
+			}
+		}
+		commandVerboseStatus(cmd, "Left %s", folder)
+	}
+
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(plannedStatus(dryRun, shareFolderStatusLeft), shareFolderJSONKindFolder, input, shareFolderRefJSON{SharedFolderID: sharedFolderID}),
+	}, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		return writeDryRunLine(w, "leave shared folder", folder)
+	})
+}
+
+var shareFolderLeaveCmd = &cobra.Command{
+	Use:   "leave [flags] <folder>",
+	Short: "Give up membership of a shared folder",
+	Long: `Leave a shared folder, giving up your membership. With --keep-copy, a copy
+of the folder's contents stays in your Dropbox; Dropbox rejects --keep-copy
+for folders inside a team folder or another shared folder. Owners cannot
+leave; use share folder unshare instead.`,
+	Example: `  dbxcli share folder leave /Projects
+  dbxcli share folder leave --keep-copy 84528192421`,
+	RunE: shareFolderLeave,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderLeaveCmd)
+	shareFolderLeaveCmd.Flags().Bool("keep-copy", false, "Keep a copy of the folder's contents")
+	addDryRunFlag(shareFolderLeaveCmd)
+	enableStructuredOutput(shareFolderLeaveCmd)
+}
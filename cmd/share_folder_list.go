@@ -0,0 +1,76 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationList = "share_folder_list"
+
+type shareFolderListFoldersInput struct {
+	Mountable bool `json:"mountable"`
+}
+
+func shareFolderList(cmd *cobra.Command, args []string) error {
+	mountable, _ := cmd.Flags().GetBool("mountable")
+	long, _ := cmd.Flags().GetBool("long")
+
+	dbx := newSharedFolderClient(config)
+	list, render := listSharedFolders, renderSharedFolders
+	if mountable {
+		list, render = listMountableSharedFolders, renderMountableSharedFolders
+	}
+	entries, err := list(dbx, sharing.NewListFoldersArgs())
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(shareFolderOperationList))
+	}
+
+	commandVerboseStatus(cmd, "Listed %d shared folders", len(entries))
+	return renderSharedFolderList(cmd, shareFolderListFoldersInput{Mountable: mountable}, entries, long, render)
+}
+
+// renderMountableSharedFolders prints the ID and name of each folder, since
+// a folder that is not mounted has no path.
+func renderMountableSharedFolders(out io.Writer, entries []*sharing.SharedFolderMetadata) error {
+	for _, f := range entries {
+		if _, err := fmt.Fprintf(out, "%s\t%s\n", f.SharedFolderId, f.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var shareFolderListCmd = &cobra.Command{
+	Use:   "list [flags]",
+	Short: "List shared folders",
+	Long: `List the shared folders you are a member of. With --mountable, list the
+shared folders you have been invited to but not yet mounted, with the IDs to
+pass to share folder mount.`,
+	Example: `  dbxcli share folder list
+  dbxcli share folder list --mountable`,
+	RunE: shareFolderList,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderListCmd)
+	shareFolderListCmd.Flags().Bool("mountable", false, "List folders you were invited to but have not mounted")
+	shareFolderListCmd.Flags().BoolP("long", "l", false, "Show access levels and folder policies")
+	enableStructuredOutput(shareFolderListCmd)
+}
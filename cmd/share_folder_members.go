@@ -0,0 +1,163 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationMembers = "share_folder_members"
+
+type shareFolderMembersInput struct {
+	Folder         string `json:"folder"`
+	SharedFolderID string `json:"shared_folder_id"`
+}
+
+// shareFolderMemberJSON is one user, group, or invitee with access to a
+// shared folder. Users and invitees are identified by email and, once they
+// have an account, account ID; groups by group ID and name.
+type shareFolderMemberJSON struct {
+	Type         string `json:"type"`
+	AccessType   string `json:"access_type,omitempty"`
+	IsInherited  bool   `json:"is_inherited"`
+	AccountID    string `json:"account_id,omitempty"`
+	TeamMemberID string `json:"team_member_id,omitempty"`
+	Email        string `json:"email,omitempty"`
+	DisplayName  string `json:"display_name,omitempty"`
+	SameTeam     bool   `json:"same_team"`
+	GroupID      string `json:"group_id,omitempty"`
+	GroupName    string `json:"group_name,omitempty"`
+	MemberCount  uint32 `json:"member_count,omitempty"`
+}
+
+func shareFolderMembers(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder members` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+	folder := args[0]
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+
+	dbx := newSharedFolderClient(config)
+	members, err := listSharedFolderMembers(dbx, sharedFolderID)
+	if err != nil {
+		return withJSONErrorDetails(err, shareFolderFolderDetails(shareFolderOperationMembers, folder))
+	}
+
+	commandVerboseStatus(cmd, "Listed %d members of %s", len(members), folder)
+	results := make([]jsonOperationResult, 0, len(members))
+	for _, member := range members {
+		results = append(results, newJSONOperationResult(shareFolderMemberStatusListed, member.Type, nil, member))
+	}
+	input := shareFolderMembersInput{Folder: folder, SharedFolderID: sharedFolderID}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		for _, member := range members {
+			name := member.DisplayName
+			if member.Type == shareFolderMemberKindGroup {
+				name = member.GroupName
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", member.Type, member.AccessType, name, member.Email); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// listSharedFolderMembers returns the users, groups, and invitees of a shared
+// folder, following the list_folder_members cursor.
+func listSharedFolderMembers(dbx sharedFolderClient, sharedFolderID string) ([]shareFolderMemberJSON, error) {
+	res, err := dbx.ListFolderMembersContext(currentContext(), sharing.NewListFolderMembersArgs(sharedFolderID))
+	if err != nil {
+		return nil, err
+	}
+	members := appendSharedFolderMembers(nil, res)
+	for res.Cursor != "" {
+		res, err = dbx.ListFolderMembersContinueContext(currentContext(), sharing.NewListFolderMembersContinueArg(res.Cursor))
+		if err != nil {
+			return nil, err
+		}
+		members = appendSharedFolderMembers(members, res)
+	}
+	return members, nil
+}
+
+func appendSharedFolderMembers(members []shareFolderMemberJSON, res *sharing.SharedFolderMembers) []shareFolderMemberJSON {
+	for _, user := range res.Users {
+		member := shareFolderMemberFromMembership(shareFolderMemberKindUser, user.MembershipInfo)
+		setShareFolderMemberUser(&member, user.User)
+		members = append(members, member)
+	}
+	for _, group := range res.Groups {
+		member := shareFolderMemberFromMembership(shareFolderMemberKindGroup, group.MembershipInfo)
+		if group.Group != nil {
+			member.GroupID = group.Group.GroupId
+			member.GroupName = group.Group.GroupName
+			member.MemberCount = group.Group.MemberCount
+			member.SameTeam = group.Group.SameTeam
+		}
+		members = append(members, member)
+	}
+	for _, invitee := range res.Invitees {
+		member := shareFolderMemberFromMembership(shareFolderMemberKindInvitee, invitee.MembershipInfo)
+		setShareFolderMemberUser(&member, invitee.User)
+		if invitee.Invitee != nil && invitee.Invitee.Email != "" {
+			member.Email = invitee.Invitee.Email
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+func shareFolderMemberFromMembership(kind string, info sharing.MembershipInfo) shareFolderMemberJSON {
+	member := shareFolderMemberJSON{Type: kind, IsInherited: info.IsInherited}
+	if info.AccessType != nil {
+		member.AccessType = info.AccessType.Tag
+	}
+	return member
+}
+
+func setShareFolderMemberUser(member *shareFolderMemberJSON, user *sharing.UserInfo) {
+	if user == nil {
+		return
+	}
+	member.AccountID = user.AccountId
+	member.TeamMemberID = user.TeamMemberId
+	member.Email = user.Email
+	member.DisplayName = user.DisplayName
+	member.SameTeam = user.SameTeam
+}
+
+var shareFolderMembersCmd = &cobra.Command{
+	Use:   "members <folder>",
+	Short: "List the members of a shared folder",
+	Long: `List the users, groups, and pending invitees of a shared folder with their
+access levels. Text output prints one tab-separated line per member: type,
+access level, name, and email.`,
+	Example: `  dbxcli share folder members /Projects
+  dbxcli share folder members 84528192421 --output=json`,
+	RunE: shareFolderMembers,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderMembersCmd)
+	enableStructuredOutput(shareFolderMembersCmd)
+}
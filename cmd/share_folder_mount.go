@@ -0,0 +1,136 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationMount = "share_folder_mount"
+
+func shareFolderMount(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder mount` requires a `shared-folder-id` argument", argumentErrorDetails("shared-folder-id"))
+	}
+	sharedFolderID := args[0]
+	if !isSharedFolderID(sharedFolderID) {
+		return invalidArgumentsErrorfWithDetails("invalid shared folder ID %q: use an ID from share folder list --mountable", mergeJSONErrorDetails(argumentErrorDetails("shared-folder-id"), map[string]any{"value": sharedFolderID}), sharedFolderID)
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderMountInput{Folder: sharedFolderID, SharedFolderID: sharedFolderID, DryRun: dryRun}
+	if dryRun {
+		return renderOperation(cmd, input, []jsonOperationResult{
+			newJSONOperationResult(jsonStatusPlanned, shareFolderJSONKindFolder, input, shareFolderJSONMetadata{Type: shareFolderJSONKindFolder, SharedFolderID: sharedFolderID}),
+		}, nil, func(w io.Writer) error {
+			return writeDryRunLine(w, "mount shared folder", sharedFolderID)
+		})
+	}
+
+	dbx := newSharedFolderClient(config)
+	folder, err := dbx.MountFolderContext(currentContext(), sharing.NewMountFolderArg(sharedFolderID))
+	if err != nil {
+		return mountFolderError(dbx, sharedFolderID, err)
+	}
+
+	metadata := shareFolderJSONMetadataFromDropbox(folder)
+	commandVerboseStatus(cmd, "Mounted shared folder %s at %s", sharedFolderID, metadata.PathLower)
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(shareFolderStatusMounted, shareFolderJSONKindFolder, input, metadata),
+	}, nil, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, metadata.PathLower)
+		return err
+	})
+}
+
+// mountFolderError maps a mount_folder failure to a coded error. Mount
+// conflicts report as path conflicts; when the folder is already mounted the
+// details carry the path it is mounted at.
+func mountFolderError(dbx sharedFolderClient, sharedFolderID string, err error) error {
+	details := mergeJSONErrorDetails(operationErrorDetails(shareFolderOperationMount), map[string]any{"shared_folder_id": sharedFolderID})
+
+	var endpointErr *sharing.MountFolderError
+	var apiErrPtr *sharing.MountFolderAPIError
+	var apiErr sharing.MountFolderAPIError
+	switch {
+	case errors.As(err, &apiErrPtr) && apiErrPtr != nil:
+		endpointErr = apiErrPtr.EndpointError
+	case errors.As(err, &apiErr):
+		endpointErr = apiErr.EndpointError
+	}
+	if endpointErr == nil {
+		return withJSONErrorDetails(err, details)
+	}
+
+	summary := endpointErr.Tag
+	code := jsonErrorCodeDropboxAPIError
+	reason := endpointErr.Tag
+	switch endpointErr.Tag {
+	case sharing.MountFolderErrorInsideSharedFolder:
+		code = jsonErrorCodePathConflict
+		reason = "its mount point is inside another shared folder"
+	case sharing.MountFolderErrorAlreadyMounted:
+		code = jsonErrorCodePathConflict
+		reason = "it is already mounted"
+		if current, err := dbx.GetFolderMetadataContext(currentContext(), sharing.NewGetMetadataArgs(sharedFolderID)); err == nil && current.PathLower != "" {
+			details = mergeJSONErrorDetails(details, pathErrorDetails(current.PathLower))
+			reason = "it is already mounted at " + current.PathLower
+		}
+	case sharing.MountFolderErrorInsufficientQuota:
+		if quota := endpointErr.InsufficientQuota; quota != nil {
+			reason = fmt.Sprintf("it needs %s more space than is available", humanize.IBytes(quota.SpaceShortage))
+		}
+	case sharing.MountFolderErrorNoPermission:
+		code = jsonErrorCodePermissionDenied
+	case sharing.MountFolderErrorAccessError:
+		if endpointErr.AccessError != nil {
+			summary += "/" + endpointErr.AccessError.Tag
+			reason = endpointErr.AccessError.Tag
+		}
+		if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+			code = mapped
+		}
+	}
+	details["api_summary"] = summary + "/"
+
+	return newCodedError(code, fmt.Errorf("mount shared folder %s: %s", sharedFolderID, reason), details)
+}
+
+var shareFolderMountCmd = &cobra.Command{
+	Use:   "mount [flags] <shared-folder-id>",
+	Short: "Accept a shared folder invitation",
+	Long: `Mount a shared folder you have been invited to, adding it to your Dropbox.
+This accepts the invitation without the web UI. Find the IDs of pending
+invitations with share folder list --mountable. The command prints the path
+the folder was mounted at.`,
+	Example: `  dbxcli share folder list --mountable
+  dbxcli share folder mount 84528192421`,
+	RunE: shareFolderMount,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderMountCmd)
+	addDryRunFlag(shareFolderMountCmd)
+	enableStructuredOutput(shareFolderMountCmd)
+}
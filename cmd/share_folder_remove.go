@@ -0,0 +1,153 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationRemove = "share_folder_remove"
+
+func shareFolderRemove(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`share folder remove` requires a `folder` and at least one `member` argument", argumentsErrorDetails("folder", "member"))
+	}
+	folder, memberArgs := args[0], args[1:]
+	selectors := make([]*sharing.MemberSelector, 0, len(memberArgs))
+	kinds := make([]string, 0, len(memberArgs))
+	for _, value := range memberArgs {
+		selector, kind, err := parseShareFolderMember(value)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, selector)
+		kinds = append(kinds, kind)
+	}
+	leaveACopy, _ := cmd.Flags().GetBool("leave-a-copy")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+	input := shareFolderMembershipInput{
+		Folder:         folder,
+		SharedFolderID: sharedFolderID,
+		Members:        memberArgs,
+		LeaveACopy:     leaveACopy,
+		DryRun:         dryRun,
+	}
+
+	dbx := newSharedFolderClient(config)
+	results := make([]jsonOperationResult, 0, len(memberArgs))
+	removed := make([]string, 0, len(memberArgs))
+	changes := make([]shareFolderMemberChangeJSON, 0, len(memberArgs))
+	var warnings []jsonWarning
+	var failures []error
+	for i, value := range memberArgs {
+		var res *sharing.MemberAccessLevelResult
+		if !dryRun {
+			res, err = removeSharedFolderMember(dbx, sharedFolderID, selectors[i], leaveACopy, shareFolderFolderDetails(shareFolderOperationRemove, folder))
+			if err != nil {
+				failures = append(failures, err)
+				warnings = append(warnings, jsonWarning{Code: jsonWarningCodeMemberRemoveFailed, Message: fmt.Sprintf("remove %s from %s: %v", value, folder, err)})
+				continue
+			}
+			commandVerboseStatus(cmd, "Removed %s from %s", value, folder)
+		}
+		change := shareFolderMemberChange(sharedFolderID, "", res)
+		removed = append(removed, value)
+		changes = append(changes, change)
+		results = append(results, newJSONOperationResult(
+			plannedStatus(dryRun, shareFolderMemberStatusRemoved),
+			kinds[i],
+			shareFolderMemberInput{Member: value, DryRun: dryRun},
+			change,
+		))
+	}
+	if len(failures) == len(memberArgs) {
+		return batchFailuresError(shareFolderOperationRemove, failures)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+	return renderOperation(cmd, input, results, warnings, func(w io.Writer) error {
+		for i, member := range removed {
+			if dryRun {
+				if _, err := fmt.Fprintf(w, "Would remove %s from %s\n", member, folder); err != nil {
+					return err
+				}
+				continue
+			}
+			if changes[i].InheritedAccess == "" {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s still has %s access to %s through a parent folder\n", member, changes[i].InheritedAccess, folder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// removeSharedFolderMember removes one member and waits for the async job
+// Dropbox starts to finish.
+func removeSharedFolderMember(dbx sharedFolderClient, sharedFolderID string, member *sharing.MemberSelector, leaveACopy bool, details map[string]any) (*sharing.MemberAccessLevelResult, error) {
+	if member.Email != "" {
+		details = mergeJSONErrorDetails(details, map[string]any{"email": member.Email})
+	}
+	launch, err := dbx.RemoveFolderMemberContext(currentContext(), sharing.NewRemoveFolderMemberArg(sharedFolderID, member, leaveACopy))
+	if err != nil {
+		return nil, withJSONErrorDetails(err, details)
+	}
+	if launch.Tag != async.LaunchResultBaseAsyncJobId || launch.AsyncJobId == "" {
+		return nil, commandFailedErrorfWithDetails("remove folder member: Dropbox returned an unexpected result %q", details, launch.Tag)
+	}
+	return waitForRemoveFolderMemberJob(dbx, launch.AsyncJobId, details)
+}
+
+var shareFolderRemoveCmd = &cobra.Command{
+	Use:   "remove [flags] <folder> <member>...",
+	Short: "Remove members from a shared folder",
+	Long: `Remove users, groups, or pending invitees from a shared folder.
+
+Members are email addresses, account IDs (dbid:...), team member IDs
+(dbmid:...), or group IDs (g:...). Dropbox removes members in the
+background; the command waits until each removal finishes. A member who
+still has access through a parent folder is reported. Members that cannot be
+removed are reported as warnings while the rest are removed; the command
+fails only when no member could be removed.`,
+	Example: `  dbxcli share folder remove /Projects alice@example.com
+  dbxcli share folder remove --leave-a-copy /Projects dbid:AAH4f99T0taONIb-OurWxbNQ6ywGRopQngc`,
+	RunE: shareFolderRemove,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderRemoveCmd)
+	shareFolderRemoveCmd.Flags().Bool("leave-a-copy", false, "Let removed users keep a copy of the folder")
+	addDryRunFlag(shareFolderRemoveCmd)
+	enableStructuredOutput(shareFolderRemoveCmd)
+}
@@ -0,0 +1,153 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationSetAccess = "share_folder_set_access"
+
+func shareFolderSetAccess(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`share folder set-access` requires a `folder` and at least one `member` argument", argumentsErrorDetails("folder", "member"))
+	}
+	folder, memberArgs := args[0], args[1:]
+	accessLevel, err := parseShareFolderAccessLevel(cmd)
+	if err != nil {
+		return err
+	}
+	selectors := make([]*sharing.MemberSelector, 0, len(memberArgs))
+	kinds := make([]string, 0, len(memberArgs))
+	for _, value := range memberArgs {
+		selector, kind, err := parseShareFolderMember(value)
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, selector)
+		kinds = append(kinds, kind)
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+	input := shareFolderMembershipInput{
+		Folder:         folder,
+		SharedFolderID: sharedFolderID,
+		Members:        memberArgs,
+		AccessLevel:    accessLevel.Tag,
+		DryRun:         dryRun,
+	}
+	details := shareFolderFolderDetails(shareFolderOperationSetAccess, folder)
+
+	dbx := newSharedFolderClient(config)
+	if err := resolveShareFolderMemberEmails(dbx, sharedFolderID, selectors, details); err != nil {
+		return err
+	}
+
+	results := make([]jsonOperationResult, 0, len(memberArgs))
+	for i, value := range memberArgs {
+		var res *sharing.MemberAccessLevelResult
+		if !dryRun {
+			res, err = dbx.UpdateFolderMemberContext(currentContext(), sharing.NewUpdateFolderMemberArg(sharedFolderID, selectors[i], accessLevel))
+			if err != nil {
+				return withJSONErrorDetails(fmt.Errorf("update %s: %w", value, err), details)
+			}
+			commandVerboseStatus(cmd, "Set %s access for %s on %s", accessLevel.Tag, value, folder)
+		}
+		results = append(results, newJSONOperationResult(
+			plannedStatus(dryRun, shareFolderMemberStatusUpdated),
+			kinds[i],
+			shareFolderMemberInput{Member: value, DryRun: dryRun},
+			shareFolderMemberChange(sharedFolderID, accessLevel.Tag, res),
+		))
+	}
+	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		for _, value := range memberArgs {
+			if _, err := fmt.Fprintf(w, "Would set %s access for %s on %s\n", accessLevel.Tag, value, folder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// resolveShareFolderMemberEmails replaces email selectors with the member's
+// account ID, because update_folder_member only accepts Dropbox IDs. Pending
+// invitees have no account yet, so their access cannot be changed.
+func resolveShareFolderMemberEmails(dbx sharedFolderClient, sharedFolderID string, selectors []*sharing.MemberSelector, details map[string]any) error {
+	var members []shareFolderMemberJSON
+	for _, selector := range selectors {
+		if selector.Tag != sharing.MemberSelectorEmail {
+			continue
+		}
+		if members == nil {
+			var err error
+			members, err = listSharedFolderMembers(dbx, sharedFolderID)
+			if err != nil {
+				return withJSONErrorDetails(err, details)
+			}
+		}
+		email := selector.Email
+		emailDetails := mergeJSONErrorDetails(details, map[string]any{"email": email})
+		accountID := ""
+		for _, member := range members {
+			if member.Type != shareFolderMemberKindUser || !strings.EqualFold(member.Email, email) {
+				continue
+			}
+			accountID = member.AccountID
+			break
+		}
+		if accountID == "" {
+			return newCodedError(jsonErrorCodeNotFound, fmt.Errorf("%s is not a member of shared folder %s; pending invitees must accept before their access can change", email, sharedFolderID), emailDetails)
+		}
+		*selector = sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorDropboxId}, DropboxId: accountID}
+	}
+	return nil
+}
+
+var shareFolderSetAccessCmd = &cobra.Command{
+	Use:   "set-access --access <level> <folder> <member>...",
+	Short: "Change the access level of shared folder members",
+	Long: `Change the access level of users or groups in a shared folder.
+
+Members are email addresses, account IDs (dbid:...), team member IDs
+(dbmid:...), or group IDs (g:...). Email addresses must belong to current
+members; pending invitees cannot be changed until they accept.`,
+	Example: `  dbxcli share folder set-access --access editor /Projects alice@example.com
+  dbxcli share folder set-access --access viewer --dry-run /Projects g:1234567890abcdef`,
+	RunE: shareFolderSetAccess,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderSetAccessCmd)
+	shareFolderSetAccessCmd.Flags().String("access", "", "New access level: viewer, editor, or viewer_no_comment")
+	addDryRunFlag(shareFolderSetAccessCmd)
+	enableStructuredOutput(shareFolderSetAccessCmd)
+}
@@ -0,0 +1,720 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_common"
+	"github.com/spf13/cobra"
+)
+
+func TestShareFolderMembersJSONListsUsersGroupsAndInvitees(t *testing.T) {
+	stubSharedFolderPath(t, "/Projects", "84528192421")
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFolderMembersFn: func(arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error) {
+			if arg.SharedFolderId != "84528192421" {
+				t.Fatalf("shared folder id = %q", arg.SharedFolderId)
+			}
+			return &sharing.SharedFolderMembers{
+				Users:  []*sharing.UserMembershipInfo{testShareFolderUser("dbid:alice", "alice@example.com", sharing.AccessLevelOwner)},
+				Groups: []*sharing.GroupMembershipInfo{testShareFolderGroup("g:design", "Design", sharing.AccessLevelEditor)},
+				Cursor: "cursor-1",
+			}, nil
+		},
+		listFolderMembersContinueFn: func(arg *sharing.ListFolderMembersContinueArg) (*sharing.SharedFolderMembers, error) {
+			if arg.Cursor != "cursor-1" {
+				t.Fatalf("continue cursor = %q", arg.Cursor)
+			}
+			invitee := &sharing.InviteeMembershipInfo{
+				MembershipInfo: sharing.MembershipInfo{AccessType: testAccessLevel(sharing.AccessLevelViewer)},
+				Invitee:        &sharing.InviteeInfo{Tagged: dropbox.Tagged{Tag: sharing.InviteeInfoEmail}, Email: "carol@example.com"},
+			}
+			return &sharing.SharedFolderMembers{Invitees: []*sharing.InviteeMembershipInfo{invitee}}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json"})
+	if err := shareFolderMembers(cmd, []string{"/Projects"}); err != nil {
+		t.Fatalf("share folder members error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[shareFolderMembersInput, shareFolderMemberJSON](t, stdout.Bytes())
+	if got.Input.Folder != "/Projects" || got.Input.SharedFolderID != "84528192421" {
+		t.Fatalf("input = %#v", got.Input)
+	}
+	if len(got.Results) != 3 {
+		t.Fatalf("results = %#v, want three members", got.Results)
+	}
+	user, group, invitee := got.Results[0], got.Results[1], got.Results[2]
+	if user.Kind != shareFolderMemberKindUser || user.Result.AccountID != "dbid:alice" || user.Result.AccessType != sharing.AccessLevelOwner {
+		t.Fatalf("user = %#v", user)
+	}
+	if group.Kind != shareFolderMemberKindGroup || group.Result.GroupID != "g:design" || group.Result.GroupName != "Design" || group.Result.MemberCount != 4 {
+		t.Fatalf("group = %#v", group)
+	}
+	if invitee.Kind != shareFolderMemberKindInvitee || invitee.Result.Email != "carol@example.com" || invitee.Status != shareFolderMemberStatusListed {
+		t.Fatalf("invitee = %#v", invitee)
+	}
+}
+
+func TestShareFolderMembersTextPrintsOneLinePerMember(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFolderMembersFn: func(arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error) {
+			return &sharing.SharedFolderMembers{
+				Users:  []*sharing.UserMembershipInfo{testShareFolderUser("dbid:alice", "alice@example.com", sharing.AccessLevelOwner)},
+				Groups: []*sharing.GroupMembershipInfo{testShareFolderGroup("g:design", "Design", sharing.AccessLevelEditor)},
+			}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(nil)
+	if err := shareFolderMembers(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder members error: %v", err)
+	}
+	want := "user\towner\tAlice\talice@example.com\ngroup\teditor\tDesign\t\n"
+	if stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareFolderMembersRejectsUnsharedFolder(t *testing.T) {
+	stubSharedFolderPath(t, "/Notes", "")
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderMembers(cmd, []string{"/Notes"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments || !strings.Contains(err.Error(), "not a shared folder") {
+		t.Fatalf("err = %v, want invalid_arguments for an unshared folder", err)
+	}
+}
+
+func TestShareFolderInviteAddsMembersWithAccess(t *testing.T) {
+	var got *sharing.AddFolderMemberArg
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		addFolderMemberFn: func(arg *sharing.AddFolderMemberArg) error {
+			got = arg
+			return nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "access": "editor", "message": "Welcome", "quiet": "true"})
+	if err := shareFolderInvite(cmd, []string{"84528192421", "bob@example.com", "g:design"}); err != nil {
+		t.Fatalf("share folder invite error: %v", err)
+	}
+
+	if got == nil || got.SharedFolderId != "84528192421" || got.CustomMessage != "Welcome" || !got.Quiet || len(got.Members) != 2 {
+		t.Fatalf("add folder member arg = %#v", got)
+	}
+	if got.Members[0].Member.Email != "bob@example.com" || got.Members[1].Member.DropboxId != "g:design" || got.Members[1].AccessLevel.Tag != sharing.AccessLevelEditor {
+		t.Fatalf("members = %#v, %#v", got.Members[0], got.Members[1])
+	}
+
+	out := decodeShareLinkOperationOutput[shareFolderMembershipInput, shareFolderMemberChangeJSON](t, stdout.Bytes())
+	if len(out.Results) != 2 || out.Results[0].Kind != shareFolderMemberKindUser || out.Results[1].Kind != shareFolderMemberKindGroup {
+		t.Fatalf("results = %#v", out.Results)
+	}
+	if out.Results[0].Status != shareFolderMemberStatusInvited || out.Results[0].Result.AccessLevel != sharing.AccessLevelEditor {
+		t.Fatalf("result = %#v", out.Results[0])
+	}
+}
+
+func TestShareFolderInviteDryRunDoesNotAddMembers(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		addFolderMemberFn: func(arg *sharing.AddFolderMemberArg) error {
+			t.Fatal("dry run added members")
+			return nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{"access": "viewer", dryRunFlagName: "true"})
+	if err := shareFolderInvite(cmd, []string{"84528192421", "bob@example.com"}); err != nil {
+		t.Fatalf("share folder invite error: %v", err)
+	}
+	if want := "Would invite bob@example.com to 84528192421 as viewer\n"; stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareFolderInviteRejectsInvalidMembersAndAccess(t *testing.T) {
+	cmd, _ := testShareFolderCmd(map[string]string{"access": "viewer"})
+	if err := shareFolderInvite(cmd, []string{"84528192421", "bob"}); err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for a bare name", err)
+	}
+	cmd, _ = testShareFolderCmd(map[string]string{"access": "owner"})
+	if err := shareFolderInvite(cmd, []string{"84528192421", "bob@example.com"}); err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for owner access", err)
+	}
+}
+
+func TestShareFolderRemoveWaitsForJob(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	polls := 0
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFolderMemberFn: func(arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error) {
+			if arg.Member.Email != "bob@example.com" || !arg.LeaveACopy {
+				t.Fatalf("remove arg = %#v", arg)
+			}
+			return &async.LaunchResultBase{Tagged: dropbox.Tagged{Tag: async.LaunchResultBaseAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkRemoveMemberJobStatusFn: func(arg *async.PollArg) (*sharing.RemoveMemberJobStatus, error) {
+			polls++
+			if arg.AsyncJobId != "job-1" {
+				t.Fatalf("poll job = %q", arg.AsyncJobId)
+			}
+			if polls == 1 {
+				return &sharing.RemoveMemberJobStatus{Tagged: dropbox.Tagged{Tag: sharing.RemoveMemberJobStatusInProgress}}, nil
+			}
+			return &sharing.RemoveMemberJobStatus{
+				Tagged:   dropbox.Tagged{Tag: sharing.RemoveMemberJobStatusComplete},
+				Complete: &sharing.MemberAccessLevelResult{AccessLevel: testAccessLevel(sharing.AccessLevelViewer)},
+			}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{"leave-a-copy": "true"})
+	if err := shareFolderRemove(cmd, []string{"84528192421", "bob@example.com"}); err != nil {
+		t.Fatalf("share folder remove error: %v", err)
+	}
+	if polls != 2 {
+		t.Fatalf("polls = %d, want 2", polls)
+	}
+	if want := "bob@example.com still has viewer access to 84528192421 through a parent folder\n"; stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareFolderRemoveJobFailureMapsErrorCode(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFolderMemberFn: func(arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error) {
+			return &async.LaunchResultBase{Tagged: dropbox.Tagged{Tag: async.LaunchResultBaseAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkRemoveMemberJobStatusFn: func(arg *async.PollArg) (*sharing.RemoveMemberJobStatus, error) {
+			return &sharing.RemoveMemberJobStatus{
+				Tagged: dropbox.Tagged{Tag: sharing.RemoveMemberJobStatusFailed},
+				Failed: &sharing.RemoveFolderMemberError{
+					Tagged:      dropbox.Tagged{Tag: sharing.RemoveFolderMemberErrorMemberError},
+					MemberError: &sharing.SharedFolderMemberError{Tagged: dropbox.Tagged{Tag: sharing.SharedFolderMemberErrorNotAMember}},
+				},
+			}, nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderRemove(cmd, []string{"84528192421", "dbid:bob"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeDropboxAPIError || !strings.Contains(err.Error(), "member_error/not_a_member") {
+		t.Fatalf("err = %v, want dropbox_api_error with the job failure", err)
+	}
+}
+
+func TestShareFolderRemoveReportsRemovedMembersAndWarnsOnFailures(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFolderMemberFn: func(arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error) {
+			if arg.Member.Email == "bob@example.com" {
+				return nil, errors.New("member_error/not_a_member/")
+			}
+			return &async.LaunchResultBase{Tagged: dropbox.Tagged{Tag: async.LaunchResultBaseAsyncJobId}, AsyncJobId: "job-" + arg.Member.Email}, nil
+		},
+		checkRemoveMemberJobStatusFn: func(arg *async.PollArg) (*sharing.RemoveMemberJobStatus, error) {
+			return &sharing.RemoveMemberJobStatus{
+				Tagged:   dropbox.Tagged{Tag: sharing.RemoveMemberJobStatusComplete},
+				Complete: &sharing.MemberAccessLevelResult{},
+			}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json"})
+	if err := shareFolderRemove(cmd, []string{"84528192421", "alice@example.com", "bob@example.com", "carol@example.com"}); err != nil {
+		t.Fatalf("share folder remove error: %v", err)
+	}
+	out := decodeShareLinkOperationOutputWithWarnings[shareFolderMembershipInput, shareFolderMemberChangeJSON](t, stdout.Bytes())
+	if len(out.Results) != 2 || out.Results[0].Status != shareFolderMemberStatusRemoved || out.Results[1].Status != shareFolderMemberStatusRemoved {
+		t.Fatalf("results = %+v, want alice and carol removed", out.Results)
+	}
+	if len(out.Warnings) != 1 || out.Warnings[0].Code != jsonWarningCodeMemberRemoveFailed || !strings.Contains(out.Warnings[0].Message, "bob@example.com") {
+		t.Fatalf("warnings = %+v, want member_remove_failed for bob", out.Warnings)
+	}
+}
+
+func TestShareFolderRemoveFailsWhenEveryMemberFails(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		removeFolderMemberFn: func(arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error) {
+			return nil, errors.New("member_error/not_a_member/")
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(nil)
+	err := shareFolderRemove(cmd, []string{"84528192421", "alice@example.com", "bob@example.com"})
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want both failures reported", err)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != shareFolderOperationRemove {
+		t.Fatalf("details = %#v, want share_folder_remove operation", details)
+	}
+	if stdout.String() != "" {
+		t.Fatalf("stdout = %q, want no output on error", stdout.String())
+	}
+}
+
+func TestShareFolderSetAccessResolvesEmailToAccountID(t *testing.T) {
+	var got *sharing.UpdateFolderMemberArg
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFolderMembersFn: func(arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error) {
+			return &sharing.SharedFolderMembers{
+				Users: []*sharing.UserMembershipInfo{testShareFolderUser("dbid:alice", "Alice@Example.com", sharing.AccessLevelViewer)},
+			}, nil
+		},
+		updateFolderMemberFn: func(arg *sharing.UpdateFolderMemberArg) (*sharing.MemberAccessLevelResult, error) {
+			got = arg
+			return &sharing.MemberAccessLevelResult{}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "access": "editor"})
+	if err := shareFolderSetAccess(cmd, []string{"84528192421", "alice@example.com"}); err != nil {
+		t.Fatalf("share folder set-access error: %v", err)
+	}
+	if got == nil || got.Member.DropboxId != "dbid:alice" || got.AccessLevel.Tag != sharing.AccessLevelEditor {
+		t.Fatalf("update arg = %#v", got)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderMembershipInput, shareFolderMemberChangeJSON](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderMemberStatusUpdated || out.Input.Members[0] != "alice@example.com" {
+		t.Fatalf("results = %#v", out.Results)
+	}
+}
+
+func TestShareFolderSetAccessRejectsUnknownEmailAndMissingAccess(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, _ := testShareFolderCmd(map[string]string{"access": "editor"})
+	err := shareFolderSetAccess(cmd, []string{"84528192421", "carol@example.com"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeNotFound {
+		t.Fatalf("err = %v, want not_found for a non-member email", err)
+	}
+
+	cmd, _ = testShareFolderCmd(nil)
+	err = shareFolderSetAccess(cmd, []string{"84528192421", "dbid:alice"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments || !strings.Contains(err.Error(), "--access") {
+		t.Fatalf("err = %v, want invalid_arguments for missing --access", err)
+	}
+}
+
+func TestShareFolderCreateWaitsForShareJob(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	polls := 0
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		shareFolderFn: func(arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error) {
+			if arg.Path != "/Projects" || !arg.ForceAsync || arg.AclUpdatePolicy == nil || arg.AclUpdatePolicy.Tag != sharing.AclUpdatePolicyOwner || arg.MemberPolicy != nil {
+				t.Fatalf("share arg = %#v", arg)
+			}
+			return &sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderLaunchAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkShareJobStatusFn: func(arg *async.PollArg) (*sharing.ShareFolderJobStatus, error) {
+			polls++
+			if polls == 1 {
+				return &sharing.ShareFolderJobStatus{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderJobStatusInProgress}}, nil
+			}
+			folder := testSharedFolder("/projects", "https://example.com/projects")
+			folder.SharedFolderId = "84528192421"
+			folder.Policy = &sharing.FolderPolicy{AclUpdatePolicy: &sharing.AclUpdatePolicy{Tagged: dropbox.Tagged{Tag: sharing.AclUpdatePolicyOwner}}}
+			return &sharing.ShareFolderJobStatus{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderJobStatusComplete}, Complete: folder}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "acl-update-policy": "owner"})
+	if err := shareFolderCreate(cmd, []string{"/Projects"}); err != nil {
+		t.Fatalf("share folder create error: %v", err)
+	}
+	if polls != 2 {
+		t.Fatalf("polls = %d, want 2", polls)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderCreateInput, shareFolderJSONMetadata](t, stdout.Bytes())
+	if out.Input.Path != "/Projects" || out.Input.AclUpdatePolicy != "owner" {
+		t.Fatalf("input = %#v", out.Input)
+	}
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusShared || out.Results[0].Result.SharedFolderID != "84528192421" {
+		t.Fatalf("results = %#v", out.Results)
+	}
+	if policy := out.Results[0].Result.Policy; policy == nil || policy.AclUpdatePolicy != "owner" {
+		t.Fatalf("policy = %#v, want owner acl update policy", policy)
+	}
+}
+
+func TestShareFolderCreateRejectsRootAndInvalidPolicy(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, _ := testShareFolderCmd(nil)
+	if err := shareFolderCreate(cmd, []string{"/"}); err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for the root", err)
+	}
+
+	cmd, _ = testShareFolderCmd(map[string]string{"shared-link-policy": "everyone"})
+	err := shareFolderCreate(cmd, []string{"/Projects"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments || !strings.Contains(err.Error(), "--shared-link-policy") {
+		t.Fatalf("err = %v, want invalid_arguments for --shared-link-policy", err)
+	}
+}
+
+func TestShareFolderUnshareWaitsForJob(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	stubSharedFolderPath(t, "/Projects", "84528192421")
+	polls := 0
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		unshareFolderFn: func(arg *sharing.UnshareFolderArg) (*async.LaunchEmptyResult, error) {
+			if arg.SharedFolderId != "84528192421" || !arg.LeaveACopy {
+				t.Fatalf("unshare arg = %#v", arg)
+			}
+			return &async.LaunchEmptyResult{Tagged: dropbox.Tagged{Tag: async.LaunchEmptyResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkJobStatusFn: func(arg *async.PollArg) (*sharing.JobStatus, error) {
+			polls++
+			if polls == 1 {
+				return &sharing.JobStatus{Tagged: dropbox.Tagged{Tag: sharing.JobStatusInProgress}}, nil
+			}
+			return &sharing.JobStatus{Tagged: dropbox.Tagged{Tag: sharing.JobStatusComplete}}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "leave-a-copy": "true"})
+	if err := shareFolderUnshare(cmd, []string{"/Projects"}); err != nil {
+		t.Fatalf("share folder unshare error: %v", err)
+	}
+	if polls != 2 {
+		t.Fatalf("polls = %d, want 2", polls)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderUnshareInput, shareFolderRefJSON](t, stdout.Bytes())
+	if !out.Input.LeaveACopy || len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusUnshared || out.Results[0].Result.SharedFolderID != "84528192421" {
+		t.Fatalf("output = %#v", out)
+	}
+}
+
+func TestShareFolderUnshareJobFailureMapsErrorCode(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		unshareFolderFn: func(arg *sharing.UnshareFolderArg) (*async.LaunchEmptyResult, error) {
+			return &async.LaunchEmptyResult{Tagged: dropbox.Tagged{Tag: async.LaunchEmptyResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkJobStatusFn: func(arg *async.PollArg) (*sharing.JobStatus, error) {
+			return &sharing.JobStatus{
+				Tagged: dropbox.Tagged{Tag: sharing.JobStatusFailed},
+				Failed: &sharing.JobError{
+					Tagged:             dropbox.Tagged{Tag: sharing.JobErrorUnshareFolderError},
+					UnshareFolderError: &sharing.UnshareFolderError{Tagged: dropbox.Tagged{Tag: sharing.UnshareFolderErrorNoPermission}},
+				},
+			}, nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderUnshare(cmd, []string{"84528192421"})
+	if err == nil || !strings.Contains(err.Error(), "unshare_folder_error/no_permission") {
+		t.Fatalf("err = %v, want the job failure", err)
+	}
+}
+
+func TestShareFolderUpdatePolicySendsOnlyGivenPolicies(t *testing.T) {
+	var got *sharing.UpdateFolderPolicyArg
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		updateFolderPolicyFn: func(arg *sharing.UpdateFolderPolicyArg) (*sharing.SharedFolderMetadata, error) {
+			got = arg
+			folder := testSharedFolder("/projects", "https://example.com/projects")
+			folder.Policy = &sharing.FolderPolicy{MemberPolicy: &sharing.MemberPolicy{Tagged: dropbox.Tagged{Tag: sharing.MemberPolicyTeam}}}
+			return folder, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "member-policy": "team"})
+	if err := shareFolderUpdatePolicy(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder update-policy error: %v", err)
+	}
+	if got == nil || got.SharedFolderId != "84528192421" || got.MemberPolicy == nil || got.MemberPolicy.Tag != sharing.MemberPolicyTeam {
+		t.Fatalf("update arg = %#v", got)
+	}
+	if got.AclUpdatePolicy != nil || got.SharedLinkPolicy != nil || got.ViewerInfoPolicy != nil {
+		t.Fatalf("update arg = %#v, want only member policy", got)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderUpdatePolicyInput, shareFolderJSONMetadata](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusUpdated || out.Results[0].Result.Policy.MemberPolicy != "team" {
+		t.Fatalf("results = %#v", out.Results)
+	}
+}
+
+func TestShareFolderUpdatePolicyDryRunShowsPlannedPolicies(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		getFolderMetadataFn: func(arg *sharing.GetMetadataArgs) (*sharing.SharedFolderMetadata, error) {
+			folder := testSharedFolder("/projects", "https://example.com/projects")
+			folder.Policy = &sharing.FolderPolicy{
+				AclUpdatePolicy:  &sharing.AclUpdatePolicy{Tagged: dropbox.Tagged{Tag: sharing.AclUpdatePolicyEditors}},
+				SharedLinkPolicy: &sharing.SharedLinkPolicy{Tagged: dropbox.Tagged{Tag: sharing.SharedLinkPolicyAnyone}},
+			}
+			return folder, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "acl-update-policy": "owner", dryRunFlagName: "true"})
+	if err := shareFolderUpdatePolicy(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder update-policy error: %v", err)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderUpdatePolicyInput, shareFolderJSONMetadata](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != jsonStatusPlanned {
+		t.Fatalf("results = %#v", out.Results)
+	}
+	if policy := out.Results[0].Result.Policy; policy.AclUpdatePolicy != "owner" || policy.SharedLinkPolicy != "anyone" {
+		t.Fatalf("policy = %#v, want owner acl update policy and unchanged shared link policy", policy)
+	}
+}
+
+func TestShareFolderUpdatePolicyRequiresAPolicyFlag(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderUpdatePolicy(cmd, []string{"84528192421"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments without a policy flag", err)
+	}
+}
+
+func TestShareFolderListMountableListsInvitations(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFoldersFn: func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
+			t.Fatal("unexpected ListFolders call")
+			return nil, nil
+		},
+		listMountableFoldersFn: func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
+			res := sharing.NewListFoldersResult([]*sharing.SharedFolderMetadata{testMountableFolder("84528192421", "Projects")})
+			res.Cursor = "cursor-1"
+			return res, nil
+		},
+		listMountableContinueFn: func(arg *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error) {
+			if arg.Cursor != "cursor-1" {
+				t.Fatalf("cursor = %q", arg.Cursor)
+			}
+			return sharing.NewListFoldersResult([]*sharing.SharedFolderMetadata{testMountableFolder("84528192422", "Design")}), nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{"mountable": "true"})
+	if err := shareFolderList(cmd, nil); err != nil {
+		t.Fatalf("share folder list error: %v", err)
+	}
+	if want := "84528192421\tProjects\n84528192422\tDesign\n"; stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+
+	cmd, stdout = testShareFolderCmd(map[string]string{outputFlag: "json", "mountable": "true"})
+	if err := shareFolderList(cmd, nil); err != nil {
+		t.Fatalf("share folder list error: %v", err)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderListFoldersInput, shareFolderJSONMetadata](t, stdout.Bytes())
+	if !out.Input.Mountable || len(out.Results) != 2 || out.Results[1].Result.SharedFolderID != "84528192422" {
+		t.Fatalf("output = %#v", out)
+	}
+}
+
+func TestShareFolderMountPrintsMountedPath(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		mountFolderFn: func(arg *sharing.MountFolderArg) (*sharing.SharedFolderMetadata, error) {
+			if arg.SharedFolderId != "84528192421" {
+				t.Fatalf("mount arg = %#v", arg)
+			}
+			folder := testSharedFolder("/projects", "https://example.com/projects")
+			folder.SharedFolderId = "84528192421"
+			return folder, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(nil)
+	if err := shareFolderMount(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder mount error: %v", err)
+	}
+	if stdout.String() != "/projects\n" {
+		t.Fatalf("stdout = %q, want mounted path", stdout.String())
+	}
+
+	cmd, stdout = testShareFolderCmd(map[string]string{outputFlag: "json"})
+	if err := shareFolderMount(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder mount error: %v", err)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderMountInput, shareFolderJSONMetadata](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusMounted || out.Results[0].Result.PathLower != "/projects" {
+		t.Fatalf("results = %#v", out.Results)
+	}
+}
+
+func TestShareFolderMountConflictsReportDetails(t *testing.T) {
+	tag := sharing.MountFolderErrorAlreadyMounted
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		mountFolderFn: func(arg *sharing.MountFolderArg) (*sharing.SharedFolderMetadata, error) {
+			return nil, sharing.MountFolderAPIError{
+				APIError:      dropbox.APIError{ErrorSummary: tag + "/"},
+				EndpointError: &sharing.MountFolderError{Tagged: dropbox.Tagged{Tag: tag}},
+			}
+		},
+		getFolderMetadataFn: func(arg *sharing.GetMetadataArgs) (*sharing.SharedFolderMetadata, error) {
+			return testSharedFolder("/projects (1)", ""), nil
+		},
+	})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderMount(cmd, []string{"84528192421"})
+	details := jsonErrorDetails(err)
+	if jsonErrorCode(err) != jsonErrorCodePathConflict || details["path"] != "/projects (1)" || details["shared_folder_id"] != "84528192421" || details["api_summary"] != "already_mounted/" {
+		t.Fatalf("err = %v, details = %#v, want path_conflict with the mounted path", err, details)
+	}
+
+	tag = sharing.MountFolderErrorInsideSharedFolder
+	err = shareFolderMount(cmd, []string{"84528192421"})
+	details = jsonErrorDetails(err)
+	if jsonErrorCode(err) != jsonErrorCodePathConflict || details["api_summary"] != "inside_shared_folder/" || details["operation"] != shareFolderOperationMount {
+		t.Fatalf("err = %v, details = %#v, want path_conflict for inside_shared_folder", err, details)
+	}
+}
+
+func TestShareFolderMountRejectsPaths(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, _ := testShareFolderCmd(nil)
+	err := shareFolderMount(cmd, []string{"/Projects"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for a path", err)
+	}
+}
+
+func TestShareFolderUnmountUsesResolvedID(t *testing.T) {
+	stubSharedFolderPath(t, "/Projects", "84528192421")
+	var got *sharing.UnmountFolderArg
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		unmountFolderFn: func(arg *sharing.UnmountFolderArg) error {
+			got = arg
+			return nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json"})
+	if err := shareFolderUnmount(cmd, []string{"/Projects"}); err != nil {
+		t.Fatalf("share folder unmount error: %v", err)
+	}
+	if got == nil || got.SharedFolderId != "84528192421" {
+		t.Fatalf("unmount arg = %#v", got)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderMountInput, shareFolderRefJSON](t, stdout.Bytes())
+	if len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusUnmounted {
+		t.Fatalf("results = %#v", out.Results)
+	}
+}
+
+func TestShareFolderLeaveKeepsCopyAndWaitsForJob(t *testing.T) {
+	stubShareFolderPollInterval(t)
+	polls := 0
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		relinquishFolderFn: func(arg *sharing.RelinquishFolderMembershipArg) (*async.LaunchEmptyResult, error) {
+			if arg.SharedFolderId != "84528192421" || !arg.LeaveACopy {
+				t.Fatalf("relinquish arg = %#v", arg)
+			}
+			return &async.LaunchEmptyResult{Tagged: dropbox.Tagged{Tag: async.LaunchEmptyResultAsyncJobId}, AsyncJobId: "job-1"}, nil
+		},
+		checkJobStatusFn: func(arg *async.PollArg) (*sharing.JobStatus, error) {
+			polls++
+			return &sharing.JobStatus{Tagged: dropbox.Tagged{Tag: sharing.JobStatusComplete}}, nil
+		},
+	})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{outputFlag: "json", "keep-copy": "true"})
+	if err := shareFolderLeave(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder leave error: %v", err)
+	}
+	if polls != 1 {
+		t.Fatalf("polls = %d, want 1", polls)
+	}
+	out := decodeShareLinkOperationOutput[shareFolderMountInput, shareFolderRefJSON](t, stdout.Bytes())
+	if !out.Input.KeepCopy || len(out.Results) != 1 || out.Results[0].Status != shareFolderStatusLeft {
+		t.Fatalf("output = %#v", out)
+	}
+}
+
+func TestShareFolderLeaveDryRunDoesNotRelinquish(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{})
+
+	cmd, stdout := testShareFolderCmd(map[string]string{dryRunFlagName: "true"})
+	if err := shareFolderLeave(cmd, []string{"84528192421"}); err != nil {
+		t.Fatalf("share folder leave error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "84528192421") {
+		t.Fatalf("stdout = %q, want dry-run line", stdout.String())
+	}
+}
+
+func testShareFolderCmd(flags map[string]string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "share folder"}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().String("access", "", "")
+	cmd.Flags().String("message", "", "")
+	cmd.Flags().Bool("quiet", false, "")
+	cmd.Flags().Bool("leave-a-copy", false, "")
+	cmd.Flags().Bool("keep-copy", false, "")
+	cmd.Flags().Bool("mountable", false, "")
+	cmd.Flags().Bool("long", false, "")
+	addShareFolderPolicyFlags(cmd)
+	addDryRunFlag(cmd)
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			panic(err)
+		}
+	}
+	return cmd, &stdout
+}
+
+func stubSharedFolderPath(t *testing.T, path, sharedFolderID string) {
+	t.Helper()
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			if arg.Path != path {
+				return nil, errors.New("unexpected path " + arg.Path)
+			}
+			folder := &files.FolderMetadata{Metadata: files.Metadata{Name: strings.TrimPrefix(path, "/"), PathDisplay: path}}
+			if sharedFolderID != "" {
+				folder.SharingInfo = &files.FolderSharingInfo{SharedFolderId: sharedFolderID}
+			}
+			return folder, nil
+		},
+	})
+}
+
+func stubShareFolderPollInterval(t *testing.T) {
+	t.Helper()
+	orig := shareFolderPollInterval
+	shareFolderPollInterval = 0
+	t.Cleanup(func() { shareFolderPollInterval = orig })
+}
+
+func testAccessLevel(tag string) *sharing.AccessLevel {
+	return &sharing.AccessLevel{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+func testShareFolderUser(accountID, email, access string) *sharing.UserMembershipInfo {
+	return &sharing.UserMembershipInfo{
+		MembershipInfo: sharing.MembershipInfo{AccessType: testAccessLevel(access)},
+		User:           &sharing.UserInfo{AccountId: accountID, Email: email, DisplayName: "Alice"},
+	}
+}
+
+func testShareFolderGroup(groupID, name, access string) *sharing.GroupMembershipInfo {
+	return &sharing.GroupMembershipInfo{
+		MembershipInfo: sharing.MembershipInfo{AccessType: testAccessLevel(access)},
+		Group:          &sharing.GroupInfo{GroupSummary: team_common.GroupSummary{GroupId: groupID, GroupName: name, MemberCount: 4}},
+	}
+}
+
+func testMountableFolder(sharedFolderID, name string) *sharing.SharedFolderMetadata {
+	return &sharing.SharedFolderMetadata{Name: name, SharedFolderId: sharedFolderID}
+}
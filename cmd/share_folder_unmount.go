@@ -0,0 +1,72 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationUnmount = "share_folder_unmount"
+
+func shareFolderUnmount(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder unmount` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+	folder := args[0]
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderMountInput{Folder: folder, SharedFolderID: sharedFolderID, DryRun: dryRun}
+	if !dryRun {
+		dbx := newSharedFolderClient(config)
+		if err := dbx.UnmountFolderContext(currentContext(), sharing.NewUnmountFolderArg(sharedFolderID)); err != nil {
+			return withJSONErrorDetails(err, shareFolderFolderDetails(shareFolderOperationUnmount, folder))
+		}
+		commandVerboseStatus(cmd, "Unmounted %s", folder)
+	}
+
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(plannedStatus(dryRun, shareFolderStatusUnmounted), shareFolderJSONKindFolder, input, shareFolderRefJSON{SharedFolderID: sharedFolderID}),
+	}, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		return writeDryRunLine(w, "unmount shared folder", folder)
+	})
+}
+
+var shareFolderUnmountCmd = &cobra.Command{
+	Use:   "unmount [flags] <folder>",
+	Short: "Remove a shared folder from your Dropbox and keep access",
+	Long: `Unmount a shared folder, removing it from your Dropbox while keeping your
+membership. Mount it again with share folder mount.`,
+	Example: `  dbxcli share folder unmount /Projects`,
+	RunE:    shareFolderUnmount,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderUnmountCmd)
+	addDryRunFlag(shareFolderUnmountCmd)
+	enableStructuredOutput(shareFolderUnmountCmd)
+}
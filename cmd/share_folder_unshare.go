@@ -0,0 +1,94 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationUnshare = "share_folder_unshare"
+
+type shareFolderUnshareInput struct {
+	Folder         string `json:"folder"`
+	SharedFolderID string `json:"shared_folder_id"`
+	LeaveACopy     bool   `json:"leave_a_copy"`
+	DryRun         bool   `json:"dry_run,omitempty"`
+}
+
+func shareFolderUnshare(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder unshare` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+	folder := args[0]
+	leaveACopy, _ := cmd.Flags().GetBool("leave-a-copy")
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderUnshareInput{Folder: folder, SharedFolderID: sharedFolderID, LeaveACopy: leaveACopy, DryRun: dryRun}
+	if !dryRun {
+		details := shareFolderFolderDetails(shareFolderOperationUnshare, folder)
+		arg := sharing.NewUnshareFolderArg(sharedFolderID)
+		arg.LeaveACopy = leaveACopy
+
+		dbx := newSharedFolderClient(config)
+		launch, err := dbx.UnshareFolderContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+			if err := waitForSharingJob(dbx, launch.AsyncJobId, details); err != nil {
+				return err
+			}
+		}
+		commandVerboseStatus(cmd, "Unshared %s", folder)
+	}
+
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(plannedStatus(dryRun, shareFolderStatusUnshared), shareFolderJSONKindFolder, input, shareFolderRefJSON{SharedFolderID: sharedFolderID}),
+	}, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		return writeDryRunLine(w, "unshare folder", folder)
+	})
+}
+
+var shareFolderUnshareCmd = &cobra.Command{
+	Use:   "unshare [flags] <folder>",
+	Short: "Stop sharing a folder",
+	Long: `Stop sharing a folder and remove every member's access. The owner keeps the
+folder. With --leave-a-copy, members also keep a copy in their Dropbox.
+The command waits for Dropbox to finish unsharing.`,
+	Example: `  dbxcli share folder unshare /Projects
+  dbxcli share folder unshare --leave-a-copy 84528192421`,
+	RunE: shareFolderUnshare,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderUnshareCmd)
+	shareFolderUnshareCmd.Flags().Bool("leave-a-copy", false, "Let members keep a copy of the folder")
+	addDryRunFlag(shareFolderUnshareCmd)
+	enableStructuredOutput(shareFolderUnshareCmd)
+}
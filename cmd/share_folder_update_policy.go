@@ -0,0 +1,118 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+const shareFolderOperationUpdatePolicy = "share_folder_update_policy"
+
+type shareFolderUpdatePolicyInput struct {
+	Folder           string `json:"folder"`
+	SharedFolderID   string `json:"shared_folder_id"`
+	MemberPolicy     string `json:"member_policy,omitempty"`
+	AclUpdatePolicy  string `json:"acl_update_policy,omitempty"`
+	SharedLinkPolicy string `json:"shared_link_policy,omitempty"`
+	ViewerInfoPolicy string `json:"viewer_info_policy,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
+}
+
+func shareFolderUpdatePolicy(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share folder update-policy` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+	folder := args[0]
+	policies, err := parseShareFolderPolicyFlags(cmd)
+	if err != nil {
+		return err
+	}
+	if policies.empty() {
+		return invalidArgumentsErrorWithDetails("`share folder update-policy` requires at least one policy flag", flagsErrorDetails("member-policy", "acl-update-policy", "shared-link-policy", "viewer-info-policy"))
+	}
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	sharedFolderID, err := resolveSharedFolderID(folder)
+	if err != nil {
+		return err
+	}
+
+	input := shareFolderUpdatePolicyInput{
+		Folder:           folder,
+		SharedFolderID:   sharedFolderID,
+		MemberPolicy:     policies.memberPolicy,
+		AclUpdatePolicy:  policies.aclUpdatePolicy,
+		SharedLinkPolicy: policies.sharedLinkPolicy,
+		ViewerInfoPolicy: policies.viewerInfoPolicy,
+		DryRun:           dryRun,
+	}
+	details := shareFolderFolderDetails(shareFolderOperationUpdatePolicy, folder)
+	dbx := newSharedFolderClient(config)
+
+	var metadata shareFolderJSONMetadata
+	if dryRun {
+		current, err := dbx.GetFolderMetadataContext(currentContext(), sharing.NewGetMetadataArgs(sharedFolderID))
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		metadata = shareFolderJSONMetadataFromDropbox(current)
+		metadata.Policy = policies.applyTo(metadata.Policy)
+	} else {
+		arg := sharing.NewUpdateFolderPolicyArg(sharedFolderID)
+		arg.MemberPolicy = policies.memberPolicyArg()
+		arg.AclUpdatePolicy = policies.aclUpdatePolicyArg()
+		arg.SharedLinkPolicy = policies.sharedLinkPolicyArg()
+		arg.ViewerInfoPolicy = policies.viewerInfoPolicyArg()
+		updated, err := dbx.UpdateFolderPolicyContext(currentContext(), arg)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		metadata = shareFolderJSONMetadataFromDropbox(updated)
+		commandVerboseStatus(cmd, "Updated policies of %s", folder)
+	}
+
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(plannedStatus(dryRun, shareFolderStatusUpdated), shareFolderJSONKindFolder, input, metadata),
+	}, nil, func(w io.Writer) error {
+		if !dryRun {
+			return nil
+		}
+		return writeDryRunLine(w, "update shared folder policy for", folder)
+	})
+}
+
+var shareFolderUpdatePolicyCmd = &cobra.Command{
+	Use:   "update-policy [flags] <folder>",
+	Short: "Change shared folder policies",
+	Long: `Change who can join a shared folder, who can manage its members, who its
+shared links can be shared with, and whether viewer info is enabled. Only the
+policies given as flags change. Use share list folder --long to see the
+current policies.`,
+	Example: `  dbxcli share folder update-policy --member-policy team /Projects
+  dbxcli share folder update-policy --acl-update-policy editors --viewer-info-policy disabled 84528192421`,
+	RunE: shareFolderUpdatePolicy,
+}
+
+func init() {
+	shareFolderCmd.AddCommand(shareFolderUpdatePolicyCmd)
+	addShareFolderPolicyFlags(shareFolderUpdatePolicyCmd)
+	addDryRunFlag(shareFolderUpdatePolicyCmd)
+	enableStructuredOutput(shareFolderUpdatePolicyCmd)
+}
@@ -27,6 +27,7 @@ type sharedLinkClient interface {
 	CreateSharedLinkWithSettingsContext(context.Context, *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error)
 	CreateSharedLinkWithRawSettingsContext(context.Context, string, *rawSharedLinkSettings) (sharing.IsSharedLinkMetadata, error)
 	GetSharedLinkFileContext(context.Context, *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, io.ReadCloser, error)
+	GetSharedLinkFileRangeContext(context.Context, *sharing.GetSharedLinkMetadataArg, int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error)
 	GetSharedLinkMetadataContext(context.Context, *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, error)
 	ListSharedLinksContext(context.Context, *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error)
 	ModifySharedLinkSettingsContext(context.Context, *sharing.ModifySharedLinkSettingsArgs) (sharing.IsSharedLinkMetadata, error)
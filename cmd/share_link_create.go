@@ -34,11 +34,14 @@ type shareLinkCreateOptions struct {
 	access           *sharing.RequestedLinkAccessLevel
 	audience         *sharing.LinkAudience
 	password         sharedLinkPasswordOptions
+	qr               shareLinkQROptions
 	dryRun           bool
 }
 
 type shareLinkCreateInput struct {
-	Path             string `json:"path"`
+	Path             string `json:"path,omitempty"`
+	FromFile         string `json:"from_file,omitempty"`
+	Workers          int    `json:"workers,omitempty"`
 	Access           string `json:"access,omitempty"`
 	Audience         string `json:"audience,omitempty"`
 	Expires          string `json:"expires,omitempty"`
@@ -46,14 +49,25 @@ type shareLinkCreateInput struct {
 	AllowDownload    bool   `json:"allow_download,omitempty"`
 	DisallowDownload bool   `json:"disallow_download,omitempty"`
 	Password         bool   `json:"password,omitempty"`
+	QRPNG            string `json:"qr_png,omitempty"`
+	QRSVG            string `json:"qr_svg,omitempty"`
 	DryRun           bool   `json:"dry_run,omitempty"`
 }
 
 type shareLinkCreateResultInput struct {
-	DryRun bool `json:"dry_run,omitempty"`
+	Path   string `json:"path,omitempty"`
+	DryRun bool   `json:"dry_run,omitempty"`
 }
 
 func shareLinkCreate(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("from-file") {
+		return shareLinkCreateFromFile(cmd, args)
+	}
+	for _, name := range []string{"workers", "csv"} {
+		if cmd.Flags().Changed(name) {
+			return invalidArgumentsErrorfWithDetails("`--%s` requires `--from-file`", flagsErrorDetails(name, "from-file"), name)
+		}
+	}
 	if len(args) != 1 {
 		return invalidArgumentsErrorWithDetails("`share-link create` requires a `path` argument", argumentErrorDetails("path"))
 	}
@@ -75,46 +89,27 @@ func shareLinkCreate(cmd *cobra.Command, args []string) error {
 		return renderShareLinkCreateDryRunOutput(cmd, path, opts)
 	}
 
-	dbx := newSharedLinkClient(config)
-	link, err := createSharedLink(dbx, path, opts)
-	usedExisting := false
+	status, result, err := createOrReuseSharedLink(newSharedLinkClient(config), path, opts)
 	if err != nil {
-		link, err = existingSharedLink(dbx, path, err)
-		if err != nil {
-			return withJSONErrorDetails(err, operationErrorDetails("share_link_create"), pathErrorDetails(path))
-		}
-		link, err = applyExistingSharedLinkCreateOptions(dbx, link, opts)
-		if err != nil {
-			return withJSONErrorDetails(err, operationErrorDetails("share_link_create"), pathErrorDetails(path))
-		}
-		usedExisting = true
+		return err
 	}
-
-	url, ok := sharedLinkURL(link)
-	if !ok {
-		return withJSONErrorDetails(errors.New("shared link response did not include a URL"), operationErrorDetails("share_link_create"), pathErrorDetails(path))
+	qr, err := encodeShareLinkQR(cmd, result.URL, opts.qr)
+	if err != nil {
+		return err
 	}
 
 	out := commandOutput(cmd)
-	if usedExisting {
+	if status == shareLinkJSONStatusExisting {
 		commandVerboseStatus(cmd, "Using existing shared link for %s", path)
 	} else {
 		commandVerboseStatus(cmd, "Created shared link for %s", path)
 	}
 
-	result, ok := shareLinkJSONMetadataFromDropbox(link)
-	if !ok {
-		return withJSONErrorDetails(errors.New("found unknown shared link type"), operationErrorDetails("share_link_create"), pathErrorDetails(path))
-	}
-
-	status := shareLinkJSONStatusCreated
-	if usedExisting {
-		status = shareLinkJSONStatusExisting
-	}
-
 	return out.Render(func(w io.Writer) error {
-		_, err := fmt.Fprintln(w, url)
-		return err
+		if _, err := fmt.Fprintln(w, result.URL); err != nil {
+			return err
+		}
+		return writeShareLinkTerminalQR(w, qr, opts.qr)
 	}, newJSONCommandOperationOutput(
 		cmd,
 		newShareLinkCreateInput(path, opts),
@@ -123,6 +118,33 @@ func shareLinkCreate(cmd *cobra.Command, args []string) error {
 	))
 }
 
+// createOrReuseSharedLink creates a shared link for path, or returns the
+// existing direct link updated with opts. The status is created or existing.
+func createOrReuseSharedLink(dbx sharedLinkClient, path string, opts shareLinkCreateOptions) (string, shareLinkJSONMetadata, error) {
+	link, err := createSharedLink(dbx, path, opts)
+	status := shareLinkJSONStatusCreated
+	if err != nil {
+		link, err = existingSharedLink(dbx, path, err)
+		if err != nil {
+			return "", shareLinkJSONMetadata{}, withJSONErrorDetails(err, operationErrorDetails("share_link_create"), pathErrorDetails(path))
+		}
+		link, err = applyExistingSharedLinkCreateOptions(dbx, link, opts)
+		if err != nil {
+			return "", shareLinkJSONMetadata{}, withJSONErrorDetails(err, operationErrorDetails("share_link_create"), pathErrorDetails(path))
+		}
+		status = shareLinkJSONStatusExisting
+	}
+
+	if _, ok := sharedLinkURL(link); !ok {
+		return "", shareLinkJSONMetadata{}, withJSONErrorDetails(errors.New("shared link response did not include a URL"), operationErrorDetails("share_link_create"), pathErrorDetails(path))
+	}
+	result, ok := shareLinkJSONMetadataFromDropbox(link)
+	if !ok {
+		return "", shareLinkJSONMetadata{}, withJSONErrorDetails(errors.New("found unknown shared link type"), operationErrorDetails("share_link_create"), pathErrorDetails(path))
+	}
+	return status, result, nil
+}
+
 func newShareLinkCreateInput(path string, opts shareLinkCreateOptions) shareLinkCreateInput {
 	input := shareLinkCreateInput{
 		Path:             path,
@@ -130,6 +152,8 @@ func newShareLinkCreateInput(path string, opts shareLinkCreateOptions) shareLink
 		AllowDownload:    opts.allowDownload,
 		DisallowDownload: opts.disallowDownload,
 		Password:         opts.password.set,
+		QRPNG:            opts.qr.pngPath,
+		QRSVG:            opts.qr.svgPath,
 		DryRun:           opts.dryRun,
 	}
 	if opts.access != nil {
@@ -213,11 +237,19 @@ func parseShareLinkCreateOptions(cmd *cobra.Command) (shareLinkCreateOptions, er
 		return opts, err
 	}
 	opts.password = password
+	qr, err := parseShareLinkQROptions(cmd)
+	if err != nil {
+		return opts, err
+	}
+	opts.qr = qr
 	dryRun, err := dryRunOptionalEnabled(cmd)
 	if err != nil {
 		return opts, err
 	}
 	opts.dryRun = dryRun
+	if opts.dryRun && opts.qr.enabled() {
+		return opts, invalidArgumentsErrorWithDetails("QR code flags cannot be used with `--dry-run` because no link is created", flagsErrorDetails(append(opts.qr.flagNames(), dryRunFlagName)...))
+	}
 
 	if opts.expires != nil && opts.removeExpiration {
 		return opts, invalidArgumentsErrorWithDetails("`--expires` and `--remove-expiration` cannot be used together", flagsErrorDetails("expires", "remove-expiration"))
@@ -458,15 +490,19 @@ func shareLinkAccessFlag(cmd *cobra.Command) (*sharing.RequestedLinkAccessLevel,
 	if err != nil {
 		return nil, err
 	}
+	access, ok := shareLinkAccessValue(value)
+	if !ok {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --access %q: use viewer, editor, or max", flagValueErrorDetails("access", value), value)
+	}
+	return access, nil
+}
+
+func shareLinkAccessValue(value string) (*sharing.RequestedLinkAccessLevel, bool) {
 	switch value {
-	case sharing.RequestedLinkAccessLevelViewer:
-		return requestedLinkAccessLevel(sharing.RequestedLinkAccessLevelViewer), nil
-	case sharing.RequestedLinkAccessLevelEditor:
-		return requestedLinkAccessLevel(sharing.RequestedLinkAccessLevelEditor), nil
-	case sharing.RequestedLinkAccessLevelMax:
-		return requestedLinkAccessLevel(sharing.RequestedLinkAccessLevelMax), nil
+	case sharing.RequestedLinkAccessLevelViewer, sharing.RequestedLinkAccessLevelEditor, sharing.RequestedLinkAccessLevelMax:
+		return requestedLinkAccessLevel(value), true
 	default:
-		return nil, invalidArgumentsErrorfWithDetails("invalid --access %q: use viewer, editor, or max", flagValueErrorDetails("access", value), value)
+		return nil, false
 	}
 }
 
@@ -479,17 +515,21 @@ func shareLinkAudienceFlag(cmd *cobra.Command) (*sharing.LinkAudience, error) {
 	if err != nil {
 		return nil, err
 	}
+	audience, ok := shareLinkAudienceValue(value)
+	if !ok {
+		return nil, invalidArgumentsErrorfWithDetails("invalid --audience %q: use public, team, members, or no-one", flagValueErrorDetails("audience", value), value)
+	}
+	return audience, nil
+}
+
+func shareLinkAudienceValue(value string) (*sharing.LinkAudience, bool) {
 	switch value {
-	case sharing.LinkAudiencePublic:
-		return linkAudience(sharing.LinkAudiencePublic), nil
-	case sharing.LinkAudienceTeam:
-		return linkAudience(sharing.LinkAudienceTeam), nil
-	case sharing.LinkAudienceMembers:
-		return linkAudience(sharing.LinkAudienceMembers), nil
+	case sharing.LinkAudiencePublic, sharing.LinkAudienceTeam, sharing.LinkAudienceMembers:
+		return linkAudience(value), true
 	case "no-one":
-		return linkAudience(sharing.LinkAudienceNoOne), nil
+		return linkAudience(sharing.LinkAudienceNoOne), true
 	default:
-		return nil, invalidArgumentsErrorfWithDetails("invalid --audience %q: use public, team, members, or no-one", flagValueErrorDetails("audience", value), value)
+		return nil, false
 	}
 }
 
@@ -502,12 +542,31 @@ var shareLinkCreateCmd = &cobra.Command{
 	Short: "Create a shared link",
 	Long: `Create a shared link for a Dropbox file or folder.
 If a direct shared link already exists, dbxcli returns that existing URL.
-Settings flags request Dropbox shared-link settings; account, team, and folder policies may still restrict the result.`,
+Settings flags request Dropbox shared-link settings; account, team, and folder policies may still restrict the result.
+
+With --from-file, links are created for every path in a manifest file, with
+at most --workers requests in flight. Each line holds a Dropbox path, or a JSON
+object whose settings override the flags for that path:
+
+  /Deliverables/report.pdf
+  {"path": "/Deliverables/model.zip", "audience": "team", "expires": "2026-12-31T00:00:00Z"}
+  {"path": "/Deliverables/draft.docx", "password": "s3cret", "disallow_download": true}
+
+JSON lines accept path, access, audience, expires, remove_expiration,
+allow_download, disallow_download, and password. Blank lines and lines
+starting with # are skipped. Text output maps each path to its URL, or use
+--csv for a CSV with path, url, status, and error columns. A path that fails
+is reported as a warning and the rest of the batch continues.
+
+--qr draws the link as a QR code in the terminal below the URL; --qr-png and
+--qr-svg write it as an image for printed material.`,
 	Example: `  dbxcli share-link create /file.txt
   dbxcli share-link create /folder
   dbxcli share-link create /file.txt --audience team
   dbxcli share-link create /file.txt --expires 2026-07-01T00:00:00Z
-  dbxcli share-link create /file.txt --password-prompt`,
+  dbxcli share-link create /file.txt --password-prompt
+  dbxcli share-link create /flyer.pdf --qr --qr-png flyer-qr.png
+  dbxcli share-link create --from-file deliverables.txt --audience public --csv > links.csv`,
 	RunE: shareLinkCreate,
 }
 
@@ -518,7 +577,11 @@ func init() {
 	shareLinkCreateCmd.Flags().Bool("disallow-download", false, "Disallow downloads from the shared link")
 	shareLinkCreateCmd.Flags().String("expires", "", "Set shared link expiration time as an RFC3339 timestamp")
 	shareLinkCreateCmd.Flags().Bool("remove-expiration", false, "Remove expiration when returning an existing shared link")
+	shareLinkCreateCmd.Flags().String("from-file", "", "Create links for every path in a manifest file")
+	shareLinkCreateCmd.Flags().Int("workers", defaultShareLinkCreateWorkers, "Number of concurrent requests with --from-file")
+	shareLinkCreateCmd.Flags().Bool("csv", false, "Write --from-file text output as CSV")
 	addSharedLinkPasswordFlags(shareLinkCreateCmd)
+	addShareLinkQRFlags(shareLinkCreateCmd)
 	addDryRunFlag(shareLinkCreateCmd)
 	shareLinkCmd.AddCommand(shareLinkCreateCmd)
 	enableStructuredOutput(shareLinkCreateCmd)
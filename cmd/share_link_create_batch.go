@@ -0,0 +1,263 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/spf13/cobra"
+)
+
+const defaultShareLinkCreateWorkers = 4
+
+// shareLinkCreateEntry is one JSON line of a --from-file manifest. Settings
+// left unset fall back to the command's flags.
+type shareLinkCreateEntry struct {
+	Path             string `json:"path"`
+	Access           string `json:"access,omitempty"`
+	Audience         string `json:"audience,omitempty"`
+	Expires          string `json:"expires,omitempty"`
+	RemoveExpiration bool   `json:"remove_expiration,omitempty"`
+	AllowDownload    bool   `json:"allow_download,omitempty"`
+	DisallowDownload bool   `json:"disallow_download,omitempty"`
+	Password         string `json:"password,omitempty"`
+}
+
+// shareLinkCreateTarget is a manifest entry resolved against the flags.
+type shareLinkCreateTarget struct {
+	path string
+	opts shareLinkCreateOptions
+}
+
+type shareLinkCreateOutcome struct {
+	status string
+	link   shareLinkJSONMetadata
+	err    error
+}
+
+func shareLinkCreateFromFile(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return invalidArgumentsErrorWithDetails("`--from-file` cannot be used with a `path` argument", mergeJSONErrorDetails(argumentErrorDetails("path"), flagErrorDetails("from-file")))
+	}
+	manifest, _ := cmd.Flags().GetString("from-file")
+	asCSV, _ := cmd.Flags().GetBool("csv")
+	if asCSV && commandOutputFormat(cmd) != output.FormatText {
+		return invalidArgumentsErrorWithDetails("`--csv` cannot be used with `--output json`", flagsErrorDetails("csv", outputFlag))
+	}
+	workers, _ := cmd.Flags().GetInt("workers")
+	if workers < 1 {
+		return invalidArgumentsErrorWithDetails("`--workers` must be at least 1", flagValueErrorDetails("workers", strconv.Itoa(workers)))
+	}
+	defaults, err := parseShareLinkCreateOptions(cmd)
+	if err != nil {
+		return err
+	}
+	if defaults.qr.enabled() {
+		return invalidArgumentsErrorWithDetails("QR code flags cannot be used with `--from-file`", flagsErrorDetails(append(defaults.qr.flagNames(), "from-file")...))
+	}
+	targets, err := readShareLinkCreateManifest(manifest, defaults)
+	if err != nil {
+		return err
+	}
+
+	outcomes := make([]shareLinkCreateOutcome, len(targets))
+	if defaults.dryRun {
+		for i, target := range targets {
+			outcomes[i] = shareLinkCreateOutcome{status: jsonStatusPlanned, link: plannedShareLinkCreateMetadata(target.path)}
+		}
+	} else {
+		dbx := newSharedLinkClient(config)
+		// Entries fail independently, so fn never stops the other workers.
+		_ = forEachWorker(len(targets), workers, func(i int) error {
+			status, link, err := createOrReuseSharedLink(dbx, targets[i].path, targets[i].opts)
+			outcomes[i] = shareLinkCreateOutcome{status: status, link: link, err: err}
+			return nil
+		})
+	}
+
+	var results []jsonOperationResult
+	var warnings []jsonWarning
+	var failures []error
+	for i, outcome := range outcomes {
+		target := targets[i]
+		if outcome.err != nil {
+			failures = append(failures, outcome.err)
+			warnings = append(warnings, jsonWarning{Code: jsonWarningCodeShareLinkCreateFailed, Message: outcome.err.Error(), Path: target.path})
+			continue
+		}
+		results = append(results, newJSONOperationResult(outcome.status, outcome.link.Type, shareLinkCreateResultInput{Path: target.path, DryRun: defaults.dryRun}, outcome.link))
+	}
+	if len(failures) == len(targets) {
+		return batchFailuresError("share_link_create", failures)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+	if !defaults.dryRun {
+		commandVerboseStatus(cmd, "Shared %d of %d paths from %s", len(results), len(targets), manifest)
+	}
+
+	input := newShareLinkCreateInput("", defaults)
+	input.FromFile = manifest
+	input.Workers = workers
+	return renderOperation(cmd, input, results, warnings, func(w io.Writer) error {
+		switch {
+		case asCSV:
+			return renderShareLinkCreateCSV(w, targets, outcomes)
+		case defaults.dryRun:
+			for _, target := range targets {
+				if err := writeDryRunLine(w, "create shared link", target.path); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			for i, outcome := range outcomes {
+				if outcome.err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "%s\t%s\n", targets[i].path, outcome.link.URL); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	})
+}
+
+// readShareLinkCreateManifest reads one Dropbox path per line, or one JSON
+// object per line with per-path settings. Blank lines and lines starting
+// with # are skipped.
+func readShareLinkCreateManifest(manifest string, defaults shareLinkCreateOptions) ([]shareLinkCreateTarget, error) {
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		return nil, withJSONErrorDetails(err, flagValueErrorDetails("from-file", manifest))
+	}
+
+	var targets []shareLinkCreateTarget
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		target, err := parseShareLinkCreateManifestLine(line, defaults)
+		if err != nil {
+			return nil, invalidArgumentsErrorfWithDetails("%s line %d: %v", flagValueErrorDetails("from-file", manifest), manifest, lineNumber, err)
+		}
+		targets = append(targets, target)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, withJSONErrorDetails(err, flagValueErrorDetails("from-file", manifest))
+	}
+	if len(targets) == 0 {
+		return nil, invalidArgumentsErrorfWithDetails("%s lists no paths", flagValueErrorDetails("from-file", manifest), manifest)
+	}
+	return targets, nil
+}
+
+func parseShareLinkCreateManifestLine(line string, defaults shareLinkCreateOptions) (shareLinkCreateTarget, error) {
+	entry := shareLinkCreateEntry{Path: line}
+	if strings.HasPrefix(line, "{") {
+		entry = shareLinkCreateEntry{}
+		decoder := json.NewDecoder(strings.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&entry); err != nil {
+			return shareLinkCreateTarget{}, err
+		}
+	}
+
+	path, err := validatePath(entry.Path)
+	if err != nil {
+		return shareLinkCreateTarget{}, err
+	}
+	if path == "" {
+		return shareLinkCreateTarget{}, fmt.Errorf("cannot create a shared link for Dropbox root")
+	}
+
+	opts := defaults
+	if entry.Access != "" {
+		access, ok := shareLinkAccessValue(entry.Access)
+		if !ok {
+			return shareLinkCreateTarget{}, fmt.Errorf("invalid access %q: use viewer, editor, or max", entry.Access)
+		}
+		opts.access = access
+	}
+	if entry.Audience != "" {
+		audience, ok := shareLinkAudienceValue(entry.Audience)
+		if !ok {
+			return shareLinkCreateTarget{}, fmt.Errorf("invalid audience %q: use public, team, members, or no-one", entry.Audience)
+		}
+		opts.audience = audience
+	}
+	if entry.Expires != "" {
+		expires, err := time.Parse(time.RFC3339, entry.Expires)
+		if err != nil {
+			return shareLinkCreateTarget{}, fmt.Errorf("invalid expires %q: use RFC3339 timestamp", entry.Expires)
+		}
+		opts.expires = &expires
+		opts.removeExpiration = false
+	}
+	if entry.RemoveExpiration {
+		if entry.Expires != "" {
+			return shareLinkCreateTarget{}, fmt.Errorf("expires and remove_expiration cannot be used together")
+		}
+		opts.expires = nil
+		opts.removeExpiration = true
+	}
+	if entry.AllowDownload && entry.DisallowDownload {
+		return shareLinkCreateTarget{}, fmt.Errorf("allow_download and disallow_download cannot be used together")
+	}
+	if entry.AllowDownload {
+		opts.allowDownload, opts.disallowDownload = true, false
+	}
+	if entry.DisallowDownload {
+		opts.allowDownload, opts.disallowDownload = false, true
+	}
+	if entry.Password != "" {
+		opts.password = sharedLinkPasswordOptions{password: entry.Password, set: true}
+	}
+	return shareLinkCreateTarget{path: path, opts: opts}, nil
+}
+
+func renderShareLinkCreateCSV(out io.Writer, targets []shareLinkCreateTarget, outcomes []shareLinkCreateOutcome) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"path", "url", "status", "error"}); err != nil {
+		return err
+	}
+	for i, outcome := range outcomes {
+		record := []string{targets[i].path, outcome.link.URL, outcome.status, ""}
+		if outcome.err != nil {
+			record[2], record[3] = "failed", outcome.err.Error()
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+func TestShareLinkCreateFromFileAppliesPerEntrySettings(t *testing.T) {
+	var mu sync.Mutex
+	created := map[string]*sharing.CreateSharedLinkWithSettingsArg{}
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			mu.Lock()
+			created[arg.Path] = arg
+			mu.Unlock()
+			if arg.Path == "/Deliverables/existing.pdf" {
+				return nil, alreadyExistsError(sharedLinkFile(arg.Path, "https://example.com/existing"))
+			}
+			return sharedLinkFile(arg.Path, "https://example.com"+strings.ToLower(arg.Path)), nil
+		},
+		modifySharedLinkSettingsFn: func(arg *sharing.ModifySharedLinkSettingsArgs) (sharing.IsSharedLinkMetadata, error) {
+			return sharedLinkFile("/deliverables/existing.pdf", arg.Url), nil
+		},
+	})
+	manifest := writeShareLinkCreateManifest(t, `# end of project
+/Deliverables/report.pdf
+
+{"path": "/Deliverables/model.zip", "audience": "public", "expires": "2026-12-31T00:00:00Z", "password": "s3cret"}
+/Deliverables/existing.pdf
+`)
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest, "audience": "team", "workers": "2"})
+	if err := shareLinkCreate(cmd, nil); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	if got := created["/Deliverables/report.pdf"].Settings.Audience.Tag; got != sharing.LinkAudienceTeam {
+		t.Fatalf("report audience = %q, want flag default team", got)
+	}
+	model := created["/Deliverables/model.zip"].Settings
+	if model.Audience.Tag != sharing.LinkAudiencePublic || model.Expires == nil || !model.RequirePassword || model.LinkPassword != "s3cret" {
+		t.Fatalf("model settings = %+v, want public, expiring, password-protected", model)
+	}
+	want := "/Deliverables/report.pdf\thttps://example.com/deliverables/report.pdf\n" +
+		"/Deliverables/model.zip\thttps://example.com/deliverables/model.zip\n" +
+		"/Deliverables/existing.pdf\thttps://example.com/existing\n"
+	if stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareLinkCreateFromFileReportsPartialFailures(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			if arg.Path == "/missing.pdf" {
+				return nil, sharing.CreateSharedLinkWithSettingsAPIError{APIError: dropbox.APIError{ErrorSummary: "path/not_found/"}}
+			}
+			return sharedLinkFile(arg.Path, "https://example.com/report"), nil
+		},
+	})
+	manifest := writeShareLinkCreateManifest(t, "/report.pdf\n/missing.pdf\n")
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest, outputFlag: "json"})
+	if err := shareLinkCreate(cmd, nil); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutputWithWarnings[shareLinkCreateInput, shareLinkJSONMetadata](t, stdout.Bytes())
+	if got.Input.FromFile != manifest || got.Input.Workers != defaultShareLinkCreateWorkers || got.Input.Path != "" {
+		t.Fatalf("input = %+v, want from_file %s", got.Input, manifest)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != shareLinkJSONStatusCreated || got.Results[0].Result.URL != "https://example.com/report" {
+		t.Fatalf("results = %+v, want the created report link", got.Results)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != jsonWarningCodeShareLinkCreateFailed || got.Warnings[0].Path != "/missing.pdf" {
+		t.Fatalf("warnings = %+v, want share_link_create_failed for /missing.pdf", got.Warnings)
+	}
+}
+
+func TestShareLinkCreateFromFileCSVIncludesFailures(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			if arg.Path == "/missing.pdf" {
+				return nil, errors.New("path not found")
+			}
+			return sharedLinkFile(arg.Path, "https://example.com/report"), nil
+		},
+	})
+	manifest := writeShareLinkCreateManifest(t, "/report.pdf\n/missing.pdf\n")
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest, "csv": "true"})
+	if err := shareLinkCreate(cmd, nil); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	want := "path,url,status,error\n" +
+		"/report.pdf,https://example.com/report,created,\n" +
+		"/missing.pdf,,failed,path not found\n"
+	if stdout.String() != want {
+		t.Fatalf("CSV output = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestShareLinkCreateFromFileFailsWhenEveryEntryFails(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			return nil, errors.New("path not found")
+		},
+	})
+	manifest := writeShareLinkCreateManifest(t, "/a.pdf\n/b.pdf\n")
+
+	cmd := newShareLinkCreateTestCommand(nil)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest})
+	if err := shareLinkCreate(cmd, nil); err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("shareLinkCreate error = %v, want every entry to fail", err)
+	}
+}
+
+func TestShareLinkCreateFromFileDryRunListsPlannedLinks(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			t.Fatalf("CreateSharedLinkWithSettings called during dry-run: %v", arg)
+			return nil, nil
+		},
+	})
+	manifest := writeShareLinkCreateManifest(t, "/a.pdf\n{\"path\": \"/b.pdf\", \"audience\": \"no-one\"}\n")
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest, dryRunFlagName: "true", outputFlag: "json"})
+	if err := shareLinkCreate(cmd, nil); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[shareLinkCreateInput, shareLinkJSONMetadata](t, stdout.Bytes())
+	if len(got.Results) != 2 || got.Results[0].Status != jsonStatusPlanned || got.Results[1].Result.PathLower != "/b.pdf" {
+		t.Fatalf("results = %+v, want two planned links", got.Results)
+	}
+}
+
+func TestShareLinkCreateFromFileRejectsInvalidManifest(t *testing.T) {
+	for name, body := range map[string]string{
+		"empty":          "# nothing to share\n\n",
+		"root":           "/\n",
+		"unknown field":  `{"path": "/a.pdf", "visibility": "public"}`,
+		"bad audience":   `{"path": "/a.pdf", "audience": "everyone"}`,
+		"bad expires":    `{"path": "/a.pdf", "expires": "tomorrow"}`,
+		"download clash": `{"path": "/a.pdf", "allow_download": true, "disallow_download": true}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			stubSharedLinkClient(t, &mockSharedLinkClient{
+				createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+					t.Fatalf("CreateSharedLinkWithSettings called for an invalid manifest: %v", arg)
+					return nil, nil
+				},
+			})
+			manifest := writeShareLinkCreateManifest(t, body)
+			cmd := newShareLinkCreateTestCommand(nil)
+			setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": manifest})
+			err := shareLinkCreate(cmd, nil)
+			if jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+				t.Fatalf("shareLinkCreate error = %v, want invalid_arguments", err)
+			}
+			if details := jsonErrorDetails(err); details["flag"] != "from-file" {
+				t.Fatalf("error details = %#v, want from-file flag", details)
+			}
+		})
+	}
+}
+
+func TestShareLinkCreateFromFileRejectsPathArgument(t *testing.T) {
+	cmd := newShareLinkCreateTestCommand(nil)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"from-file": "paths.txt"})
+	if err := shareLinkCreate(cmd, []string{"/a.pdf"}); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("shareLinkCreate error = %v, want invalid_arguments", err)
+	}
+}
+
+func TestShareLinkCreateBatchFlagsRequireFromFile(t *testing.T) {
+	for name, value := range map[string]string{"workers": "2", "csv": "true"} {
+		cmd := newShareLinkCreateTestCommand(nil)
+		setShareLinkCreateFlags(t, cmd, map[string]string{name: value})
+		if err := shareLinkCreate(cmd, []string{"/a.pdf"}); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+			t.Fatalf("shareLinkCreate --%s error = %v, want invalid_arguments", name, err)
+		}
+	}
+}
+
+func writeShareLinkCreateManifest(t *testing.T, body string) string {
+	t.Helper()
+	manifest := filepath.Join(t.TempDir(), "paths.txt")
+	if err := os.WriteFile(manifest, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return manifest
+}
+
+func setShareLinkCreateFlags(t *testing.T, cmd *cobra.Command, values map[string]string) {
+	t.Helper()
+	for name, value := range values {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -35,6 +35,7 @@ type shareLinkDownloadOptions struct {
 	path      string
 	password  sharedLinkPasswordOptions
 	recursive bool
+	ifExists  string
 }
 
 type shareLinkDownloadInput struct {
@@ -43,6 +44,7 @@ type shareLinkDownloadInput struct {
 	Path      string `json:"path,omitempty"`
 	Recursive bool   `json:"recursive,omitempty"`
 	Password  bool   `json:"password,omitempty"`
+	IfExists  string `json:"if_exists,omitempty"`
 }
 
 type shareLinkDownloadResult struct {
@@ -106,7 +108,7 @@ func shareLinkDownload(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return withJSONErrorDetails(err, urlErrorDetails(url), operationErrorDetails("share_link_download"))
 		}
-		if err := downloadSharedLinkFolder(filesNewFunc(config), dbx, arg, folder.Name, dst, cmd.ErrOrStderr()); err != nil {
+		if err := downloadSharedLinkFolder(filesNewFunc(config), dbx, arg, folder.Name, dst, opts.ifExists, cmd.ErrOrStderr()); err != nil {
 			return withJSONErrorDetails(err, urlErrorDetails(url), operationErrorDetails("share_link_download"))
 		}
 		commandVerboseStatus(cmd, "Downloaded shared link folder to %s", dst)
@@ -169,6 +171,22 @@ func parseShareLinkDownloadOptions(cmd *cobra.Command) (shareLinkDownloadOptions
 		return opts, invalidArgumentsErrorWithDetails("`--path` cannot be used with --recursive", mergeJSONErrorDetails(operationErrorDetails("share_link_download"), flagsErrorDetails("path", "recursive"), pathErrorDetails(opts.path)))
 	}
 
+	if localFlagChanged(cmd, "if-exists") {
+		ifExists, err := localStringFlag(cmd, "if-exists")
+		if err != nil {
+			return opts, err
+		}
+		switch ifExists {
+		case putIfExistsOverwrite, putIfExistsSkip, putIfExistsFail:
+		default:
+			return opts, invalidArgumentsErrorfWithDetails("invalid --if-exists %q (use overwrite, skip, or fail)", flagValueErrorDetails("if-exists", ifExists), ifExists)
+		}
+		if !opts.recursive {
+			return opts, invalidArgumentsErrorWithDetails("`--if-exists` requires --recursive", mergeJSONErrorDetails(operationErrorDetails("share_link_download"), flagsErrorDetails("if-exists", "recursive")))
+		}
+		opts.ifExists = ifExists
+	}
+
 	return opts, nil
 }
 
@@ -209,7 +227,7 @@ func sharedLinkFolderDownloadTarget(target string, link *sharing.FolderLinkMetad
 	return target, nil
 }
 
-func downloadSharedLinkFolder(filesDbx filesClient, dbx sharedLinkClient, arg *sharing.GetSharedLinkMetadataArg, rootName, dst string, errOut io.Writer) error {
+func downloadSharedLinkFolder(filesDbx filesClient, dbx sharedLinkClient, arg *sharing.GetSharedLinkMetadataArg, rootName, dst, ifExists string, errOut io.Writer) error {
 	if errOut == nil {
 		errOut = io.Discard
 	}
@@ -271,6 +289,15 @@ func downloadSharedLinkFolder(filesDbx filesClient, dbx sharedLinkClient, arg *s
 					downloadErrors = append(downloadErrors, fmt.Errorf("mkdir %s: %w", filepath.Dir(localPath), err))
 					continue
 				}
+				skip, err := checkSharedLinkLocalDestination(localPath, ifExists)
+				if err != nil {
+					downloadErrors = append(downloadErrors, fmt.Errorf("%s: %w", relPath, err))
+					continue
+				}
+				if skip {
+					fmt.Fprintf(errOut, "Skipping %s (%s already exists)\n", relPath, localPath)
+					continue
+				}
 				fmt.Fprintf(errOut, "Downloading %s -> %s\n", relPath, localPath)
 				if err := downloadSharedLinkRelativeFile(dbx, arg, relPath, localPath, errOut); err != nil {
 					downloadErrors = append(downloadErrors, fmt.Errorf("%s: %w", relPath, err))
@@ -296,7 +323,7 @@ func listSharedLinkFolderEntries(dbx filesClient, arg *sharing.GetSharedLinkMeta
 
 	res, err := dbx.ListFolderContext(currentContext(), listArg)
 	if err != nil {
-		return nil, fmt.Errorf("list shared link folder %q: %v", relFolder, err)
+		return nil, fmt.Errorf("list shared link folder %q: %w", relFolder, err)
 	}
 
 	entries := append([]files.IsMetadata{}, res.Entries...)
@@ -307,7 +334,7 @@ func listSharedLinkFolderEntries(dbx filesClient, arg *sharing.GetSharedLinkMeta
 		cont := files.NewListFolderContinueArg(res.Cursor)
 		res, err = dbx.ListFolderContinueContext(currentContext(), cont)
 		if err != nil {
-			return entries, fmt.Errorf("list shared link folder continue: %v", err)
+			return entries, fmt.Errorf("list shared link folder continue: %w", err)
 		}
 		entries = append(entries, res.Entries...)
 	}
@@ -315,23 +342,34 @@ func listSharedLinkFolderEntries(dbx filesClient, arg *sharing.GetSharedLinkMeta
 	return entries, nil
 }
 
+// checkSharedLinkLocalDestination applies --if-exists to a file in a
+// recursive download. Files are renamed into place only once complete, so an
+// existing file is never a partial download.
+func checkSharedLinkLocalDestination(localPath, ifExists string) (bool, error) {
+	if ifExists == "" || ifExists == putIfExistsOverwrite {
+		return false, nil
+	}
+	if _, err := os.Lstat(localPath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if ifExists == putIfExistsSkip {
+		return true, nil
+	}
+	return false, fmt.Errorf("local file %s already exists", localPath)
+}
+
 func downloadSharedLinkRelativeFile(dbx sharedLinkClient, baseArg *sharing.GetSharedLinkMetadataArg, relPath, dst string, errOut io.Writer) error {
 	arg := sharing.NewGetSharedLinkMetadataArg(baseArg.Url)
 	arg.Path = sharedLinkAPIPath(relPath)
 	arg.LinkPassword = baseArg.LinkPassword
 
-	return retryWithBackoff(func() error {
-		link, contents, err := dbx.GetSharedLinkFileContext(currentContext(), arg)
-		if err != nil {
-			return err
-		}
-		if contents == nil {
-			return errors.New("shared link download response did not include file content")
-		}
-		defer func() { _ = contents.Close() }()
-
-		return copySharedLinkContentToFile(contents, sharedLinkDownloadSize(link), dst, errOut)
-	})
+	_, _, err := downloadSharedLinkFileResumable(dbx, arg, func(sharing.IsSharedLinkMetadata) (string, error) {
+		return dst, nil
+	}, errOut)
+	return err
 }
 
 func sharedLinkEntryRelativePath(pathDisplay string, rootName string) (string, error) {
@@ -375,10 +413,38 @@ func sharedLinkLocalPath(root, rel string) (string, error) {
 }
 
 func downloadSharedLinkToFile(dbx sharedLinkClient, arg *sharing.GetSharedLinkMetadataArg, target string, errOut io.Writer) (string, sharing.IsSharedLinkMetadata, error) {
-	var dst string
-	var downloaded sharing.IsSharedLinkMetadata
+	return downloadSharedLinkFileResumable(dbx, arg, func(link sharing.IsSharedLinkMetadata) (string, error) {
+		return sharedLinkDownloadTarget(target, link)
+	}, errOut)
+}
+
+// downloadSharedLinkFileResumable downloads a shared-link file into a
+// temporary file beside its destination, which destination picks from the
+// first response. Retries request only the bytes not yet written, and the
+// file must match the size in the link metadata before it replaces the
+// destination.
+func downloadSharedLinkFileResumable(dbx sharedLinkClient, arg *sharing.GetSharedLinkMetadataArg, destination func(sharing.IsSharedLinkMetadata) (string, error), errOut io.Writer) (string, sharing.IsSharedLinkMetadata, error) {
+	if errOut == nil {
+		errOut = io.Discard
+	}
+
+	var (
+		dst      string
+		finalDst string
+		tmp      string
+		f        *os.File
+		link     *sharing.FileLinkMetadata
+		written  int64
+	)
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+		}
+	}()
+
 	err := retryWithBackoff(func() error {
-		link, contents, err := dbx.GetSharedLinkFileContext(currentContext(), arg)
+		got, contents, err := dbx.GetSharedLinkFileRangeContext(currentContext(), arg, written)
 		if err != nil {
 			return err
 		}
@@ -387,15 +453,74 @@ func downloadSharedLinkToFile(dbx sharedLinkClient, arg *sharing.GetSharedLinkMe
 		}
 		defer func() { _ = contents.Close() }()
 
-		dst, err = sharedLinkDownloadTarget(target, link)
-		if err != nil {
+		if f == nil {
+			if dst, err = destination(got); err != nil {
+				return err
+			}
+			file, ok := got.(*sharing.FileLinkMetadata)
+			if !ok {
+				return errors.New("shared link is not a downloadable file")
+			}
+			if finalDst, err = downloadDestinationPath(dst); err != nil {
+				return err
+			}
+			created, createdTmp, err := createDownloadTemp(finalDst)
+			if err != nil {
+				return err
+			}
+			f, tmp, link = created, createdTmp, file
+		} else if err := checkSharedLinkResumeMetadata(link, got); err != nil {
 			return err
 		}
-		downloaded = link
 
-		return copySharedLinkContentToFile(contents, sharedLinkDownloadSize(link), dst, errOut)
+		size := int64(link.Size)
+		start := written
+		progressbar := &ioprogress.Reader{
+			Reader: contents,
+			DrawFunc: ioprogress.DrawTerminalf(errOut, func(progress, total int64) string {
+				return fmt.Sprintf("Downloading %s/%s",
+					humanize.IBytes(uint64(start+progress)), humanize.IBytes(uint64(start+total)))
+			}),
+			Size: size - start,
+		}
+
+		n, copyErr := io.Copy(f, progressbar)
+		written += n
+		if copyErr != nil {
+			return copyErr
+		}
+		if written > size {
+			return fmt.Errorf("shared link download exceeded expected size: got %d bytes, expected %d", written, size)
+		}
+		if written < size {
+			return fmt.Errorf("incomplete shared link download: got %d bytes, expected %d: %w", written, size, io.ErrUnexpectedEOF)
+		}
+		return nil
 	})
-	return dst, downloaded, err
+	if err != nil {
+		return "", nil, err
+	}
+
+	closeErr := f.Close()
+	f = nil
+	if closeErr == nil {
+		closeErr = os.Rename(tmp, finalDst)
+	}
+	if closeErr != nil {
+		_ = os.Remove(tmp)
+		return "", nil, closeErr
+	}
+	return dst, link, nil
+}
+
+// checkSharedLinkResumeMetadata rejects a resumed response for a different
+// revision of the file than the bytes already written.
+func checkSharedLinkResumeMetadata(first *sharing.FileLinkMetadata, got sharing.IsSharedLinkMetadata) error {
+	file, ok := got.(*sharing.FileLinkMetadata)
+	if !ok || file.Rev != first.Rev || file.Size != first.Size {
+		return errors.New("shared link file changed during download")
+	}
+	return nil
 }
 
 func downloadSharedLinkToStdout(dbx sharedLinkClient, arg *sharing.GetSharedLinkMetadataArg, w io.Writer) error {
@@ -429,51 +554,6 @@ func downloadSharedLinkToStdout(dbx sharedLinkClient, arg *sharing.GetSharedLink
 	})
 }
 
-func copySharedLinkContentToFile(contents io.Reader, size uint64, dst string, errOut io.Writer) error {
-	if errOut == nil {
-		errOut = io.Discard
-	}
-
-	finalDst, err := downloadDestinationPath(dst)
-	if err != nil {
-		return err
-	}
-
-	f, tmp, err := createDownloadTemp(finalDst)
-	if err != nil {
-		return err
-	}
-	removeTemp := true
-	defer func() {
-		if removeTemp {
-			_ = os.Remove(tmp)
-		}
-	}()
-
-	progressbar := &ioprogress.Reader{
-		Reader: contents,
-		DrawFunc: ioprogress.DrawTerminalf(errOut, func(progress, total int64) string {
-			return fmt.Sprintf("Downloading %s/%s",
-				humanize.IBytes(uint64(progress)), humanize.IBytes(uint64(total)))
-		}),
-		Size: int64(size),
-	}
-
-	_, copyErr := io.Copy(f, progressbar)
-	closeErr := f.Close()
-	if copyErr != nil {
-		return copyErr
-	}
-	if closeErr != nil {
-		return closeErr
-	}
-	if err := os.Rename(tmp, finalDst); err != nil {
-		return err
-	}
-	removeTemp = false
-	return nil
-}
-
 func sharedLinkDownloadTarget(target string, link sharing.IsSharedLinkMetadata) (string, error) {
 	name, err := sharedLinkDownloadName(link)
 	if err != nil {
@@ -510,14 +590,6 @@ func sharedLinkDownloadName(link sharing.IsSharedLinkMetadata) (string, error) {
 	return name, nil
 }
 
-func sharedLinkDownloadSize(link sharing.IsSharedLinkMetadata) uint64 {
-	file, ok := link.(*sharing.FileLinkMetadata)
-	if !ok {
-		return 0
-	}
-	return file.Size
-}
-
 func newShareLinkDownloadInput(url, target string, opts shareLinkDownloadOptions) shareLinkDownloadInput {
 	return shareLinkDownloadInput{
 		URL:       url,
@@ -525,6 +597,7 @@ func newShareLinkDownloadInput(url, target string, opts shareLinkDownloadOptions
 		Path:      opts.path,
 		Recursive: opts.recursive,
 		Password:  opts.password.set,
+		IfExists:  opts.ifExists,
 	}
 }
 
@@ -556,11 +629,15 @@ var shareLinkDownloadCmd = &cobra.Command{
   - Use - as target to write file bytes to stdout.
     Stdout is byte-clean: all progress and errors go to stderr.
   - Use --recursive (-r) to download folder shared links.
+    Use --if-exists to skip or fail on files a previous run already wrote.
+  - Failed transfers resume from the last byte received, and each file must
+    match the size in the shared-link metadata.
 `,
 	Example: `  dbxcli share-link download https://www.dropbox.com/s/example/file.txt
   dbxcli share-link download https://www.dropbox.com/s/example/file.txt ./local-file.txt
   dbxcli share-link download https://www.dropbox.com/s/example/folder --path /nested/file.txt
-  dbxcli share-link download https://www.dropbox.com/s/example/file.txt - | tar tz`,
+  dbxcli share-link download https://www.dropbox.com/s/example/file.txt - | tar tz
+  dbxcli share-link download -r --if-exists skip https://www.dropbox.com/s/example/folder`,
 	RunE: shareLinkDownload,
 }
 
@@ -568,6 +645,7 @@ func init() {
 	addSharedLinkPasswordFlags(shareLinkDownloadCmd)
 	shareLinkDownloadCmd.Flags().String("path", "", "Download a file path inside a folder shared link")
 	shareLinkDownloadCmd.Flags().BoolP("recursive", "r", false, "Recursively download a folder shared link")
+	shareLinkDownloadCmd.Flags().String("if-exists", putIfExistsOverwrite, "What to do when a local file exists during --recursive: overwrite, skip, or fail")
 	shareLinkCmd.AddCommand(shareLinkDownloadCmd)
 	enableStructuredOutput(shareLinkDownloadCmd)
 }
@@ -879,6 +879,235 @@ func TestShareLinkDownloadVerboseWritesStatusToStderr(t *testing.T) {
 	}
 }
 
+func TestShareLinkDownloadResumesFromWrittenOffset(t *testing.T) {
+	retryDelays := stubRetrySleep(t)
+	target := filepath.Join(t.TempDir(), "report.txt")
+	content := "hello resumable world"
+	var offsets []int64
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		getSharedLinkFileRangeFn: func(arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			link := downloadableSharedLinkFile("report.txt", "/docs/report.txt", "https://example.com/link", uint64(len(content)))
+			if len(offsets) == 1 {
+				return link, &failingReadCloser{data: []byte(content[:6])}, nil
+			}
+			return link, io.NopCloser(strings.NewReader(content[offset:])), nil
+		},
+	})
+
+	if err := shareLinkDownload(newShareLinkDownloadTestCommand(nil, nil), []string{"https://example.com/link", target}); err != nil {
+		t.Fatalf("shareLinkDownload error: %v", err)
+	}
+
+	if fmt.Sprint(offsets) != "[0 6]" {
+		t.Fatalf("offsets = %v, want [0 6]", offsets)
+	}
+	if len(*retryDelays) != 1 {
+		t.Fatalf("retry delays = %v, want one retry", *retryDelays)
+	}
+	assertFileContent(t, target, content)
+}
+
+func TestShareLinkDownloadRetriesIncompleteContent(t *testing.T) {
+	stubRetrySleep(t)
+	target := filepath.Join(t.TempDir(), "report.txt")
+	var offsets []int64
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		getSharedLinkFileRangeFn: func(arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			body := "abc"
+			if offset > 0 {
+				body = "def"
+			}
+			return downloadableSharedLinkFile("report.txt", "/docs/report.txt", "https://example.com/link", 6),
+				io.NopCloser(strings.NewReader(body)), nil
+		},
+	})
+
+	if err := shareLinkDownload(newShareLinkDownloadTestCommand(nil, nil), []string{"https://example.com/link", target}); err != nil {
+		t.Fatalf("shareLinkDownload error: %v", err)
+	}
+	if fmt.Sprint(offsets) != "[0 3]" {
+		t.Fatalf("offsets = %v, want [0 3]", offsets)
+	}
+	assertFileContent(t, target, "abcdef")
+}
+
+func TestShareLinkDownloadVerifiesMetadataSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    uint64
+		content string
+		calls   int
+		want    string
+	}{
+		{name: "short", size: 10, content: "short", calls: maxRetries + 1, want: "incomplete shared link download: got 5 bytes, expected 10"},
+		{name: "long", size: 3, content: "too long", calls: 1, want: "exceeded expected size: got 8 bytes, expected 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubRetrySleep(t)
+			dir := t.TempDir()
+			calls := 0
+			stubSharedLinkClient(t, &mockSharedLinkClient{
+				getSharedLinkFileRangeFn: func(arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+					calls++
+					body := ""
+					if offset == 0 {
+						body = tt.content
+					}
+					return downloadableSharedLinkFile("report.txt", "/docs/report.txt", "https://example.com/link", tt.size),
+						io.NopCloser(strings.NewReader(body)), nil
+				},
+			})
+
+			err := shareLinkDownload(newShareLinkDownloadTestCommand(nil, nil), []string{"https://example.com/link", filepath.Join(dir, "report.txt")})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if calls != tt.calls {
+				t.Fatalf("calls = %d, want %d", calls, tt.calls)
+			}
+			if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+				t.Fatalf("dir entries = %v, want no file or temp file", entries)
+			}
+		})
+	}
+}
+
+func TestShareLinkDownloadFailsWhenFileChangesDuringResume(t *testing.T) {
+	stubRetrySleep(t)
+	dir := t.TempDir()
+	calls := 0
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		getSharedLinkFileRangeFn: func(arg *sharing.GetSharedLinkMetadataArg, offset int64) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+			calls++
+			link := downloadableSharedLinkFile("report.txt", "/docs/report.txt", "https://example.com/link", 20)
+			if calls == 1 {
+				return link, &failingReadCloser{data: []byte("partial")}, nil
+			}
+			link.Rev = "rev2"
+			return link, io.NopCloser(strings.NewReader("rest of the file")), nil
+		},
+	})
+
+	err := shareLinkDownload(newShareLinkDownloadTestCommand(nil, nil), []string{"https://example.com/link", filepath.Join(dir, "report.txt")})
+	if err == nil || !strings.Contains(err.Error(), "changed during download") {
+		t.Fatalf("error = %v, want changed file error", err)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("dir entries = %v, want no file or temp file", entries)
+	}
+}
+
+func TestShareLinkDownloadFolderIfExists(t *testing.T) {
+	tests := []struct {
+		ifExists   string
+		wantErr    string
+		wantLocal  string
+		downloaded string
+	}{
+		{ifExists: putIfExistsOverwrite, wantLocal: "/a.txt", downloaded: "/a.txt,/b.txt"},
+		{ifExists: putIfExistsSkip, wantLocal: "old", downloaded: "/b.txt"},
+		{ifExists: putIfExistsFail, wantErr: "1 error(s)", wantLocal: "old", downloaded: "/b.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifExists, func(t *testing.T) {
+			// An existing target directory receives the folder by name.
+			target := filepath.Join(t.TempDir(), "docs")
+			if err := os.MkdirAll(target, 0755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(target, "a.txt"), []byte("old"), 0644); err != nil {
+				t.Fatalf("write existing file: %v", err)
+			}
+
+			var downloaded []string
+			stubSharedLinkClient(t, &mockSharedLinkClient{
+				getSharedLinkMetadataFn: func(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, error) {
+					return sharedLinkFolder("/docs", "https://example.com/folder"), nil
+				},
+				getSharedLinkFileFn: func(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, io.ReadCloser, error) {
+					downloaded = append(downloaded, arg.Path)
+					return downloadableSharedLinkFile(filepath.Base(arg.Path), arg.Path, "https://example.com/folder", uint64(len(arg.Path))),
+						io.NopCloser(strings.NewReader(arg.Path)), nil
+				},
+			})
+			stubFilesClient(t, &mockFilesClient{
+				listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+					return &files.ListFolderResult{
+						Entries: []files.IsMetadata{
+							&files.FileMetadata{Metadata: files.Metadata{PathDisplay: "/docs/a.txt"}, Size: 6},
+							&files.FileMetadata{Metadata: files.Metadata{PathDisplay: "/docs/b.txt"}, Size: 6},
+						},
+					}, nil
+				},
+			})
+
+			var stderr bytes.Buffer
+			cmd := newShareLinkDownloadTestCommand(nil, &stderr)
+			if err := cmd.Flags().Set("recursive", "true"); err != nil {
+				t.Fatalf("set recursive: %v", err)
+			}
+			if err := cmd.Flags().Set("if-exists", tt.ifExists); err != nil {
+				t.Fatalf("set if-exists: %v", err)
+			}
+
+			err := shareLinkDownload(cmd, []string{"https://example.com/folder", filepath.Dir(target)})
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("shareLinkDownload error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+			if got := strings.Join(downloaded, ","); got != tt.downloaded {
+				t.Fatalf("downloaded = %q, want %q", got, tt.downloaded)
+			}
+			assertFileContent(t, filepath.Join(target, "a.txt"), tt.wantLocal)
+			assertFileContent(t, filepath.Join(target, "b.txt"), "/b.txt")
+			if tt.ifExists == putIfExistsSkip && !strings.Contains(stderr.String(), "Skipping a.txt") {
+				t.Fatalf("stderr = %q, want skip notice", stderr.String())
+			}
+		})
+	}
+}
+
+func TestShareLinkDownloadRejectsInvalidIfExists(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  string
+	}{
+		{name: "unknown value", flags: map[string]string{"recursive": "true", "if-exists": "autorename"}, want: "invalid --if-exists"},
+		{name: "without recursive", flags: map[string]string{"if-exists": putIfExistsSkip}, want: "requires --recursive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubSharedLinkClient(t, &mockSharedLinkClient{})
+			cmd := newShareLinkDownloadTestCommand(nil, nil)
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatalf("set %s: %v", name, err)
+				}
+			}
+
+			err := shareLinkDownload(cmd, []string{"https://example.com/folder"})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+		})
+	}
+}
+
 func TestShareLinkDownloadCommandIsRegistered(t *testing.T) {
 	cmd, _, err := RootCmd.Find([]string{"share-link", "download", "https://example.com/link"})
 	if err != nil {
@@ -902,6 +1131,9 @@ func TestShareLinkDownloadCommandIsRegistered(t *testing.T) {
 	if shareLinkDownloadCmd.Flags().Lookup("recursive") == nil {
 		t.Fatal("share-link download should define --recursive")
 	}
+	if shareLinkDownloadCmd.Flags().Lookup("if-exists") == nil {
+		t.Fatal("share-link download should define --if-exists")
+	}
 }
 
 func newShareLinkDownloadTestCommand(stdout, stderr *bytes.Buffer) *cobra.Command {
@@ -909,6 +1141,7 @@ func newShareLinkDownloadTestCommand(stdout, stderr *bytes.Buffer) *cobra.Comman
 	addSharedLinkPasswordFlags(cmd)
 	cmd.Flags().String("path", "", "")
 	cmd.Flags().BoolP("recursive", "r", false, "")
+	cmd.Flags().String("if-exists", putIfExistsOverwrite, "")
 	cmd.Flags().Bool("verbose", false, "")
 	if stdout != nil {
 		cmd.SetOut(stdout)
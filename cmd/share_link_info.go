@@ -29,12 +29,15 @@ import (
 type shareLinkInfoOptions struct {
 	path     string
 	password sharedLinkPasswordOptions
+	qr       shareLinkQROptions
 }
 
 type shareLinkInfoInput struct {
 	URL      string `json:"url"`
 	Path     string `json:"path,omitempty"`
 	Password bool   `json:"password,omitempty"`
+	QRPNG    string `json:"qr_png,omitempty"`
+	QRSVG    string `json:"qr_svg,omitempty"`
 }
 
 func shareLinkInfo(cmd *cobra.Command, args []string) error {
@@ -70,15 +73,24 @@ func shareLinkInfo(cmd *cobra.Command, args []string) error {
 	if !ok {
 		return withJSONErrorDetails(errors.New("found unknown shared link type"), operationErrorDetails("share_link_info"), urlErrorDetails(url))
 	}
+	qr, err := encodeShareLinkQR(cmd, result.URL, opts.qr)
+	if err != nil {
+		return err
+	}
 
 	return commandOutput(cmd).Render(func(w io.Writer) error {
-		return renderSharedLinkInfo(w, link)
+		if err := renderSharedLinkInfo(w, link); err != nil {
+			return err
+		}
+		return writeShareLinkTerminalQR(w, qr, opts.qr)
 	}, newJSONCommandOperationOutput(
 		cmd,
 		shareLinkInfoInput{
 			URL:      url,
 			Path:     opts.path,
 			Password: opts.password.set,
+			QRPNG:    opts.qr.pngPath,
+			QRSVG:    opts.qr.svgPath,
 		},
 		[]jsonOperationResult{shareLinkJSONOperationResult(shareLinkJSONStatusFound, result)},
 		nil,
@@ -105,6 +117,12 @@ func parseShareLinkInfoOptions(cmd *cobra.Command) (shareLinkInfoOptions, error)
 	}
 	opts.password = password
 
+	qr, err := parseShareLinkQROptions(cmd)
+	if err != nil {
+		return opts, err
+	}
+	opts.qr = qr
+
 	return opts, nil
 }
 
@@ -176,15 +194,19 @@ var shareLinkInfoCmd = &cobra.Command{
 	Use:   "info <url>",
 	Short: "Display shared link information",
 	Long: `Display metadata and permissions for a shared link.
-Use --path to inspect a file or folder inside a folder shared link.`,
+Use --path to inspect a file or folder inside a folder shared link.
+Use --qr to draw the link as a QR code in the terminal, or --qr-png and
+--qr-svg to write it as an image.`,
 	Example: `  dbxcli share-link info https://www.dropbox.com/s/example/file.txt
-  dbxcli share-link info https://www.dropbox.com/s/example/folder --path /nested/file.txt`,
+  dbxcli share-link info https://www.dropbox.com/s/example/folder --path /nested/file.txt
+  dbxcli share-link info https://www.dropbox.com/s/example/file.txt --qr-svg link.svg`,
 	RunE: shareLinkInfo,
 }
 
 func init() {
 	shareLinkInfoCmd.Flags().String("path", "", "Display metadata for a path inside the shared link")
 	addSharedLinkPasswordFlags(shareLinkInfoCmd)
+	addShareLinkQRFlags(shareLinkInfoCmd)
 	shareLinkCmd.AddCommand(shareLinkInfoCmd)
 	enableStructuredOutput(shareLinkInfoCmd)
 }
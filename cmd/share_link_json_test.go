@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/spf13/cobra"
 )
@@ -198,6 +199,48 @@ func TestShareLinkListJSONOutputsResultsAndInput(t *testing.T) {
 	}
 }
 
+func TestShareLinkLsJSONListsResultsAndInput(t *testing.T) {
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				sharedLinkLsFolder("sub", "/docs/sub"),
+				sharedLinkLsFile("report.txt", "/docs/report.txt", 42),
+			}}, nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkLsTestCommand(&stdout)
+	setShareLinkOutputJSON(t, cmd)
+	if err := cmd.Flags().Set("sort", "name"); err != nil {
+		t.Fatalf("set sort: %v", err)
+	}
+	if err := cmd.Flags().Set("password", "secret"); err != nil {
+		t.Fatalf("set password: %v", err)
+	}
+
+	if err := shareLinkLs(cmd, []string{"https://example.com/folder"}); err != nil {
+		t.Fatalf("shareLinkLs error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[shareLinkLsInput, jsonMetadata](t, stdout.Bytes())
+	if got.Input.URL != "https://example.com/folder" || got.Input.Sort != "name" || !got.Input.Password || got.Input.Recursive {
+		t.Fatalf("input = %#v, want listing input", got.Input)
+	}
+	if len(got.Results) != 2 {
+		t.Fatalf("results = %#v, want two entries", got.Results)
+	}
+	if got.Results[0].Status != lsJSONStatusListed || got.Results[0].Kind != "file" || got.Results[0].Result.PathDisplay != "/report.txt" || got.Results[0].Result.Size == nil || *got.Results[0].Result.Size != 42 {
+		t.Fatalf("first result = %#v, want report file", got.Results[0])
+	}
+	if got.Results[1].Kind != "folder" || got.Results[1].Result.PathLower != "/sub" {
+		t.Fatalf("second result = %#v, want sub folder", got.Results[1])
+	}
+	if strings.Contains(stdout.String(), "secret") {
+		t.Fatalf("JSON output = %s, want password omitted", stdout.String())
+	}
+}
+
 func TestDeprecatedShareListLinkJSONIncludesWarning(t *testing.T) {
 	stubSharedLinkClient(t, &mockSharedLinkClient{
 		listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
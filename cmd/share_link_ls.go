@@ -0,0 +1,205 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io"
+	"path"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+type shareLinkLsOptions struct {
+	path      string
+	password  sharedLinkPasswordOptions
+	recursive bool
+	list      listOptions
+}
+
+type shareLinkLsInput struct {
+	URL        string `json:"url"`
+	Path       string `json:"path,omitempty"`
+	Recursive  bool   `json:"recursive"`
+	Long       bool   `json:"long"`
+	Sort       string `json:"sort,omitempty"`
+	Reverse    bool   `json:"reverse"`
+	Time       string `json:"time,omitempty"`
+	TimeFormat string `json:"time_format,omitempty"`
+	Password   bool   `json:"password,omitempty"`
+}
+
+func shareLinkLs(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`share-link ls` requires a `url` argument", argumentErrorDetails("url"))
+	}
+
+	url := args[0]
+	if url == "" {
+		return invalidArgumentsErrorWithDetails("`share-link ls` requires a non-empty URL", mergeJSONErrorDetails(argumentErrorDetails("url"), urlErrorDetails(url)))
+	}
+
+	opts, err := parseShareLinkLsOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	arg := sharing.NewGetSharedLinkMetadataArg(url)
+	if opts.password.set {
+		arg.LinkPassword = opts.password.password
+	}
+
+	entries, err := listSharedLinkEntries(filesNewFunc(config), arg, opts.path, opts.recursive)
+	if err != nil {
+		if isListFolderNotFolderError(err) {
+			return invalidArgumentsErrorWithDetails("shared link path is a file (use `share-link info` to show it)", mergeJSONErrorDetails(operationErrorDetails("share_link_ls"), urlErrorDetails(url)))
+		}
+		return withJSONErrorDetails(err, urlErrorDetails(url), operationErrorDetails("share_link_ls"))
+	}
+	sortEntries(entries, opts.list)
+
+	metadata, err := jsonMetadataListFromLsEntries(entries)
+	if err != nil {
+		return err
+	}
+	return commandOutput(cmd).Render(func(w io.Writer) error {
+		return renderLsResults(w, entries, opts.list)
+	}, newJSONCommandOperationOutput(
+		cmd,
+		shareLinkLsInput{
+			URL:        url,
+			Path:       opts.path,
+			Recursive:  opts.recursive,
+			Long:       opts.list.long,
+			Sort:       opts.list.sortBy,
+			Reverse:    opts.list.reverse,
+			Time:       opts.list.timeField,
+			TimeFormat: opts.list.timeFormat,
+			Password:   opts.password.set,
+		},
+		newJSONMetadataOperationResults(lsJSONStatusListed, metadata),
+		nil,
+	))
+}
+
+func parseShareLinkLsOptions(cmd *cobra.Command) (shareLinkLsOptions, error) {
+	var opts shareLinkLsOptions
+
+	if localFlagChanged(cmd, "path") {
+		pathArg, err := localStringFlag(cmd, "path")
+		if err != nil {
+			return opts, err
+		}
+		if pathArg == "" {
+			return opts, invalidArgumentsErrorWithDetails("`--path` requires a non-empty path", flagErrorDetails("path"))
+		}
+		dropboxPath, err := validatePath(pathArg)
+		if err != nil {
+			return opts, err
+		}
+		opts.path = dropboxPath
+	}
+
+	password, err := sharedLinkPasswordFromFlags(cmd)
+	if err != nil {
+		return opts, err
+	}
+	opts.password = password
+
+	opts.recursive, err = localBoolFlag(cmd, "recursive")
+	if err != nil {
+		return opts, err
+	}
+
+	opts.list, err = parseListOptions(cmd)
+	if err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// listSharedLinkEntries lists folder inside a shared link. list_folder does
+// not recurse into shared links, so subfolders are walked here. Entry paths
+// are rewritten relative to the link root, since the API may report them
+// relative to the linked folder's own parent or not at all.
+func listSharedLinkEntries(dbx filesClient, arg *sharing.GetSharedLinkMetadataArg, folder string, recursive bool) ([]files.IsMetadata, error) {
+	var entries []files.IsMetadata
+	queue := []string{folder}
+
+	for len(queue) > 0 {
+		relFolder := queue[0]
+		queue = queue[1:]
+
+		listed, err := listSharedLinkFolderEntries(dbx, arg, relFolder)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range listed {
+			switch f := entry.(type) {
+			case *files.FolderMetadata:
+				setSharedLinkEntryPath(&f.Metadata, relFolder)
+				if recursive {
+					queue = append(queue, f.PathDisplay)
+				}
+			case *files.FileMetadata:
+				setSharedLinkEntryPath(&f.Metadata, relFolder)
+			default:
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func setSharedLinkEntryPath(metadata *files.Metadata, relFolder string) {
+	name := metadata.Name
+	if name == "" {
+		name = path.Base(metadata.PathDisplay)
+	}
+	metadata.PathDisplay = path.Join("/", relFolder, name)
+	metadata.PathLower = strings.ToLower(metadata.PathDisplay)
+}
+
+var shareLinkLsCmd = &cobra.Command{
+	Use:   "ls <url>",
+	Short: "List the contents of a folder shared link",
+	Long: `List the files and folders inside a Dropbox folder shared link without
+downloading them. Paths are shown relative to the shared folder.
+  - Use --path to list a subfolder of the shared link.
+  - Use --recursive (-R) to list all subfolders.
+`,
+	Example: `  dbxcli share-link ls https://www.dropbox.com/scl/fo/example/folder
+  dbxcli share-link ls -l --sort size https://www.dropbox.com/scl/fo/example/folder
+  dbxcli share-link ls -R --path /photos https://www.dropbox.com/scl/fo/example/folder`,
+	RunE: shareLinkLs,
+}
+
+func init() {
+	addSharedLinkPasswordFlags(shareLinkLsCmd)
+	shareLinkLsCmd.Flags().String("path", "", "List a subfolder inside the folder shared link")
+	shareLinkLsCmd.Flags().BoolP("recursive", "R", false, "Recursively list all subfolders")
+	shareLinkLsCmd.Flags().BoolP("long", "l", false, "Long listing")
+	shareLinkLsCmd.Flags().String("sort", "", "Sort by: name, size, time, type")
+	shareLinkLsCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
+	shareLinkLsCmd.Flags().String("time", "server", "Time field: server, client")
+	shareLinkLsCmd.Flags().String("time-format", "", "Time format: short (2006-01-02 15:04), rfc3339")
+	shareLinkCmd.AddCommand(shareLinkLsCmd)
+	enableStructuredOutput(shareLinkLsCmd)
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestShareLinkLsListsFolderWithLinkAndPassword(t *testing.T) {
+	var listed []string
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			listed = append(listed, arg.Path)
+			if arg.SharedLink == nil || arg.SharedLink.Url != "https://example.com/folder" || arg.SharedLink.Password != "secret" {
+				t.Fatalf("SharedLink = %#v, want URL and password", arg.SharedLink)
+			}
+			if arg.Recursive {
+				t.Fatal("Recursive = true, want manual recursion for shared links")
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				sharedLinkLsFile("b.txt", "/docs/b.txt", 2),
+				sharedLinkLsFolder("sub", "/docs/sub"),
+				sharedLinkLsFile("a.txt", "", 1),
+			}}, nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkLsTestCommand(&stdout)
+	if err := cmd.Flags().Set("password", "secret"); err != nil {
+		t.Fatalf("set password: %v", err)
+	}
+
+	if err := shareLinkLs(cmd, []string{"https://example.com/folder"}); err != nil {
+		t.Fatalf("shareLinkLs error: %v", err)
+	}
+	if len(listed) != 1 || listed[0] != "" {
+		t.Fatalf("listed paths = %q, want only the root", listed)
+	}
+	if got := strings.Fields(stdout.String()); strings.Join(got, " ") != "/b.txt /sub /a.txt" {
+		t.Fatalf("stdout = %q, want paths relative to the link root", stdout.String())
+	}
+}
+
+func TestShareLinkLsRecursivePathSortedLong(t *testing.T) {
+	var listed []string
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			listed = append(listed, arg.Path)
+			switch arg.Path {
+			case "/photos":
+				return &files.ListFolderResult{
+					Entries: []files.IsMetadata{sharedLinkLsFile("big.jpg", "", 2048)},
+					HasMore: true,
+					Cursor:  "cursor",
+				}, nil
+			case "/photos/2024":
+				return &files.ListFolderResult{Entries: []files.IsMetadata{sharedLinkLsFile("small.jpg", "", 10)}}, nil
+			}
+			t.Fatalf("unexpected list path %q", arg.Path)
+			return nil, nil
+		},
+		listFolderContinueFn: func(arg *files.ListFolderContinueArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{sharedLinkLsFolder("2024", "")}}, nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkLsTestCommand(&stdout)
+	for name, value := range map[string]string{"path": "photos", "recursive": "true", "long": "true", "sort": "size", "time-format": "rfc3339"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set %s: %v", name, err)
+		}
+	}
+
+	if err := shareLinkLs(cmd, []string{"https://example.com/folder"}); err != nil {
+		t.Fatalf("shareLinkLs error: %v", err)
+	}
+	if strings.Join(listed, ",") != "/photos,/photos/2024" {
+		t.Fatalf("listed paths = %q, want path then subfolder", strings.Join(listed, ","))
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "Revision") {
+		t.Fatalf("stdout = %q, want header and three entries", stdout.String())
+	}
+	for i, want := range []string{"/photos/2024", "/photos/2024/small.jpg", "/photos/big.jpg"} {
+		if !strings.HasSuffix(strings.TrimSpace(lines[i+1]), want) {
+			t.Fatalf("line %d = %q, want %s sorted by size", i+1, lines[i+1], want)
+		}
+	}
+	if !strings.Contains(stdout.String(), "2026-01-02T03:04:05Z") {
+		t.Fatalf("stdout = %q, want rfc3339 times", stdout.String())
+	}
+}
+
+func TestShareLinkLsRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+		want  string
+	}{
+		{name: "missing url", args: nil, want: "requires a `url` argument"},
+		{name: "empty url", args: []string{""}, want: "non-empty URL"},
+		{name: "empty path", args: []string{"https://example.com/folder"}, flags: map[string]string{"path": ""}, want: "`--path` requires a non-empty path"},
+		{name: "bad sort", args: []string{"https://example.com/folder"}, flags: map[string]string{"sort": "owner"}, want: "invalid --sort"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubFilesClient(t, &mockFilesClient{
+				listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+					t.Fatal("ListFolder should not be called")
+					return nil, nil
+				},
+			})
+			cmd := newShareLinkLsTestCommand(nil)
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatalf("set %s: %v", name, err)
+				}
+			}
+
+			err := shareLinkLs(cmd, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+		})
+	}
+}
+
+func TestShareLinkLsReportsFileLinks(t *testing.T) {
+	stubFilesClient(t, &mockFilesClient{
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return nil, files.ListFolderAPIError{EndpointError: &files.ListFolderError{
+				Tagged: dropbox.Tagged{Tag: files.ListFolderErrorPath},
+				Path:   &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFolder}},
+			}}
+		},
+	})
+
+	err := shareLinkLs(newShareLinkLsTestCommand(nil), []string{"https://example.com/report"})
+	if err == nil || !strings.Contains(err.Error(), "use `share-link info`") {
+		t.Fatalf("error = %v, want file link hint", err)
+	}
+}
+
+func TestShareLinkLsCommandIsRegistered(t *testing.T) {
+	cmd, _, err := RootCmd.Find([]string{"share-link", "ls", "https://example.com/folder"})
+	if err != nil {
+		t.Fatalf("find share-link ls: %v", err)
+	}
+	if cmd != shareLinkLsCmd {
+		t.Fatalf("share-link ls resolved to %q", cmd.CommandPath())
+	}
+	for _, name := range []string{"password", "password-prompt", "password-file", "path", "recursive", "long", "sort", "reverse", "time", "time-format"} {
+		if shareLinkLsCmd.Flags().Lookup(name) == nil {
+			t.Fatalf("share-link ls should define --%s", name)
+		}
+	}
+	if flag := shareLinkLsCmd.Flags().ShorthandLookup("R"); flag == nil || flag.Name != "recursive" {
+		t.Fatal("share-link ls should accept -R for --recursive")
+	}
+}
+
+func newShareLinkLsTestCommand(stdout *bytes.Buffer) *cobra.Command {
+	cmd := &cobra.Command{}
+	addSharedLinkPasswordFlags(cmd)
+	cmd.Flags().String("path", "", "")
+	cmd.Flags().BoolP("recursive", "R", false, "")
+	cmd.Flags().BoolP("long", "l", false, "")
+	cmd.Flags().String("sort", "", "")
+	cmd.Flags().BoolP("reverse", "r", false, "")
+	cmd.Flags().String("time", "server", "")
+	cmd.Flags().String("time-format", "", "")
+	if stdout != nil {
+		cmd.SetOut(stdout)
+	}
+	return cmd
+}
+
+func sharedLinkLsFile(name, pathDisplay string, size uint64) *files.FileMetadata {
+	modified := dropbox.DBXTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	file := files.NewFileMetadata(name, "id:"+name, modified, modified, "rev", size)
+	file.PathDisplay = pathDisplay
+	return file
+}
+
+func sharedLinkLsFolder(name, pathDisplay string) *files.FolderMetadata {
+	folder := files.NewFolderMetadata(name, "id:"+name)
+	folder.PathDisplay = pathDisplay
+	return folder
+}
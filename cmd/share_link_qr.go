@@ -0,0 +1,119 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dbxcli/v3/internal/qrcode"
+	"github.com/spf13/cobra"
+)
+
+// shareLinkQROptions selects where a shared link URL is drawn as a QR code.
+type shareLinkQROptions struct {
+	terminal bool
+	pngPath  string
+	svgPath  string
+}
+
+func addShareLinkQRFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("qr", false, "Draw the link URL as a QR code in the terminal")
+	cmd.Flags().String("qr-png", "", "Write the link URL as a QR code PNG image to a file")
+	cmd.Flags().String("qr-svg", "", "Write the link URL as a QR code SVG image to a file")
+}
+
+func parseShareLinkQROptions(cmd *cobra.Command) (shareLinkQROptions, error) {
+	var opts shareLinkQROptions
+	var err error
+	if opts.terminal, err = localBoolFlag(cmd, "qr"); err != nil {
+		return opts, err
+	}
+	if opts.pngPath, err = localStringFlag(cmd, "qr-png"); err != nil {
+		return opts, err
+	}
+	if opts.svgPath, err = localStringFlag(cmd, "qr-svg"); err != nil {
+		return opts, err
+	}
+	if opts.terminal && commandOutputFormat(cmd) != output.FormatText {
+		return opts, invalidArgumentsErrorWithDetails("`--qr` cannot be used with `--output json`; use `--qr-png` or `--qr-svg`", flagsErrorDetails("qr", outputFlag))
+	}
+	return opts, nil
+}
+
+func (opts shareLinkQROptions) enabled() bool {
+	return opts.terminal || opts.pngPath != "" || opts.svgPath != ""
+}
+
+// flagNames returns the QR flags that are set, for error details.
+func (opts shareLinkQROptions) flagNames() []string {
+	var names []string
+	if opts.terminal {
+		names = append(names, "qr")
+	}
+	if opts.pngPath != "" {
+		names = append(names, "qr-png")
+	}
+	if opts.svgPath != "" {
+		names = append(names, "qr-svg")
+	}
+	return names
+}
+
+// encodeShareLinkQR encodes url and writes the requested image files. The
+// returned code is nil when no QR output was requested.
+func encodeShareLinkQR(cmd *cobra.Command, url string, opts shareLinkQROptions) (*qrcode.Code, error) {
+	if !opts.enabled() {
+		return nil, nil
+	}
+	code, err := qrcode.Encode(url)
+	if err != nil {
+		return nil, commandFailedErrorfWithDetails("encode QR code: %v", mergeJSONErrorDetails(flagsErrorDetails(opts.flagNames()...), urlErrorDetails(url)), err)
+	}
+	if opts.pngPath != "" {
+		if err := writeShareLinkQRFile(opts.pngPath, "qr-png", func(w io.Writer) error { return code.WritePNG(w, qrcode.DefaultPNGScale) }); err != nil {
+			return nil, err
+		}
+		commandVerboseStatus(cmd, "Wrote QR code to %s", opts.pngPath)
+	}
+	if opts.svgPath != "" {
+		if err := writeShareLinkQRFile(opts.svgPath, "qr-svg", code.WriteSVG); err != nil {
+			return nil, err
+		}
+		commandVerboseStatus(cmd, "Wrote QR code to %s", opts.svgPath)
+	}
+	return code, nil
+}
+
+func writeShareLinkQRFile(filePath, flag string, write func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		return withJSONErrorDetails(err, flagValueErrorDetails(flag, filePath))
+	}
+	if err := os.WriteFile(filePath, buf.Bytes(), 0666); err != nil {
+		return withJSONErrorDetails(err, flagValueErrorDetails(flag, filePath))
+	}
+	return nil
+}
+
+// writeShareLinkTerminalQR draws code below the text output when --qr is set.
+func writeShareLinkTerminalQR(w io.Writer, code *qrcode.Code, opts shareLinkQROptions) error {
+	if !opts.terminal || code == nil {
+		return nil
+	}
+	return code.WriteTerminal(w)
+}
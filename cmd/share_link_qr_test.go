@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/spf13/cobra"
+)
+
+func TestShareLinkCreateQRDrawsCodeBelowURL(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			return sharedLinkFile(arg.Path, "https://www.dropbox.com/s/abc123/flyer.pdf"), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	addShareLinkQRFlags(cmd)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"qr": "true"})
+	if err := shareLinkCreate(cmd, []string{"/flyer.pdf"}); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	url, qr, ok := strings.Cut(stdout.String(), "\n")
+	if !ok || url != "https://www.dropbox.com/s/abc123/flyer.pdf" {
+		t.Fatalf("first line = %q, want the link URL", url)
+	}
+	if !strings.HasPrefix(qr, strings.Repeat("█", 10)) || !strings.ContainsAny(qr, "▀▄") {
+		t.Fatalf("QR output = %q, want half-block QR code", qr)
+	}
+}
+
+func TestShareLinkCreateQRWritesImages(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+			return sharedLinkFile(arg.Path, "https://www.dropbox.com/s/abc123/flyer.pdf"), nil
+		},
+	})
+	dir := t.TempDir()
+	pngPath, svgPath := filepath.Join(dir, "flyer.png"), filepath.Join(dir, "flyer.svg")
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkCreateTestCommand(&stdout)
+	addShareLinkQRFlags(cmd)
+	setShareLinkCreateFlags(t, cmd, map[string]string{"qr-png": pngPath, "qr-svg": svgPath, outputFlag: "json"})
+	if err := shareLinkCreate(cmd, []string{"/flyer.pdf"}); err != nil {
+		t.Fatalf("shareLinkCreate error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[shareLinkCreateInput, shareLinkJSONMetadata](t, stdout.Bytes())
+	if got.Input.QRPNG != pngPath || got.Input.QRSVG != svgPath {
+		t.Fatalf("input = %+v, want QR image paths", got.Input)
+	}
+	pngFile, err := os.Open(pngPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pngFile.Close()
+	if _, err := png.Decode(pngFile); err != nil {
+		t.Fatalf("decode QR PNG: %v", err)
+	}
+	svg, err := os.ReadFile(svgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(svg), "<svg") {
+		t.Fatalf("QR SVG = %s", svg)
+	}
+}
+
+func TestShareLinkQRRejectsUnsupportedCombinations(t *testing.T) {
+	for name, tt := range map[string]struct {
+		flags map[string]string
+		args  []string
+	}{
+		"terminal with json": {flags: map[string]string{"qr": "true", outputFlag: "json"}, args: []string{"/a.pdf"}},
+		"dry run":            {flags: map[string]string{"qr-png": "a.png", dryRunFlagName: "true"}, args: []string{"/a.pdf"}},
+		"from file":          {flags: map[string]string{"qr-svg": "a.svg", "from-file": writeShareLinkCreateManifest(t, "/a.pdf\n")}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stubSharedLinkClient(t, &mockSharedLinkClient{
+				createSharedLinkWithSettingsFn: func(arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+					t.Fatalf("CreateSharedLinkWithSettings called: %v", arg)
+					return nil, nil
+				},
+			})
+			cmd := newShareLinkCreateTestCommand(nil)
+			addShareLinkQRFlags(cmd)
+			setShareLinkCreateFlags(t, cmd, tt.flags)
+			if err := shareLinkCreate(cmd, tt.args); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+				t.Fatalf("shareLinkCreate error = %v, want invalid_arguments", err)
+			}
+		})
+	}
+}
+
+func TestShareLinkInfoQRWritesSVG(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		getSharedLinkMetadataFn: func(arg *sharing.GetSharedLinkMetadataArg) (sharing.IsSharedLinkMetadata, error) {
+			return sharedLinkFile("/docs/report.txt", arg.Url), nil
+		},
+	})
+	svgPath := filepath.Join(t.TempDir(), "link.svg")
+
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+	addShareLinkQRFlags(cmd)
+	if err := cmd.Flags().Set("qr-svg", svgPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := shareLinkInfo(cmd, []string{"https://www.dropbox.com/s/abc123"}); err != nil {
+		t.Fatalf("shareLinkInfo error: %v", err)
+	}
+	if _, err := os.Stat(svgPath); err != nil {
+		t.Fatalf("QR SVG not written: %v", err)
+	}
+	if strings.ContainsAny(stdout.String(), "▀▄") {
+		t.Fatalf("stdout = %q, want no terminal QR without --qr", stdout.String())
+	}
+}
@@ -18,20 +18,43 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/dropbox/dbxcli/v3/internal/output"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/spf13/cobra"
 )
 
 type shareLinkRevokeOptions struct {
-	path   string
-	dryRun bool
+	path     string
+	allUnder string
+	filter   shareLinkRevokeFilter
+	dryRun   bool
 }
 
+// shareLinkRevokeFilter selects which links --all-under revokes. Zero values
+// match every link.
+type shareLinkRevokeFilter struct {
+	audience      string
+	expired       bool
+	noPassword    bool
+	allowDownload bool
+	now           time.Time
+}
+
+// shareLinkRevokeFilterFlags are the flags that only apply with --all-under.
+var shareLinkRevokeFilterFlags = []string{"audience", "expired", "no-password", "allow-download"}
+
 type shareLinkRevokeInput struct {
-	URL    string `json:"url,omitempty"`
-	Path   string `json:"path,omitempty"`
-	DryRun bool   `json:"dry_run,omitempty"`
+	URL           string `json:"url,omitempty"`
+	Path          string `json:"path,omitempty"`
+	AllUnder      string `json:"all_under,omitempty"`
+	Audience      string `json:"audience,omitempty"`
+	Expired       bool   `json:"expired,omitempty"`
+	NoPassword    bool   `json:"no_password,omitempty"`
+	AllowDownload bool   `json:"allow_download,omitempty"`
+	DryRun        bool   `json:"dry_run,omitempty"`
 }
 
 type shareLinkRevokeResultInput struct {
@@ -49,12 +72,20 @@ func shareLinkRevoke(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if opts.allUnder != "" {
+		revoked, warnings, err := revokeSharedLinksUnder(cmd, opts.allUnder, opts.filter, opts.dryRun)
+		if err != nil {
+			return err
+		}
+		return renderShareLinkRevokeOutputWithWarnings(cmd, newShareLinkRevokeAllUnderInput(opts), shareLinkRevokeOperationResults(revoked, opts.dryRun), warnings)
+	}
+
 	if opts.path != "" {
-		revoked, err := revokeSharedLinksForPath(cmd, opts.path, opts.dryRun)
+		revoked, warnings, err := revokeSharedLinksForPath(cmd, opts.path, opts.dryRun)
 		if err != nil {
 			return err
 		}
-		return renderShareLinkRevokeOutput(cmd, shareLinkRevokeInput{Path: opts.path, DryRun: opts.dryRun}, shareLinkRevokeOperationResults(revoked, opts.dryRun))
+		return renderShareLinkRevokeOutputWithWarnings(cmd, shareLinkRevokeInput{Path: opts.path, DryRun: opts.dryRun}, shareLinkRevokeOperationResults(revoked, opts.dryRun), warnings)
 	}
 
 	if len(args) != 1 {
@@ -103,6 +134,15 @@ func parseShareLinkRevokeOptions(cmd *cobra.Command, args []string) (shareLinkRe
 	}
 	opts.dryRun = dryRun
 
+	if localFlagChanged(cmd, "all-under") {
+		return parseShareLinkRevokeAllUnderOptions(cmd, args, opts)
+	}
+	for _, name := range shareLinkRevokeFilterFlags {
+		if localFlagChanged(cmd, name) {
+			return opts, invalidArgumentsErrorfWithDetails("`--%s` requires `--all-under`", flagsErrorDetails(name, "all-under"), name)
+		}
+	}
+
 	if !localFlagChanged(cmd, "path") {
 		return opts, nil
 	}
@@ -133,7 +173,104 @@ func parseShareLinkRevokeOptions(cmd *cobra.Command, args []string) (shareLinkRe
 	return opts, nil
 }
 
-func revokeSharedLinksForPath(cmd *cobra.Command, path string, dryRun bool) ([]shareLinkRevokeResult, error) {
+func parseShareLinkRevokeAllUnderOptions(cmd *cobra.Command, args []string, opts shareLinkRevokeOptions) (shareLinkRevokeOptions, error) {
+	if len(args) != 0 {
+		return opts, invalidArgumentsErrorWithDetails("`--all-under` cannot be used with a shared link URL", mergeJSONErrorDetails(operationErrorDetails("share_link_revoke"), flagErrorDetails("all-under"), argumentErrorDetails("url")))
+	}
+	if localFlagChanged(cmd, "path") {
+		return opts, invalidArgumentsErrorWithDetails("`--all-under` cannot be used with `--path`", flagsErrorDetails("all-under", "path"))
+	}
+	folder, err := localStringFlag(cmd, "all-under")
+	if err != nil {
+		return opts, err
+	}
+	if folder == "" {
+		return opts, invalidArgumentsErrorWithDetails("`--all-under` requires a non-empty folder", flagErrorDetails("all-under"))
+	}
+	dropboxPath, err := validatePath(folder)
+	if err != nil {
+		return opts, err
+	}
+	opts.allUnder = dropboxPath
+	if opts.allUnder == "" {
+		opts.allUnder = "/"
+	}
+
+	opts.filter.now = time.Now()
+	if localFlagChanged(cmd, "audience") {
+		audience, err := shareLinkAudienceFlag(cmd)
+		if err != nil {
+			return opts, err
+		}
+		opts.filter.audience = audience.Tag
+	}
+	opts.filter.expired, _ = cmd.Flags().GetBool("expired")
+	opts.filter.noPassword, _ = cmd.Flags().GetBool("no-password")
+	opts.filter.allowDownload, _ = cmd.Flags().GetBool("allow-download")
+	return opts, nil
+}
+
+func newShareLinkRevokeAllUnderInput(opts shareLinkRevokeOptions) shareLinkRevokeInput {
+	return shareLinkRevokeInput{
+		AllUnder:      opts.allUnder,
+		Audience:      opts.filter.audience,
+		Expired:       opts.filter.expired,
+		NoPassword:    opts.filter.noPassword,
+		AllowDownload: opts.filter.allowDownload,
+		DryRun:        opts.dryRun,
+	}
+}
+
+// matches reports whether a shared link passes every filter.
+func (f shareLinkRevokeFilter) matches(link shareLinkJSONMetadata) bool {
+	permissions := link.Permissions
+	if permissions == nil {
+		permissions = &shareLinkJSONPermissions{}
+	}
+	if f.audience != "" && shareLinkAudience(permissions) != f.audience {
+		return false
+	}
+	if f.expired && !jsonTimeBefore(link.Expires, f.now) {
+		return false
+	}
+	if f.noPassword && (permissions.RequirePassword || permissions.ResolvedVisibility == sharing.ResolvedVisibilityPassword) {
+		return false
+	}
+	if f.allowDownload && !permissions.AllowDownload {
+		return false
+	}
+	return true
+}
+
+// shareLinkAudience returns who can open a link, preferring Dropbox's
+// effective audience and falling back to the older resolved visibility.
+func shareLinkAudience(permissions *shareLinkJSONPermissions) string {
+	if permissions.EffectiveAudience != "" {
+		return permissions.EffectiveAudience
+	}
+	switch permissions.ResolvedVisibility {
+	case sharing.ResolvedVisibilityPublic, sharing.ResolvedVisibilityPassword:
+		return sharing.LinkAudiencePublic
+	case sharing.ResolvedVisibilityTeamOnly, sharing.ResolvedVisibilityTeamAndPassword:
+		return sharing.LinkAudienceTeam
+	case sharing.ResolvedVisibilitySharedFolderOnly:
+		return sharing.LinkAudienceMembers
+	case sharing.ResolvedVisibilityNoOne, sharing.ResolvedVisibilityOnlyYou:
+		return sharing.LinkAudienceNoOne
+	default:
+		return ""
+	}
+}
+
+func jsonTimeBefore(value *string, cutoff time.Time) bool {
+	if value == nil {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	return err == nil && t.Before(cutoff)
+}
+
+func revokeSharedLinksForPath(cmd *cobra.Command, path string, dryRun bool) ([]shareLinkRevokeResult, []jsonWarning, error) {
 	arg := sharing.NewListSharedLinksArg()
 	arg.Path = path
 	arg.DirectOnly = true
@@ -141,25 +278,82 @@ func revokeSharedLinksForPath(cmd *cobra.Command, path string, dryRun bool) ([]s
 	dbx := newSharedLinkClient(config)
 	links, err := listSharedLinks(dbx, arg)
 	if err != nil {
-		return nil, withJSONErrorDetails(err, operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
+		return nil, nil, withJSONErrorDetails(err, operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
 	}
 	if len(links) == 0 {
-		return nil, withJSONErrorDetails(fmt.Errorf("no direct shared links found for %q", path), operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
+		return nil, nil, withJSONErrorDetails(fmt.Errorf("no direct shared links found for %q", path), operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
+	}
+
+	revoked, warnings, err := revokeSharedLinks(dbx, links, nil, dryRun, pathErrorDetails(path))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !dryRun {
+		commandVerboseStatus(cmd, "Revoked %d shared links for %s", len(revoked), path)
+	}
+	return revoked, warnings, nil
+}
+
+// revokeSharedLinksUnder revokes every shared link the user created for
+// folder or anything inside it that matches filter. It lists all of the
+// user's links because list_shared_links cannot list a folder's descendants.
+func revokeSharedLinksUnder(cmd *cobra.Command, folder string, filter shareLinkRevokeFilter, dryRun bool) ([]shareLinkRevokeResult, []jsonWarning, error) {
+	dbx := newSharedLinkClient(config)
+	links, err := listSharedLinks(dbx, sharing.NewListSharedLinksArg())
+	if err != nil {
+		return nil, nil, withJSONErrorDetails(err, operationErrorDetails("share_link_revoke"), pathErrorDetails(folder))
 	}
 
+	prefix := strings.ToLower(strings.TrimSuffix(folder, "/")) + "/"
+	revoked, warnings, err := revokeSharedLinks(dbx, links, func(metadata shareLinkJSONMetadata) bool {
+		return metadata.PathLower != "" && strings.HasPrefix(metadata.PathLower+"/", prefix) && filter.matches(metadata)
+	}, dryRun, pathErrorDetails(folder))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !dryRun {
+		commandVerboseStatus(cmd, "Revoked %d shared links under %s", len(revoked), folder)
+	}
+	return revoked, warnings, nil
+}
+
+// revokeSharedLinks revokes each link that match accepts, or every link when
+// match is nil. In dry-run mode it only reports the links it would revoke.
+// Links fail independently: each failure becomes a warning, and the command
+// fails only when every targeted link did. A sweep skips links it cannot
+// read, since they cannot be matched against its filters.
+func revokeSharedLinks(dbx sharedLinkClient, links []sharing.IsSharedLinkMetadata, match func(shareLinkJSONMetadata) bool, dryRun bool, details map[string]any) ([]shareLinkRevokeResult, []jsonWarning, error) {
 	revoked := make([]shareLinkRevokeResult, 0, len(links))
+	var warnings []jsonWarning
+	var failures []error
+	fail := func(path string, err error) {
+		failures = append(failures, err)
+		warnings = append(warnings, jsonWarning{Code: jsonWarningCodeShareLinkRevokeFailed, Message: err.Error(), Path: path})
+	}
+
 	for _, link := range links {
-		url, ok := sharedLinkURL(link)
+		metadata, ok := shareLinkJSONMetadataFromDropbox(link)
 		if !ok {
-			return nil, withJSONErrorDetails(errors.New("shared link response did not include a URL"), operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
+			if match == nil {
+				fail("", withJSONErrorDetails(errors.New("found unknown shared link type"), operationErrorDetails("share_link_revoke"), details))
+			}
+			continue
 		}
-		metadata, ok := shareLinkJSONMetadataFromDropbox(link)
+		if match != nil && !match(metadata) {
+			continue
+		}
+		url, ok := sharedLinkURL(link)
 		if !ok {
-			return nil, withJSONErrorDetails(errors.New("found unknown shared link type"), operationErrorDetails("share_link_revoke"), pathErrorDetails(path))
+			fail(metadata.PathLower, withJSONErrorDetails(errors.New("shared link response did not include a URL"), operationErrorDetails("share_link_revoke"), details))
+			continue
 		}
 		if !dryRun {
-			if err := dbx.RevokeSharedLinkContext(currentContext(), sharing.NewRevokeSharedLinkArg(url)); err != nil {
-				return nil, withJSONErrorDetails(fmt.Errorf("revoke shared link %s: %w", url, err), urlErrorDetails(url), operationErrorDetails("share_link_revoke"))
+			err := retryWithBackoff(func() error {
+				return dbx.RevokeSharedLinkContext(currentContext(), sharing.NewRevokeSharedLinkArg(url))
+			})
+			if err != nil {
+				fail(metadata.PathLower, withJSONErrorDetails(fmt.Errorf("revoke shared link %s: %w", url, err), urlErrorDetails(url), operationErrorDetails("share_link_revoke")))
+				continue
 			}
 		}
 		revoked = append(revoked, shareLinkRevokeResult{
@@ -167,15 +361,23 @@ func revokeSharedLinksForPath(cmd *cobra.Command, path string, dryRun bool) ([]s
 			Link: &metadata,
 		})
 	}
-
-	if !dryRun {
-		commandVerboseStatus(cmd, "Revoked %d shared links for %s", len(links), path)
+	if len(failures) > 0 && len(revoked) == 0 {
+		return nil, nil, batchFailuresError("share_link_revoke", failures)
 	}
-	return revoked, nil
+	return revoked, warnings, nil
 }
 
 func renderShareLinkRevokeOutput(cmd *cobra.Command, input shareLinkRevokeInput, results []jsonOperationResult) error {
-	return renderOperation(cmd, input, results, nil, func(w io.Writer) error {
+	return renderShareLinkRevokeOutputWithWarnings(cmd, input, results, nil)
+}
+
+func renderShareLinkRevokeOutputWithWarnings(cmd *cobra.Command, input shareLinkRevokeInput, results []jsonOperationResult, warnings []jsonWarning) error {
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+	return renderOperation(cmd, input, results, warnings, func(w io.Writer) error {
 		if !input.DryRun {
 			return nil
 		}
@@ -195,14 +397,28 @@ func renderShareLinkRevokeOutput(cmd *cobra.Command, input shareLinkRevokeInput,
 var shareLinkRevokeCmd = &cobra.Command{
 	Use:   "revoke [url]",
 	Short: "Revoke shared links",
-	Long:  "Revoke a shared link by URL, or revoke all direct shared links for a Dropbox path with --path.",
+	Long: `Revoke a shared link by URL, or revoke all direct shared links for a Dropbox path with --path.
+
+With --all-under, revoke every shared link you created for a folder or
+anything inside it. Narrow the sweep with --audience, --expired,
+--no-password, and --allow-download; a link must match every filter given.
+Dropbox does not report when a link was created, so links cannot be filtered
+by age. Preview a sweep with --dry-run. With --path or --all-under, links
+that cannot be revoked are reported as warnings; the command fails only when
+none could be revoked.`,
 	Example: `  dbxcli share-link revoke https://www.dropbox.com/s/example/file.txt
-  dbxcli share-link revoke --path /file.txt`,
+  dbxcli share-link revoke --path /file.txt
+  dbxcli share-link revoke --all-under /Clients --audience public --dry-run`,
 	RunE: shareLinkRevoke,
 }
 
 func init() {
 	shareLinkRevokeCmd.Flags().String("path", "", "Revoke direct shared links for a Dropbox path")
+	shareLinkRevokeCmd.Flags().String("all-under", "", "Revoke your shared links for a folder and everything inside it")
+	shareLinkRevokeCmd.Flags().String("audience", "", "With --all-under, only revoke links open to: public, team, members, or no-one")
+	shareLinkRevokeCmd.Flags().Bool("expired", false, "With --all-under, only revoke expired links")
+	shareLinkRevokeCmd.Flags().Bool("no-password", false, "With --all-under, only revoke links without a password")
+	shareLinkRevokeCmd.Flags().Bool("allow-download", false, "With --all-under, only revoke links that allow downloads")
 	addDryRunFlag(shareLinkRevokeCmd)
 	shareLinkCmd.AddCommand(shareLinkRevokeCmd)
 	enableStructuredOutput(shareLinkRevokeCmd)
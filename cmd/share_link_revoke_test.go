@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	dbxauth "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/spf13/cobra"
 )
@@ -507,9 +510,188 @@ func TestShareLinkRevokeDoesNotBreakOtherCommands(t *testing.T) {
 	}
 }
 
+func TestShareLinkRevokeAllUnderRevokesMatchingLinksBelowFolder(t *testing.T) {
+	link := func(pathLower, url, audience string) *sharing.FileLinkMetadata {
+		l := sharedLinkFile(pathLower, url)
+		l.LinkPermissions = &sharing.LinkPermissions{EffectiveAudience: &sharing.LinkAudience{Tagged: dropbox.Tagged{Tag: audience}}}
+		return l
+	}
+	var listArg *sharing.ListSharedLinksArg
+	var revoked []string
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+			listArg = arg
+			return sharing.NewListSharedLinksResult([]sharing.IsSharedLinkMetadata{
+				link("/clients/acme/plan.pdf", "https://example.com/plan-public", sharing.LinkAudiencePublic),
+				link("/clients/acme/notes.txt", "https://example.com/notes-public", sharing.LinkAudiencePublic),
+				link("/clients/globex.pdf", "https://example.com/team", sharing.LinkAudienceTeam),
+				link("/clients-archive/plan.pdf", "https://example.com/sibling", sharing.LinkAudiencePublic),
+			}, false), nil
+		},
+		revokeSharedLinkFn: func(arg *sharing.RevokeSharedLinkArg) error {
+			revoked = append(revoked, arg.Url)
+			return nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkRevokeTestCommand(&stdout, nil)
+	for name, value := range map[string]string{"all-under": "/Clients", "audience": "public"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := shareLinkRevoke(cmd, nil); err != nil {
+		t.Fatalf("shareLinkRevoke error: %v", err)
+	}
+	if listArg == nil || listArg.Path != "" {
+		t.Fatalf("ListSharedLinks arg = %#v, want every link", listArg)
+	}
+	if got := strings.Join(revoked, ","); got != "https://example.com/plan-public,https://example.com/notes-public" {
+		t.Fatalf("revoked URLs = %q, want only the public links under /Clients", got)
+	}
+}
+
+func TestShareLinkRevokeAllUnderDryRunJSONListsPlannedLinks(t *testing.T) {
+	expired := sharedLinkFolder("/clients/acme", "https://example.com/expired")
+	past := dropbox.DBXTime(time.Now().AddDate(0, 0, -7))
+	expired.Expires = &past
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+			return sharing.NewListSharedLinksResult([]sharing.IsSharedLinkMetadata{
+				expired,
+				sharedLinkFolder("/clients/globex", "https://example.com/current"),
+			}, false), nil
+		},
+		revokeSharedLinkFn: func(arg *sharing.RevokeSharedLinkArg) error {
+			t.Fatalf("RevokeSharedLink called during dry-run: %v", arg)
+			return nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkRevokeTestCommand(&stdout, nil)
+	for name, value := range map[string]string{"all-under": "/Clients", "expired": "true", dryRunFlagName: "true", outputFlag: "json"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := shareLinkRevoke(cmd, nil); err != nil {
+		t.Fatalf("shareLinkRevoke error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[shareLinkRevokeInput, shareLinkRevokeResult](t, stdout.Bytes())
+	if got.Input.AllUnder != "/Clients" || !got.Input.Expired || !got.Input.DryRun {
+		t.Fatalf("input = %#v", got.Input)
+	}
+	if len(got.Results) != 1 || got.Results[0].Status != jsonStatusPlanned || got.Results[0].Result.URL != "https://example.com/expired" || got.Results[0].Kind != "folder" {
+		t.Fatalf("results = %#v, want the expired folder link", got.Results)
+	}
+}
+
+func TestShareLinkRevokeAllUnderReportsPartialFailures(t *testing.T) {
+	stubRetrySleep(t)
+	attempts := map[string]int{}
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+			return sharing.NewListSharedLinksResult([]sharing.IsSharedLinkMetadata{
+				sharedLinkFile("/clients/a.pdf", "https://example.com/a"),
+				sharedLinkFile("/clients/b.pdf", "https://example.com/b"),
+				sharedLinkFile("/clients/c.pdf", "https://example.com/c"),
+				sharedLinkFile("/elsewhere/odd.pdf", ""),
+			}, false), nil
+		},
+		revokeSharedLinkFn: func(arg *sharing.RevokeSharedLinkArg) error {
+			attempts[arg.Url]++
+			switch {
+			case arg.Url == "https://example.com/a" && attempts[arg.Url] == 1:
+				return dbxauth.ServerError{APIError: dropbox.APIError{ErrorSummary: "500"}}
+			case arg.Url == "https://example.com/b":
+				return errors.New("shared_link_access_denied")
+			}
+			return nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := newShareLinkRevokeTestCommand(&stdout, nil)
+	for name, value := range map[string]string{"all-under": "/Clients", outputFlag: "json"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := shareLinkRevoke(cmd, nil); err != nil {
+		t.Fatalf("shareLinkRevoke error: %v", err)
+	}
+
+	if attempts["https://example.com/a"] != 2 {
+		t.Fatalf("attempts = %v, want the transient failure retried", attempts)
+	}
+	got := decodeShareLinkOperationOutputWithWarnings[shareLinkRevokeInput, shareLinkRevokeResult](t, stdout.Bytes())
+	if len(got.Results) != 2 || got.Results[0].Result.URL != "https://example.com/a" || got.Results[1].Result.URL != "https://example.com/c" {
+		t.Fatalf("results = %#v, want the links revoked around the failure", got.Results)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != jsonWarningCodeShareLinkRevokeFailed || got.Warnings[0].Path != "/clients/b.pdf" || !strings.Contains(got.Warnings[0].Message, "https://example.com/b") {
+		t.Fatalf("warnings = %+v, want one share_link_revoke_failed warning for b.pdf", got.Warnings)
+	}
+}
+
+func TestShareLinkRevokeAllUnderFailsWhenEveryLinkFails(t *testing.T) {
+	stubSharedLinkClient(t, &mockSharedLinkClient{
+		listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+			return sharing.NewListSharedLinksResult([]sharing.IsSharedLinkMetadata{
+				sharedLinkFile("/clients/a.pdf", "https://example.com/a"),
+				sharedLinkFile("/clients/b.pdf", ""),
+			}, false), nil
+		},
+		revokeSharedLinkFn: func(arg *sharing.RevokeSharedLinkArg) error {
+			return errors.New("shared_link_access_denied")
+		},
+	})
+
+	cmd := newShareLinkRevokeTestCommand(nil, nil)
+	if err := cmd.Flags().Set("all-under", "/Clients"); err != nil {
+		t.Fatal(err)
+	}
+	err := shareLinkRevoke(cmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want both failures reported", err)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != "share_link_revoke" {
+		t.Fatalf("details = %#v, want share_link_revoke operation", details)
+	}
+}
+
+func TestShareLinkRevokeFiltersRequireAllUnder(t *testing.T) {
+	cmd := newShareLinkRevokeTestCommand(nil, nil)
+	if err := cmd.Flags().Set("audience", "public"); err != nil {
+		t.Fatal(err)
+	}
+	err := shareLinkRevoke(cmd, []string{"https://example.com/one"})
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments || !strings.Contains(err.Error(), "--all-under") {
+		t.Fatalf("err = %v, want invalid_arguments naming --all-under", err)
+	}
+
+	cmd = newShareLinkRevokeTestCommand(nil, nil)
+	for name, value := range map[string]string{"all-under": "/Clients", "path": "/Clients/plan.pdf"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := shareLinkRevoke(cmd, nil); err == nil || jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("err = %v, want invalid_arguments for --all-under with --path", err)
+	}
+}
+
 func newShareLinkRevokeTestCommand(stdout, stderr *bytes.Buffer) *cobra.Command {
 	cmd := &cobra.Command{}
 	cmd.Flags().String("path", "", "")
+	cmd.Flags().String("all-under", "", "")
+	cmd.Flags().String("audience", "", "")
+	cmd.Flags().Bool("expired", false, "")
+	cmd.Flags().Bool("no-password", false, "")
+	cmd.Flags().Bool("allow-download", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
 	addDryRunFlag(cmd)
 	cmd.Flags().Bool("verbose", false, "")
 	if stdout != nil {
@@ -4,18 +4,42 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/spf13/cobra"
 )
 
 type mockSharedFolderClient struct {
-	listFoldersFn         func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error)
-	listFoldersContinueFn func(arg *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error)
+	listFoldersFn                func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error)
+	listFoldersContinueFn        func(arg *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error)
+	listFolderMembersFn          func(arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error)
+	listFolderMembersContinueFn  func(arg *sharing.ListFolderMembersContinueArg) (*sharing.SharedFolderMembers, error)
+	addFolderMemberFn            func(arg *sharing.AddFolderMemberArg) error
+	removeFolderMemberFn         func(arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error)
+	checkRemoveMemberJobStatusFn func(arg *async.PollArg) (*sharing.RemoveMemberJobStatus, error)
+	updateFolderMemberFn         func(arg *sharing.UpdateFolderMemberArg) (*sharing.MemberAccessLevelResult, error)
+	getFolderMetadataFn          func(arg *sharing.GetMetadataArgs) (*sharing.SharedFolderMetadata, error)
+	shareFolderFn                func(arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error)
+	checkShareJobStatusFn        func(arg *async.PollArg) (*sharing.ShareFolderJobStatus, error)
+	unshareFolderFn              func(arg *sharing.UnshareFolderArg) (*async.LaunchEmptyResult, error)
+	checkJobStatusFn             func(arg *async.PollArg) (*sharing.JobStatus, error)
+	updateFolderPolicyFn         func(arg *sharing.UpdateFolderPolicyArg) (*sharing.SharedFolderMetadata, error)
+	listMountableFoldersFn       func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error)
+	listMountableContinueFn      func(arg *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error)
+	mountFolderFn                func(arg *sharing.MountFolderArg) (*sharing.SharedFolderMetadata, error)
+	unmountFolderFn              func(arg *sharing.UnmountFolderArg) error
+	relinquishFolderFn           func(arg *sharing.RelinquishFolderMembershipArg) (*async.LaunchEmptyResult, error)
+	addFileMemberFn              func(arg *sharing.AddFileMemberArgs) ([]*sharing.FileMemberActionResult, error)
+	listFileMembersBatchFn       func(arg *sharing.ListFileMembersBatchArg) ([]*sharing.ListFileMembersBatchResult, error)
+	listFileMembersContinueFn    func(arg *sharing.ListFileMembersContinueArg) (*sharing.SharedFileMembers, error)
+	removeFileMemberFn           func(arg *sharing.RemoveFileMemberArg) (*sharing.FileMemberRemoveActionResult, error)
+	unshareFileFn                func(arg *sharing.UnshareFileArg) error
 }
 
 func (m *mockSharedFolderClient) ListFolders(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
@@ -40,6 +64,160 @@ func (m *mockSharedFolderClient) ListFoldersContinueContext(ctx context.Context,
 	return m.ListFoldersContinue(arg)
 }
 
+func (m *mockSharedFolderClient) ListFolderMembersContext(ctx context.Context, arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error) {
+	if m.listFolderMembersFn != nil {
+		return m.listFolderMembersFn(arg)
+	}
+	return &sharing.SharedFolderMembers{}, nil
+}
+
+func (m *mockSharedFolderClient) ListFolderMembersContinueContext(ctx context.Context, arg *sharing.ListFolderMembersContinueArg) (*sharing.SharedFolderMembers, error) {
+	if m.listFolderMembersContinueFn != nil {
+		return m.listFolderMembersContinueFn(arg)
+	}
+	return &sharing.SharedFolderMembers{}, nil
+}
+
+func (m *mockSharedFolderClient) AddFolderMemberContext(ctx context.Context, arg *sharing.AddFolderMemberArg) error {
+	if m.addFolderMemberFn != nil {
+		return m.addFolderMemberFn(arg)
+	}
+	return nil
+}
+
+func (m *mockSharedFolderClient) RemoveFolderMemberContext(ctx context.Context, arg *sharing.RemoveFolderMemberArg) (*async.LaunchResultBase, error) {
+	if m.removeFolderMemberFn != nil {
+		return m.removeFolderMemberFn(arg)
+	}
+	return nil, errors.New("unexpected RemoveFolderMember call")
+}
+
+func (m *mockSharedFolderClient) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (*sharing.RemoveMemberJobStatus, error) {
+	if m.checkRemoveMemberJobStatusFn != nil {
+		return m.checkRemoveMemberJobStatusFn(arg)
+	}
+	return nil, errors.New("unexpected CheckRemoveMemberJobStatus call")
+}
+
+func (m *mockSharedFolderClient) UpdateFolderMemberContext(ctx context.Context, arg *sharing.UpdateFolderMemberArg) (*sharing.MemberAccessLevelResult, error) {
+	if m.updateFolderMemberFn != nil {
+		return m.updateFolderMemberFn(arg)
+	}
+	return nil, errors.New("unexpected UpdateFolderMember call")
+}
+
+func (m *mockSharedFolderClient) GetFolderMetadataContext(ctx context.Context, arg *sharing.GetMetadataArgs) (*sharing.SharedFolderMetadata, error) {
+	if m.getFolderMetadataFn != nil {
+		return m.getFolderMetadataFn(arg)
+	}
+	return nil, errors.New("unexpected GetFolderMetadata call")
+}
+
+func (m *mockSharedFolderClient) ShareFolderContext(ctx context.Context, arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error) {
+	if m.shareFolderFn != nil {
+		return m.shareFolderFn(arg)
+	}
+	return nil, errors.New("unexpected ShareFolder call")
+}
+
+func (m *mockSharedFolderClient) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (*sharing.ShareFolderJobStatus, error) {
+	if m.checkShareJobStatusFn != nil {
+		return m.checkShareJobStatusFn(arg)
+	}
+	return nil, errors.New("unexpected CheckShareJobStatus call")
+}
+
+func (m *mockSharedFolderClient) UnshareFolderContext(ctx context.Context, arg *sharing.UnshareFolderArg) (*async.LaunchEmptyResult, error) {
+	if m.unshareFolderFn != nil {
+		return m.unshareFolderFn(arg)
+	}
+	return nil, errors.New("unexpected UnshareFolder call")
+}
+
+func (m *mockSharedFolderClient) CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (*sharing.JobStatus, error) {
+	if m.checkJobStatusFn != nil {
+		return m.checkJobStatusFn(arg)
+	}
+	return nil, errors.New("unexpected CheckJobStatus call")
+}
+
+func (m *mockSharedFolderClient) UpdateFolderPolicyContext(ctx context.Context, arg *sharing.UpdateFolderPolicyArg) (*sharing.SharedFolderMetadata, error) {
+	if m.updateFolderPolicyFn != nil {
+		return m.updateFolderPolicyFn(arg)
+	}
+	return nil, errors.New("unexpected UpdateFolderPolicy call")
+}
+
+func (m *mockSharedFolderClient) ListMountableFoldersContext(ctx context.Context, arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
+	if m.listMountableFoldersFn != nil {
+		return m.listMountableFoldersFn(arg)
+	}
+	return sharing.NewListFoldersResult(nil), nil
+}
+
+func (m *mockSharedFolderClient) ListMountableFoldersContinueContext(ctx context.Context, arg *sharing.ListFoldersContinueArg) (*sharing.ListFoldersResult, error) {
+	if m.listMountableContinueFn != nil {
+		return m.listMountableContinueFn(arg)
+	}
+	return sharing.NewListFoldersResult(nil), nil
+}
+
+func (m *mockSharedFolderClient) MountFolderContext(ctx context.Context, arg *sharing.MountFolderArg) (*sharing.SharedFolderMetadata, error) {
+	if m.mountFolderFn != nil {
+		return m.mountFolderFn(arg)
+	}
+	return nil, errors.New("unexpected MountFolder call")
+}
+
+func (m *mockSharedFolderClient) UnmountFolderContext(ctx context.Context, arg *sharing.UnmountFolderArg) error {
+	if m.unmountFolderFn != nil {
+		return m.unmountFolderFn(arg)
+	}
+	return errors.New("unexpected UnmountFolder call")
+}
+
+func (m *mockSharedFolderClient) RelinquishFolderMembershipContext(ctx context.Context, arg *sharing.RelinquishFolderMembershipArg) (*async.LaunchEmptyResult, error) {
+	if m.relinquishFolderFn != nil {
+		return m.relinquishFolderFn(arg)
+	}
+	return nil, errors.New("unexpected RelinquishFolderMembership call")
+}
+
+func (m *mockSharedFolderClient) AddFileMemberContext(ctx context.Context, arg *sharing.AddFileMemberArgs) ([]*sharing.FileMemberActionResult, error) {
+	if m.addFileMemberFn != nil {
+		return m.addFileMemberFn(arg)
+	}
+	return nil, errors.New("unexpected AddFileMember call")
+}
+
+func (m *mockSharedFolderClient) ListFileMembersBatchContext(ctx context.Context, arg *sharing.ListFileMembersBatchArg) ([]*sharing.ListFileMembersBatchResult, error) {
+	if m.listFileMembersBatchFn != nil {
+		return m.listFileMembersBatchFn(arg)
+	}
+	return nil, errors.New("unexpected ListFileMembersBatch call")
+}
+
+func (m *mockSharedFolderClient) ListFileMembersContinueContext(ctx context.Context, arg *sharing.ListFileMembersContinueArg) (*sharing.SharedFileMembers, error) {
+	if m.listFileMembersContinueFn != nil {
+		return m.listFileMembersContinueFn(arg)
+	}
+	return nil, errors.New("unexpected ListFileMembersContinue call")
+}
+
+func (m *mockSharedFolderClient) RemoveFileMember2Context(ctx context.Context, arg *sharing.RemoveFileMemberArg) (*sharing.FileMemberRemoveActionResult, error) {
+	if m.removeFileMemberFn != nil {
+		return m.removeFileMemberFn(arg)
+	}
+	return nil, errors.New("unexpected RemoveFileMember2 call")
+}
+
+func (m *mockSharedFolderClient) UnshareFileContext(ctx context.Context, arg *sharing.UnshareFileArg) error {
+	if m.unshareFileFn != nil {
+		return m.unshareFileFn(arg)
+	}
+	return errors.New("unexpected UnshareFile call")
+}
+
 func TestShareListFoldersTextUsesCommandOutput(t *testing.T) {
 	stubSharedFolderClient(t, &mockSharedFolderClient{
 		listFoldersFn: func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
@@ -62,6 +240,42 @@ func TestShareListFoldersTextUsesCommandOutput(t *testing.T) {
 	}
 }
 
+func TestShareListFoldersLongShowsPolicies(t *testing.T) {
+	stubSharedFolderClient(t, &mockSharedFolderClient{
+		listFoldersFn: func(arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
+			folder := testSharedFolder("/docs", "https://example.com/docs")
+			folder.AccessType = &sharing.AccessLevel{Tagged: dropbox.Tagged{Tag: sharing.AccessLevelOwner}}
+			folder.Policy = &sharing.FolderPolicy{
+				MemberPolicy:         &sharing.MemberPolicy{Tagged: dropbox.Tagged{Tag: sharing.MemberPolicyAnyone}},
+				ResolvedMemberPolicy: &sharing.MemberPolicy{Tagged: dropbox.Tagged{Tag: sharing.MemberPolicyTeam}},
+				AclUpdatePolicy:      &sharing.AclUpdatePolicy{Tagged: dropbox.Tagged{Tag: sharing.AclUpdatePolicyOwner}},
+				SharedLinkPolicy:     &sharing.SharedLinkPolicy{Tagged: dropbox.Tagged{Tag: sharing.SharedLinkPolicyMembers}},
+			}
+			return sharing.NewListFoldersResult([]*sharing.SharedFolderMetadata{folder}), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("long", "l", false, "")
+	if err := cmd.Flags().Set("long", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := shareListFolders(cmd, nil); err != nil {
+		t.Fatalf("shareListFolders error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "Path") {
+		t.Fatalf("stdout = %q, want header and one row", stdout.String())
+	}
+	if fields := strings.Fields(lines[1]); !slices.Equal(fields, []string{"/docs", "sfid:docs", "owner", "team", "owner", "members", "-", "https://example.com/docs"}) {
+		t.Fatalf("row = %q", fields)
+	}
+}
+
 func TestShareListFoldersJSONOutputsSharedFolders(t *testing.T) {
 	invited := time.Date(2026, 6, 24, 12, 0, 0, 0, time.UTC)
 	stubSharedFolderClient(t, &mockSharedFolderClient{
@@ -1,48 +1,101 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
-	"os"
-)
+	"log"
+	"time"
 
-var removeFile = os.Remove
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dustin/go-humanize"
+	"github.com/mitchellh/ioprogress"
+)
 
-func spoolStdinToTemp(r io.Reader) (path string, size int64, cleanup func() error, err error) {
-	f, err := os.CreateTemp("", "dbxcli-stdin-*")
+// uploadStream uploads r without knowing its size up front and without
+// writing it to disk. Input that fits in one chunk is sent with a single
+// upload request; anything larger goes through a sequential upload session,
+// appending each chunk as it arrives and finishing with the total length.
+// Only the current chunk is held in memory, so a failed request can be
+// retried without re-reading r.
+func uploadStream(dbx filesClient, r io.Reader, commitInfo *files.CommitInfo, chunkSize int64, errOut io.Writer, debug bool) (*files.FileMetadata, error) {
+	buf := make([]byte, chunkSize)
+	n, done, err := readStreamChunk(r, buf)
 	if err != nil {
-		return "", 0, nil, fmt.Errorf("create temp file: %w", err)
+		return nil, err
 	}
-	if err := os.Chmod(f.Name(), 0600); err != nil {
-		_ = f.Close()
-		_ = os.Remove(f.Name())
-		return "", 0, nil, fmt.Errorf("chmod temp file: %w", err)
+	if done {
+		return uploadSingleShot(dbx, bytes.NewReader(buf[:n]), &files.UploadArg{CommitInfo: *commitInfo}, int64(n), errOut)
 	}
 
-	tmpPath := f.Name()
-	removeTmp := func() error { return removeFile(tmpPath) }
+	if errOut == nil {
+		errOut = io.Discard
+	}
+	draw := ioprogress.DrawTerminalf(errOut, func(progress, _ int64) string {
+		return fmt.Sprintf("Uploading %s", humanize.IBytes(uint64(progress)))
+	})
 
-	n, copyErr := io.Copy(f, r)
-	if closeErr := f.Close(); closeErr != nil {
-		if copyErr != nil {
-			return "", 0, nil, stdinSpoolFailureError(tmpPath, copyErr, removeTmp())
-		}
-		return "", 0, nil, stdinSpoolFailureError(tmpPath, closeErr, removeTmp())
+	t0 := time.Now()
+	var res *files.UploadSessionStartResult
+	err = retryWithBackoff(func() error {
+		var e error
+		res, e = dbx.UploadSessionStartContext(currentContext(), files.NewUploadSessionStartArg(), bytes.NewReader(buf[:n]))
+		return e
+	})
+	if err != nil {
+		return nil, err
+	}
+	written := uint64(n)
+	_ = draw(int64(written), -1)
+	if debug {
+		log.Printf("Start took: %v\n", time.Since(t0))
 	}
-	if copyErr != nil {
-		return "", 0, nil, stdinSpoolFailureError(tmpPath, copyErr, removeTmp())
+
+	for !done {
+		n, done, err = readStreamChunk(r, buf)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		t0 := time.Now()
+		args := files.NewUploadSessionAppendArg(files.NewUploadSessionCursor(res.SessionId, written))
+		if err := uploadOneChunk(dbx, args, buf[:n]); err != nil {
+			return nil, err
+		}
+		if debug {
+			log.Printf("Chunk upload at offset %d took: %v\n", written, time.Since(t0))
+		}
+		written += uint64(n)
+		_ = draw(int64(written), -1)
 	}
+	_ = draw(-1, -1)
 
-	return tmpPath, n, removeTmp, nil
+	t1 := time.Now()
+	finishArgs := files.NewUploadSessionFinishArg(files.NewUploadSessionCursor(res.SessionId, written), commitInfo)
+	var metadata *files.FileMetadata
+	err = retryWithBackoff(func() error {
+		var e error
+		metadata, e = dbx.UploadSessionFinishContext(currentContext(), finishArgs, nil)
+		return e
+	})
+	if debug {
+		log.Printf("Finish took: %v\n", time.Since(t1))
+	}
+	return metadata, err
 }
 
-func stdinSpoolFailureError(tmpPath string, cause error, cleanupErr error) error {
-	if cleanupErr == nil {
-		return cause
+// readStreamChunk fills buf from r. done reports that r ended, possibly
+// after a partial chunk.
+func readStreamChunk(r io.Reader, buf []byte) (n int, done bool, err error) {
+	n, err = io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, true, nil
+	}
+	if err != nil {
+		return n, false, fmt.Errorf("read stdin: %w", err)
 	}
-	return errors.Join(
-		cause,
-		fmt.Errorf("failed to remove temp file %s after stdin spool failure; sensitive stdin data may remain on disk: %w", tmpPath, cleanupErr),
-	)
+	return n, false, nil
 }
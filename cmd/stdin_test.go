@@ -4,122 +4,130 @@ import (
 	"bytes"
 	"errors"
 	"io"
-	"os"
-	"runtime"
 	"strings"
 	"testing"
-)
-
-func TestSpoolStdinToTemp_Success(t *testing.T) {
-	data := []byte("hello from stdin")
-	r := bytes.NewReader(data)
 
-	path, size, cleanup, err := spoolStdinToTemp(r)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-	defer func() { _ = cleanup() }()
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	dbxauth "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
 
-	if size != int64(len(data)) {
-		t.Errorf("size = %d, want %d", size, len(data))
+func TestUploadStreamSmallInputUsesSingleUpload(t *testing.T) {
+	var uploaded []byte
+	dbx := &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			uploaded, _ = io.ReadAll(content)
+			return putFileMetadata(arg.Path, uint64(len(uploaded))), nil
+		},
+		uploadSessionStartFn: func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error) {
+			t.Fatal("upload session should not start for input smaller than one chunk")
+			return nil, nil
+		},
 	}
 
-	got, err := os.ReadFile(path)
-	if err != nil {
-		t.Fatalf("failed to read temp file: %v", err)
+	if _, err := uploadStream(dbx, strings.NewReader("hello"), files.NewCommitInfo("/a.txt"), 8, io.Discard, false); err != nil {
+		t.Fatalf("uploadStream error: %v", err)
 	}
-	if !bytes.Equal(got, data) {
-		t.Errorf("temp file content = %q, want %q", got, data)
+	if string(uploaded) != "hello" {
+		t.Fatalf("uploaded = %q, want hello", uploaded)
 	}
+}
 
-	info, err := os.Stat(path)
+func TestUploadStreamAppendsChunksAndFinishesWithTotal(t *testing.T) {
+	var stream bytes.Buffer
+	var offsets []uint64
+	var finish *files.UploadSessionFinishArg
+	dbx := &mockFilesClient{
+		uploadSessionStartFn: func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error) {
+			if arg.SessionType != nil && arg.SessionType.Tag == files.UploadSessionTypeConcurrent {
+				t.Fatal("stream upload should use a sequential session")
+			}
+			data, _ := io.ReadAll(content)
+			stream.Write(data)
+			return &files.UploadSessionStartResult{SessionId: "session"}, nil
+		},
+		uploadSessionAppendV2Fn: func(arg *files.UploadSessionAppendArg, content io.Reader) error {
+			if arg.Close {
+				t.Fatal("stream upload should not close the session before finish")
+			}
+			offsets = append(offsets, arg.Cursor.Offset)
+			data, _ := io.ReadAll(content)
+			stream.Write(data)
+			return nil
+		},
+		uploadSessionFinishFn: func(arg *files.UploadSessionFinishArg, content io.Reader) (*files.FileMetadata, error) {
+			finish = arg
+			return putFileMetadata(arg.Commit.Path, arg.Cursor.Offset), nil
+		},
+	}
+
+	var stderr bytes.Buffer
+	// A reader that returns short reads, like a pipe.
+	input := io.MultiReader(strings.NewReader("abcde"), strings.NewReader("fghij"), strings.NewReader("k"))
+	metadata, err := uploadStream(dbx, input, files.NewCommitInfo("/big.bin"), 4, &stderr, false)
 	if err != nil {
-		t.Fatalf("failed to stat temp file: %v", err)
+		t.Fatalf("uploadStream error: %v", err)
 	}
-	if runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
-		t.Errorf("temp file permissions = %o, want 0600", info.Mode().Perm())
+	if stream.String() != "abcdefghijk" {
+		t.Fatalf("uploaded stream = %q", stream.String())
 	}
-}
-
-func TestSpoolStdinToTemp_Empty(t *testing.T) {
-	r := bytes.NewReader(nil)
-
-	path, size, cleanup, err := spoolStdinToTemp(r)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	if len(offsets) != 2 || offsets[0] != 4 || offsets[1] != 8 {
+		t.Fatalf("append offsets = %v, want [4 8]", offsets)
 	}
-	defer func() { _ = cleanup() }()
-
-	if size != 0 {
-		t.Errorf("size = %d, want 0", size)
+	if finish == nil || finish.Cursor.SessionId != "session" || finish.Cursor.Offset != 11 || finish.Commit.Path != "/big.bin" {
+		t.Fatalf("finish arg = %#v", finish)
 	}
-
-	got, err := os.ReadFile(path)
-	if err != nil {
-		t.Fatalf("failed to read temp file: %v", err)
+	if metadata == nil || metadata.Size != 11 {
+		t.Fatalf("metadata = %#v", metadata)
 	}
-	if len(got) != 0 {
-		t.Errorf("temp file content = %q, want empty", got)
+	if !strings.Contains(stderr.String(), "Uploading 11 B") {
+		t.Fatalf("stderr = %q, want running total", stderr.String())
 	}
 }
 
-func TestSpoolStdinToTemp_ReadError(t *testing.T) {
-	r := &failingReader{err: io.ErrUnexpectedEOF}
-
-	_, _, _, err := spoolStdinToTemp(r)
-	if err == nil {
-		t.Fatal("expected error for failing reader")
+func TestUploadStreamRetriesChunkFromMemory(t *testing.T) {
+	stubRetrySleep(t)
+	attempts := 0
+	var appended []string
+	dbx := &mockFilesClient{
+		uploadSessionStartFn: func(arg *files.UploadSessionStartArg, content io.Reader) (*files.UploadSessionStartResult, error) {
+			_, _ = io.ReadAll(content)
+			return &files.UploadSessionStartResult{SessionId: "session"}, nil
+		},
+		uploadSessionAppendV2Fn: func(arg *files.UploadSessionAppendArg, content io.Reader) error {
+			attempts++
+			data, _ := io.ReadAll(content)
+			if attempts == 1 {
+				return dbxauth.ServerError{APIError: dropbox.APIError{ErrorSummary: "500"}}
+			}
+			appended = append(appended, string(data))
+			return nil
+		},
+		uploadSessionFinishFn: func(arg *files.UploadSessionFinishArg, content io.Reader) (*files.FileMetadata, error) {
+			return &files.FileMetadata{}, nil
+		},
+	}
+
+	if _, err := uploadStream(dbx, strings.NewReader("abcdef"), files.NewCommitInfo("/a.bin"), 4, io.Discard, false); err != nil {
+		t.Fatalf("uploadStream error: %v", err)
+	}
+	if attempts != 2 || len(appended) != 1 || appended[0] != "ef" {
+		t.Fatalf("attempts = %d, appended = %q, want retried chunk ef", attempts, appended)
 	}
 }
 
-func TestSpoolStdinToTemp_ReadErrorReportsCleanupFailure(t *testing.T) {
-	readErr := io.ErrUnexpectedEOF
-	cleanupErr := errors.New("remove failed")
-	var tempPath string
-	origRemoveFile := removeFile
-	removeFile = func(path string) error {
-		tempPath = path
-		return cleanupErr
+func TestUploadStreamReadError(t *testing.T) {
+	readErr := errors.New("broken pipe")
+	dbx := &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			t.Fatal("upload should not run after a read error")
+			return nil, nil
+		},
 	}
-	t.Cleanup(func() {
-		removeFile = origRemoveFile
-		if tempPath != "" {
-			_ = origRemoveFile(tempPath)
-		}
-	})
 
-	_, _, _, err := spoolStdinToTemp(&failingReader{err: readErr})
-	if err == nil {
-		t.Fatal("expected error for failing reader")
-	}
+	_, err := uploadStream(dbx, &failingReader{err: readErr}, files.NewCommitInfo("/a.txt"), 4, io.Discard, false)
 	if !errors.Is(err, readErr) {
-		t.Fatalf("error = %v, want read error", err)
-	}
-	if !errors.Is(err, cleanupErr) {
-		t.Fatalf("error = %v, want cleanup error", err)
-	}
-	if tempPath == "" {
-		t.Fatal("expected temp path to be captured")
-	}
-	if !strings.Contains(err.Error(), "sensitive stdin data may remain on disk") {
-		t.Errorf("error = %q, want sensitive-data warning", err.Error())
-	}
-}
-
-func TestSpoolStdinToTemp_CleanupRemovesFile(t *testing.T) {
-	r := bytes.NewReader([]byte("data"))
-
-	path, _, cleanup, err := spoolStdinToTemp(r)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-
-	if err := cleanup(); err != nil {
-		t.Fatalf("cleanup error: %v", err)
-	}
-
-	if _, err := os.Stat(path); !os.IsNotExist(err) {
-		t.Errorf("expected temp file to be removed after cleanup, stat err = %v", err)
+		t.Fatalf("err = %v, want read error", err)
 	}
 }
 
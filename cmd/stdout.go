@@ -29,13 +29,22 @@ func (e partialTransferError) JSONErrorDetails() map[string]any {
 }
 
 func downloadToStdout(dbx filesClient, src string, w io.Writer) error {
-	return downloadToStdoutWithMetadata(dbx, src, nil, w)
+	return downloadToStdoutWithMetadata(dbx, src, nil, "", w)
 }
 
-func downloadToStdoutWithMetadata(dbx filesClient, src string, metadata *files.FileMetadata, w io.Writer) error {
+func downloadToStdoutWithMetadata(dbx filesClient, src string, metadata *files.FileMetadata, exportFormat string, w io.Writer) error {
+	arg := files.NewDownloadArg(src)
+	return streamToStdout(w, func() (io.ReadCloser, error) {
+		return stdoutReadCloser(dbx, arg, metadata, exportFormat)
+	})
+}
+
+// streamToStdout copies the stream returned by open to w, retrying transient
+// failures only while nothing has been written yet. A closed pipe on w ends
+// the copy successfully.
+func streamToStdout(w io.Writer, open func() (io.ReadCloser, error)) error {
 	ignoreBrokenPipeSignal()
 
-	arg := files.NewDownloadArg(src)
 	var bytesWritten int64
 
 	err := retryWithBackoff(func() error {
@@ -43,7 +52,7 @@ func downloadToStdoutWithMetadata(dbx filesClient, src string, metadata *files.F
 			return partialStdoutError(bytesWritten)
 		}
 
-		contents, err := stdoutReadCloser(dbx, arg, metadata)
+		contents, err := open()
 		if err != nil {
 			return err
 		}
@@ -64,9 +73,9 @@ func downloadToStdoutWithMetadata(dbx filesClient, src string, metadata *files.F
 	return err
 }
 
-func stdoutReadCloser(dbx filesClient, downloadArg *files.DownloadArg, metadata *files.FileMetadata) (io.ReadCloser, error) {
+func stdoutReadCloser(dbx filesClient, downloadArg *files.DownloadArg, metadata *files.FileMetadata, exportFormat string) (io.ReadCloser, error) {
 	if isExportOnlyFile(metadata) {
-		_, contents, err := exportFile(dbx, downloadArg.Path)
+		_, contents, err := exportFile(dbx, downloadArg.Path, exportFormat)
 		return contents, err
 	}
 
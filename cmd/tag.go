@@ -0,0 +1,223 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	tagStatusAdded   = "added"
+	tagStatusRemoved = "removed"
+	tagKindTag       = "tag"
+
+	tagOperationAdd    = "tag_add"
+	tagOperationRemove = "tag_remove"
+)
+
+// tagTextPattern mirrors the Dropbox tag_text constraint. Dropbox stores tags
+// in lowercase, so dbxcli normalizes before calling the API.
+var tagTextPattern = regexp.MustCompile(`^[\p{L}\p{N}_]{1,32}$`)
+
+type tagInput struct {
+	Path   string `json:"path"`
+	Tag    string `json:"tag"`
+	DryRun bool   `json:"dry_run,omitempty"`
+}
+
+type tagResult struct {
+	Path string `json:"path"`
+	Tag  string `json:"tag"`
+}
+
+type tagMutation struct {
+	status    string
+	operation string
+	planned   string
+	done      string
+	apply     func(dbx filesClient, path, tag string) error
+}
+
+var tagAddMutation = tagMutation{
+	status:    tagStatusAdded,
+	operation: tagOperationAdd,
+	planned:   "add tag %s to",
+	done:      "Added tag %s to %s\n",
+	apply: func(dbx filesClient, path, tag string) error {
+		return dbx.TagsAddContext(currentContext(), files.NewAddTagArg(path, tag))
+	},
+}
+
+var tagRemoveMutation = tagMutation{
+	status:    tagStatusRemoved,
+	operation: tagOperationRemove,
+	planned:   "remove tag %s from",
+	done:      "Removed tag %s from %s\n",
+	apply: func(dbx filesClient, path, tag string) error {
+		return dbx.TagsRemoveContext(currentContext(), files.NewRemoveTagArg(path, tag))
+	},
+}
+
+func tagAdd(cmd *cobra.Command, args []string) error {
+	return runTagMutation(cmd, args, "tag add", tagAddMutation)
+}
+
+func tagRemove(cmd *cobra.Command, args []string) error {
+	return runTagMutation(cmd, args, "tag remove", tagRemoveMutation)
+}
+
+func runTagMutation(cmd *cobra.Command, args []string, command string, mutation tagMutation) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorfWithDetails("`%s` requires `tag` and at least one `path` argument", argumentsErrorDetails("tag", "path"), command)
+	}
+	tag, ok := normalizeTag(args[0])
+	if !ok {
+		return invalidTagError(args[0], argumentErrorDetails("tag"))
+	}
+	paths, err := validateTagPaths(args[1:])
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return err
+	}
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	var dbx filesClient
+	if !dryRun {
+		dbx = filesNewFunc(config)
+	}
+
+	results := make([]jsonOperationResult, 0, len(paths))
+	done := make([]string, 0, len(paths))
+	var warnings []jsonWarning
+	var failures []error
+	for _, path := range paths {
+		if !dryRun {
+			err := retryWithBackoff(func() error {
+				return mutation.apply(dbx, path, tag)
+			})
+			if err != nil {
+				failures = append(failures, withJSONErrorDetails(err, operationErrorDetails(mutation.operation), pathErrorDetails(path)))
+				warnings = append(warnings, jsonWarning{Code: jsonWarningCodeTagFailed, Message: fmt.Sprintf("%s %s: %v", fmt.Sprintf(mutation.planned, tag), path, err), Path: path})
+				continue
+			}
+		}
+		input := tagInput{Path: path, Tag: tag, DryRun: dryRun}
+		results = append(results, newJSONOperationResult(plannedStatus(dryRun, mutation.status), tagKindTag, input, tagResult{Path: path, Tag: tag}))
+		done = append(done, path)
+	}
+	if len(failures) == len(paths) {
+		return batchFailuresError(mutation.operation, failures)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+
+	return renderOperation(cmd, nil, results, warnings, func(w io.Writer) error {
+		if !dryRun && !verbose {
+			return nil
+		}
+		for _, path := range done {
+			if dryRun {
+				if err := writeDryRunLine(w, fmt.Sprintf(mutation.planned, tag), path); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, mutation.done, tag, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// normalizeTag trims an optional leading "#" and lowercases the tag the way
+// Dropbox stores it.
+func normalizeTag(tag string) (string, bool) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	return normalized, tagTextPattern.MatchString(normalized)
+}
+
+func invalidTagError(tag string, details map[string]any) error {
+	return invalidArgumentsErrorfWithDetails("invalid tag %q: tags are 1-32 letters, digits, or underscores", mergeJSONErrorDetails(details, map[string]any{"value": tag}), tag)
+}
+
+func validateTagPaths(args []string) ([]string, error) {
+	paths := make([]string, 0, len(args))
+	for _, arg := range args {
+		path, err := validatePath(arg)
+		if err != nil {
+			return nil, err
+		}
+		if path == "" {
+			return nil, invalidArgumentsErrorWithDetails("cannot tag the Dropbox root", mergeJSONErrorDetails(argumentErrorDetails("path"), pathErrorDetails("/")))
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "File tag commands",
+	Long:  "Add, remove, and list Dropbox user tags on files and folders.",
+}
+
+var tagAddCmd = &cobra.Command{
+	Use:   "add [flags] <tag> <path>...",
+	Short: "Add a tag to files or folders",
+	Long: `Add a user tag to one or more Dropbox files or folders.
+
+Tags are stored in lowercase and may contain letters, digits, and underscores.
+Paths that cannot be tagged are reported as warnings while the rest are
+tagged; the command fails only when every path fails.`,
+	Example: `  dbxcli tag add approved /Design/logo.psd /Design/banner.psd
+  dbxcli tag add approved /Design/logo.psd --dry-run`,
+	RunE: tagAdd,
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove [flags] <tag> <path>...",
+	Short: "Remove a tag from files or folders",
+	Long: `Remove a user tag from one or more Dropbox files or folders.
+
+Paths whose tag cannot be removed are reported as warnings while the rest are
+updated; the command fails only when every path fails.`,
+	Example: `  dbxcli tag remove draft /Design/logo.psd`,
+	RunE:    tagRemove,
+}
+
+func init() {
+	RootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagAddCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	enableStructuredOutput(tagAddCmd)
+	enableStructuredOutput(tagRemoveCmd)
+	addDryRunFlag(tagAddCmd)
+	addDryRunFlag(tagRemoveCmd)
+}
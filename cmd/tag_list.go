@@ -0,0 +1,189 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	tagStatusListed  = "listed"
+	tagOperationList = "tag_list"
+
+	// tagsGetBatchSize bounds the number of paths sent in one tags/get call.
+	tagsGetBatchSize = 100
+)
+
+type pathTagsResult struct {
+	Path string   `json:"path"`
+	Tags []string `json:"tags"`
+}
+
+type tagListTarget struct {
+	path string
+	kind string
+}
+
+func tagList(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return invalidArgumentsErrorWithDetails("`tag list` requires at least one `path` argument", argumentErrorDetails("path"))
+	}
+	paths, err := validateTagPaths(args)
+	if err != nil {
+		return err
+	}
+
+	dbx := filesNewFunc(config)
+	targets, err := tagListTargets(dbx, paths)
+	if err != nil {
+		return err
+	}
+
+	targetPaths := make([]string, 0, len(targets))
+	for _, target := range targets {
+		targetPaths = append(targetPaths, target.path)
+	}
+	tags, err := getPathTags(dbx, targetPaths)
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(tagOperationList))
+	}
+
+	results := make([]pathTagsResult, 0, len(targets))
+	operationResults := make([]jsonOperationResult, 0, len(targets))
+	for _, target := range targets {
+		result := pathTagsResult{Path: target.path, Tags: tags[strings.ToLower(target.path)]}
+		if result.Tags == nil {
+			result.Tags = []string{}
+		}
+		results = append(results, result)
+		operationResults = append(operationResults, newJSONOperationResult(tagStatusListed, target.kind, nil, result))
+	}
+
+	return renderOperation(cmd, nil, operationResults, nil, func(w io.Writer) error {
+		return renderPathTags(w, results)
+	})
+}
+
+// tagListTargets expands folder arguments to their immediate children so a
+// folder prints tags like an ls listing. File arguments are kept as-is.
+func tagListTargets(dbx filesClient, paths []string) ([]tagListTarget, error) {
+	var targets []tagListTarget
+	for _, path := range paths {
+		metadata, err := getFileMetadata(dbx, path)
+		if err != nil {
+			return nil, withJSONErrorDetails(err, operationErrorDetails(tagOperationList), pathErrorDetails(path))
+		}
+
+		folder, ok := metadata.(*files.FolderMetadata)
+		if !ok {
+			targets = append(targets, tagListTarget{path: tagMetadataPath(path, metadata), kind: "file"})
+			continue
+		}
+
+		res, err := dbx.ListFolderContext(currentContext(), files.NewListFolderArg(folder.PathLower))
+		if err != nil {
+			return nil, withJSONErrorDetails(err, operationErrorDetails(tagOperationList), pathErrorDetails(path))
+		}
+		for {
+			for _, entry := range res.Entries {
+				switch e := entry.(type) {
+				case *files.FileMetadata:
+					targets = append(targets, tagListTarget{path: e.PathDisplay, kind: "file"})
+				case *files.FolderMetadata:
+					targets = append(targets, tagListTarget{path: e.PathDisplay, kind: "folder"})
+				}
+			}
+			if !res.HasMore {
+				break
+			}
+			res, err = dbx.ListFolderContinueContext(currentContext(), files.NewListFolderContinueArg(res.Cursor))
+			if err != nil {
+				return nil, withJSONErrorDetails(err, operationErrorDetails(tagOperationList), pathErrorDetails(path))
+			}
+		}
+	}
+	return targets, nil
+}
+
+func tagMetadataPath(path string, metadata files.IsMetadata) string {
+	if file, ok := metadata.(*files.FileMetadata); ok && file.PathDisplay != "" {
+		return file.PathDisplay
+	}
+	return path
+}
+
+// getPathTags returns user tags keyed by lowercase path.
+func getPathTags(dbx filesClient, paths []string) (map[string][]string, error) {
+	tags := make(map[string][]string, len(paths))
+	for start := 0; start < len(paths); start += tagsGetBatchSize {
+		end := min(start+tagsGetBatchSize, len(paths))
+		res, err := dbx.TagsGetContext(currentContext(), files.NewGetTagsArg(paths[start:end]))
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			continue
+		}
+		for _, entry := range res.PathsToTags {
+			if entry == nil {
+				continue
+			}
+			key := strings.ToLower(entry.Path)
+			for _, tag := range entry.Tags {
+				if tag != nil && tag.UserGeneratedTag != nil {
+					tags[key] = append(tags[key], tag.UserGeneratedTag.TagText)
+				}
+			}
+		}
+	}
+	return tags, nil
+}
+
+func renderPathTags(out io.Writer, results []pathTagsResult) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	for _, result := range results {
+		line := result.Path
+		if len(result.Tags) > 0 {
+			line += "\t" + strings.Join(result.Tags, ", ")
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+var tagListCmd = &cobra.Command{
+	Use:   "list [flags] <path>...",
+	Short: "List tags on files or folder contents",
+	Long: `List user tags on Dropbox files.
+
+A folder argument lists the tags of every file and folder directly inside it.`,
+	Example: `  dbxcli tag list /Design/logo.psd
+  dbxcli tag list /Design`,
+	RunE: tagList,
+}
+
+func init() {
+	tagCmd.AddCommand(tagListCmd)
+	enableStructuredOutput(tagListCmd)
+}
@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	dbxauth "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestTagMutationArgValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*cobra.Command, []string) error
+		args []string
+	}{
+		{name: "add missing path", run: tagAdd, args: []string{"approved"}},
+		{name: "remove missing path", run: tagRemove, args: []string{"draft"}},
+		{name: "invalid tag", run: tagAdd, args: []string{"two words", "/a.txt"}},
+		{name: "root path", run: tagAdd, args: []string{"approved", "/"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testTagCmd()
+			stubFilesClient(t, &mockFilesClient{
+				tagsAddFn: func(arg *files.AddTagArg) error {
+					t.Fatal("tags/add called for invalid arguments")
+					return nil
+				},
+			})
+			if code := jsonErrorCode(tt.run(cmd, tt.args)); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+			}
+		})
+	}
+}
+
+func TestTagAddTagsEveryPathWithNormalizedTag(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	var added []string
+	stubFilesClient(t, &mockFilesClient{
+		tagsAddFn: func(arg *files.AddTagArg) error {
+			added = append(added, arg.Path+"#"+arg.TagText)
+			return nil
+		},
+	})
+
+	if err := tagAdd(cmd, []string{"#Approved", "/Design/logo.psd", "/Design/banner.psd"}); err != nil {
+		t.Fatalf("tag add error: %v", err)
+	}
+	if got := strings.Join(added, ","); got != "/Design/logo.psd#approved,/Design/banner.psd#approved" {
+		t.Fatalf("added = %q, want normalized tag on both paths", got)
+	}
+	if stdout.String() != "" {
+		t.Fatalf("stdout = %q, want quiet success", stdout.String())
+	}
+}
+
+func TestTagAddVerbosePrintsTaggedPaths(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set("verbose", "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{})
+
+	if err := tagAdd(cmd, []string{"approved", "/Design/logo.psd"}); err != nil {
+		t.Fatalf("tag add error: %v", err)
+	}
+	if got, want := stdout.String(), "Added tag approved to /Design/logo.psd\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestTagAddJSONOutputsResults(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{})
+
+	if err := tagAdd(cmd, []string{"approved", "/Design/logo.psd"}); err != nil {
+		t.Fatalf("tag add error: %v", err)
+	}
+
+	got := decodeTagOutput[tagInput, tagResult](t, stdout)
+	if len(got) != 1 {
+		t.Fatalf("results len = %d, want 1", len(got))
+	}
+	if got[0].Status != tagStatusAdded || got[0].Kind != tagKindTag {
+		t.Fatalf("status/kind = %s/%s, want added/tag", got[0].Status, got[0].Kind)
+	}
+	if got[0].Input != (tagInput{Path: "/Design/logo.psd", Tag: "approved"}) || got[0].Result != (tagResult{Path: "/Design/logo.psd", Tag: "approved"}) {
+		t.Fatalf("result = %#v, want path and tag", got[0])
+	}
+}
+
+func TestTagAddJSONDryRunOutputsPlannedResults(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Flags().Set(dryRunFlagName, "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		tagsAddFn: func(arg *files.AddTagArg) error {
+			t.Fatal("tags/add called during dry-run")
+			return nil
+		},
+	})
+
+	if err := tagAdd(cmd, []string{"approved", "/Design/logo.psd"}); err != nil {
+		t.Fatalf("tag add error: %v", err)
+	}
+
+	got := decodeTagOutput[tagInput, tagResult](t, stdout)
+	if len(got) != 1 || got[0].Status != jsonStatusPlanned || !got[0].Input.DryRun {
+		t.Fatalf("results = %#v, want planned dry-run result", got)
+	}
+}
+
+func TestTagDryRunTextOutput(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(dryRunFlagName, "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tagRemove(cmd, []string{"draft", "/a.txt", "/b.txt"}); err != nil {
+		t.Fatalf("tag remove error: %v", err)
+	}
+	want := "Would remove tag draft from /a.txt\nWould remove tag draft from /b.txt\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestTagRemoveJSONOutputsResults(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var removed *files.RemoveTagArg
+	stubFilesClient(t, &mockFilesClient{
+		tagsRemoveFn: func(arg *files.RemoveTagArg) error {
+			removed = arg
+			return nil
+		},
+	})
+
+	if err := tagRemove(cmd, []string{"draft", "/Design/logo.psd"}); err != nil {
+		t.Fatalf("tag remove error: %v", err)
+	}
+	if removed == nil || removed.Path != "/Design/logo.psd" || removed.TagText != "draft" {
+		t.Fatalf("remove arg = %#v, want path and tag", removed)
+	}
+	got := decodeTagOutput[tagInput, tagResult](t, stdout)
+	if len(got) != 1 || got[0].Status != tagStatusRemoved {
+		t.Fatalf("results = %#v, want removed result", got)
+	}
+}
+
+func TestTagRemoveErrorIncludesPathDetails(t *testing.T) {
+	cmd, _ := testTagCmd()
+	stubFilesClient(t, &mockFilesClient{
+		tagsRemoveFn: func(arg *files.RemoveTagArg) error {
+			return files.TagsRemoveAPIError{APIError: dropbox.APIError{ErrorSummary: "tag_not_present/"}}
+		},
+	})
+
+	err := tagRemove(cmd, []string{"draft", "/Design/logo.psd"})
+	if err == nil {
+		t.Fatal("expected tag remove error")
+	}
+	details := jsonErrorDetails(err)
+	if details["path"] != "/Design/logo.psd" || details["operation"] != tagOperationRemove {
+		t.Fatalf("details = %#v, want path and operation", details)
+	}
+}
+
+func TestTagAddRetriesAndWarnsOnFailedPaths(t *testing.T) {
+	stubRetrySleep(t)
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	calls := map[string]int{}
+	stubFilesClient(t, &mockFilesClient{
+		tagsAddFn: func(arg *files.AddTagArg) error {
+			calls[arg.Path]++
+			switch {
+			case arg.Path == "/b.psd" && calls[arg.Path] == 1:
+				return dbxauth.ServerError{APIError: dropbox.APIError{ErrorSummary: "500"}}
+			case arg.Path == "/c.psd":
+				return files.TagsAddAPIError{APIError: dropbox.APIError{ErrorSummary: "path/not_found/"}}
+			}
+			return nil
+		},
+	})
+
+	if err := tagAdd(cmd, []string{"approved", "/a.psd", "/b.psd", "/c.psd"}); err != nil {
+		t.Fatalf("tag add error: %v", err)
+	}
+	if calls["/b.psd"] != 2 {
+		t.Fatalf("calls = %v, want the transient failure retried", calls)
+	}
+	got, warnings := decodeTagOutputWithWarnings[tagInput, tagResult](t, stdout)
+	if len(got) != 2 || got[0].Input.Path != "/a.psd" || got[1].Input.Path != "/b.psd" {
+		t.Fatalf("results = %#v, want the tagged paths", got)
+	}
+	if len(warnings) != 1 || warnings[0].Code != jsonWarningCodeTagFailed || warnings[0].Path != "/c.psd" {
+		t.Fatalf("warnings = %+v, want tag_failed for /c.psd", warnings)
+	}
+}
+
+func TestTagAddFailsWhenEveryPathFails(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	stubFilesClient(t, &mockFilesClient{
+		tagsAddFn: func(arg *files.AddTagArg) error {
+			return files.TagsAddAPIError{APIError: dropbox.APIError{ErrorSummary: "path/not_found/"}}
+		},
+	})
+
+	err := tagAdd(cmd, []string{"approved", "/a.psd", "/b.psd"})
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want both failures reported", err)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != tagOperationAdd {
+		t.Fatalf("details = %#v, want tag_add operation", details)
+	}
+	if stdout.String() != "" {
+		t.Fatalf("stdout = %q, want no output on error", stdout.String())
+	}
+}
+
+func TestTagListExpandsFolders(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	stubFilesClient(t, newTagListMock(t))
+
+	if err := tagList(cmd, []string{"/Design"}); err != nil {
+		t.Fatalf("tag list error: %v", err)
+	}
+	want := "/Design/logo.psd approved, q3\n/Design/old\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestTagListJSONOutputsFolderListing(t *testing.T) {
+	cmd, stdout := testTagCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, newTagListMock(t))
+
+	if err := tagList(cmd, []string{"/Design"}); err != nil {
+		t.Fatalf("tag list error: %v", err)
+	}
+
+	got := decodeTagOutput[map[string]any, pathTagsResult](t, stdout)
+	if len(got) != 2 {
+		t.Fatalf("results len = %d, want 2", len(got))
+	}
+	if got[0].Kind != "file" || got[0].Result.Path != "/Design/logo.psd" || strings.Join(got[0].Result.Tags, ",") != "approved,q3" {
+		t.Fatalf("first result = %#v, want file tags", got[0])
+	}
+	if got[1].Kind != "folder" || got[1].Result.Tags == nil || len(got[1].Result.Tags) != 0 {
+		t.Fatalf("second result = %#v, want folder with empty tags", got[1])
+	}
+}
+
+func TestTagListBatchesTagRequests(t *testing.T) {
+	paths := make([]string, tagsGetBatchSize+1)
+	for i := range paths {
+		paths[i] = "/f" + strings.Repeat("x", i%3)
+	}
+	var sizes []int
+	mock := &mockFilesClient{
+		tagsGetFn: func(arg *files.GetTagsArg) (*files.GetTagsResult, error) {
+			sizes = append(sizes, len(arg.Paths))
+			return &files.GetTagsResult{}, nil
+		},
+	}
+	if _, err := getPathTags(mock, paths); err != nil {
+		t.Fatal(err)
+	}
+	if len(sizes) != 2 || sizes[0] != tagsGetBatchSize || sizes[1] != 1 {
+		t.Fatalf("batch sizes = %v, want [%d 1]", sizes, tagsGetBatchSize)
+	}
+}
+
+func TestTagListErrorIncludesPath(t *testing.T) {
+	cmd, _ := testTagCmd()
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return nil, errors.New("lookup failed")
+		},
+	})
+
+	err := tagList(cmd, []string{"/missing"})
+	if details := jsonErrorDetails(err); details["path"] != "/missing" || details["operation"] != tagOperationList {
+		t.Fatalf("details = %#v, want path and operation", details)
+	}
+}
+
+func TestTagCommandsSupportStructuredOutput(t *testing.T) {
+	for _, cmd := range []*cobra.Command{tagAddCmd, tagRemoveCmd, tagListCmd} {
+		if !commandSupportsStructuredOutput(cmd) {
+			t.Fatalf("%s should support structured output", cmd.CommandPath())
+		}
+	}
+}
+
+func newTagListMock(t *testing.T) *mockFilesClient {
+	t.Helper()
+	return &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return &files.FolderMetadata{Metadata: files.Metadata{PathDisplay: "/Design", PathLower: "/design"}}, nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if arg.Path != "/design" || arg.Recursive {
+				t.Fatalf("list arg = %#v, want non-recursive /design listing", arg)
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				&files.FileMetadata{Metadata: files.Metadata{PathDisplay: "/Design/logo.psd", PathLower: "/design/logo.psd"}},
+				&files.FolderMetadata{Metadata: files.Metadata{PathDisplay: "/Design/old", PathLower: "/design/old"}},
+			}}, nil
+		},
+		tagsGetFn: func(arg *files.GetTagsArg) (*files.GetTagsResult, error) {
+			if strings.Join(arg.Paths, ",") != "/Design/logo.psd,/Design/old" {
+				t.Fatalf("tags/get paths = %v, want folder children", arg.Paths)
+			}
+			return &files.GetTagsResult{PathsToTags: []*files.PathToTags{
+				files.NewPathToTags("/Design/logo.psd", []*files.Tag{userTag("approved"), userTag("q3")}),
+				files.NewPathToTags("/Design/old", nil),
+			}}, nil
+		},
+	}
+}
+
+func userTag(text string) *files.Tag {
+	return &files.Tag{
+		Tagged:           dropbox.Tagged{Tag: files.TagUserGeneratedTag},
+		UserGeneratedTag: files.NewUserGeneratedTag(text),
+	}
+}
+
+func testTagCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "tag"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	addDryRunFlag(cmd)
+	return cmd, &stdout
+}
+
+type tagOperationResultForTest[I, R any] struct {
+	Status string `json:"status"`
+	Kind   string `json:"kind"`
+	Input  I      `json:"input"`
+	Result R      `json:"result"`
+}
+
+func decodeTagOutput[I, R any](t *testing.T, stdout *bytes.Buffer) []tagOperationResultForTest[I, R] {
+	t.Helper()
+
+	results, warnings := decodeTagOutputWithWarnings[I, R](t, stdout)
+	if len(warnings) != 0 {
+		t.Fatalf("warnings = %+v, want empty array", warnings)
+	}
+	return results
+}
+
+func decodeTagOutputWithWarnings[I, R any](t *testing.T, stdout *bytes.Buffer) ([]tagOperationResultForTest[I, R], []jsonWarning) {
+	t.Helper()
+
+	var got struct {
+		Results  []tagOperationResultForTest[I, R] `json:"results"`
+		Warnings []jsonWarning                     `json:"warnings"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if got.Warnings == nil {
+		t.Fatal("warnings = nil, want array")
+	}
+	return got.Results, got.Warnings
+}
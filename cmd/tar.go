@@ -0,0 +1,358 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+// getTar writes the folder src to stdout as a tar stream. Entry names are
+// relative to src, and files keep their client modified times.
+func getTar(cmd *cobra.Command, dbx filesClient, src string, root *files.FolderMetadata, opts getOptions) error {
+	entries, err := listFolderRecursive(dbx, src)
+	if err != nil {
+		return err
+	}
+	rootPath := src
+	if root.PathDisplay != "" {
+		rootPath = root.PathDisplay
+	}
+	// Parents sort before their children, so tar x never sees a file before
+	// its folder entry.
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToLower(metadataPathDisplay(entries[i])) < strings.ToLower(metadataPathDisplay(entries[j]))
+	})
+
+	ignoreBrokenPipeSignal()
+	out := &countingWriter{w: stdoutBrokenPipeWriter{w: cmd.OutOrStdout()}}
+	tw := tar.NewWriter(out)
+	started := time.Now().UTC().Truncate(time.Second)
+
+	for _, entry := range entries {
+		entryPath := metadataPathDisplay(entry)
+		name, err := relativeTo(rootPath, entryPath)
+		if err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails("download"), pathErrorDetails(src))
+		}
+		if name == "" {
+			continue
+		}
+
+		switch f := entry.(type) {
+		case *files.FolderMetadata:
+			err = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: name + "/", Mode: 0755, ModTime: started})
+		case *files.FileMetadata:
+			fmt.Fprintf(getErrorOutput(opts), "Archiving %s\n", f.PathDisplay)
+			err = writeTarFile(tw, dbx, f, name, opts)
+		default:
+			continue
+		}
+		if err != nil {
+			return getTarError(err, src, entryPath, out.n)
+		}
+	}
+	return getTarError(tw.Close(), src, src, out.n)
+}
+
+// writeTarFile adds one file to tw. Export-only files are exported in
+// memory first because a tar header needs the size up front.
+func writeTarFile(tw *tar.Writer, dbx filesClient, f *files.FileMetadata, name string, opts getOptions) error {
+	size := int64(f.Size)
+	var contents io.ReadCloser
+	err := retryWithBackoff(func() error {
+		if !isExportOnlyFile(f) {
+			var err error
+			_, contents, err = dbx.DownloadContext(currentContext(), files.NewDownloadArg(f.PathDisplay))
+			return err
+		}
+		res, exported, err := exportFile(dbx, f.PathDisplay, exportFormatFor(f, opts.exportFormat))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = exported.Close() }()
+		data, err := io.ReadAll(exported)
+		if err != nil {
+			return err
+		}
+		if res != nil && res.ExportMetadata != nil && res.ExportMetadata.Name != "" {
+			name = path.Join(path.Dir(name), res.ExportMetadata.Name)
+		}
+		size = int64(len(data))
+		contents = io.NopCloser(bytes.NewReader(data))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = contents.Close() }()
+
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  time.Time(f.ClientModified).UTC(),
+	}); err != nil {
+		return err
+	}
+	n, err := io.Copy(tw, contents)
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf("downloaded %d bytes, expected %d", n, size)
+	}
+	return nil
+}
+
+// getTarError reports a failure after tar bytes reached stdout as a partial
+// transfer, since the stream cannot be resumed. A closed pipe ends the
+// stream successfully, like any other stdout download.
+func getTarError(err error, src, entryPath string, written int64) error {
+	if err == nil || errors.Is(err, errStdoutBrokenPipe) {
+		return nil
+	}
+	details := mergeJSONErrorDetails(operationErrorDetails("download"), pathErrorDetails(entryPath))
+	if written == 0 {
+		return withJSONErrorDetails(fmt.Errorf("%s: %w", entryPath, err), details)
+	}
+	return withJSONErrorDetails(fmt.Errorf("tar stream of %s failed at %s: %v: %w", src, entryPath, err, partialStdoutError(written)), details)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// putUntar uploads each entry of a tar stream read from stdin, or from a
+// local tar file, under the Dropbox folder dst.
+func putUntar(cmd *cobra.Command, args []string, opts putOptions, recursive bool) error {
+	if len(args) < 2 {
+		return invalidArgumentsErrorWithDetails("`put --untar` requires a source and a target folder", argumentErrorDetails("dst"))
+	}
+	if recursive {
+		return invalidArgumentsErrorWithDetails("`--untar` cannot be used with --recursive", flagsErrorDetails("untar", "recursive"))
+	}
+	if opts.hasRevCondition() {
+		return invalidArgumentsErrorfWithDetails("`--untar` cannot be used with `--%s`", flagsErrorDetails("untar", opts.revConditionFlag()), opts.revConditionFlag())
+	}
+
+	src := args[0]
+	dst, err := validatePath(args[1])
+	if err != nil {
+		return err
+	}
+	target := dst
+	if target == "" {
+		target = "/"
+	}
+	details := mergeJSONErrorDetails(operationErrorDetails("upload"), relocationErrorDetails(src, target))
+
+	var r io.Reader = cmd.InOrStdin()
+	if src != "-" {
+		f, err := os.Open(src)
+		if err != nil {
+			return withJSONErrorDetails(err, details)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	input := putCommandInput{
+		Source:    src,
+		Target:    target,
+		Recursive: false,
+		IfExists:  opts.ifExists,
+		Stdin:     src == "-",
+		Untar:     true,
+		DryRun:    opts.dryRun,
+	}
+	results, warnings, err := putTarStream(r, src, dst, opts)
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			if warning.Code == jsonWarningCodeUploadFailed {
+				commandOutput(cmd).Warn("%s", warning.Message)
+			}
+		}
+	}
+	if opts.dryRun {
+		return renderPlannedPutResults(cmd, input, results, warnings)
+	}
+	return renderPutResultsWithWarnings(cmd, input, results, warnings)
+}
+
+// putTarStream walks the tar entries in r in order. Regular files are
+// uploaded as they are read and folders are created; links and other entry
+// types are skipped with a warning. Each failed upload becomes a warning so
+// one bad entry does not stop the rest of the stream; the stream fails only
+// when nothing could be uploaded.
+func putTarStream(r io.Reader, src, dst string, opts putOptions) ([]putResult, []jsonWarning, error) {
+	var dbx filesClient
+	if !opts.dryRun {
+		dbx = filesNewFunc(config)
+	}
+
+	var results []putResult
+	var warnings []jsonWarning
+	var uploadErrors []error
+	fail := func(path string, err error) {
+		uploadErrors = append(uploadErrors, err)
+		warnings = append(warnings, jsonWarning{
+			Code:    jsonWarningCodeUploadFailed,
+			Message: err.Error(),
+			Path:    path,
+		})
+	}
+
+	if dst != "" {
+		putOutput(opts).Status("Creating directory %s", dst)
+		if opts.dryRun {
+			results = append(results, plannedPutFolderResult(src, dst))
+		} else if result, err := putDirectoryWithResult(dbx, src, dst); err != nil {
+			fail(src, fmt.Errorf("mkdir %s: %w", dst, err))
+		} else {
+			results = append(results, result)
+		}
+	}
+
+	// The target folder alone does not count as a successful upload.
+	folderResults := len(results)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read tar stream: %w", err)
+		}
+		name := tarEntryPath(hdr.Name)
+		if name == "" {
+			continue
+		}
+		remotePath := path.Join("/", dst, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			putOutput(opts).Status("Creating directory %s", remotePath)
+			if opts.dryRun {
+				results = append(results, plannedPutFolderResult(hdr.Name, remotePath))
+				continue
+			}
+			result, err := putDirectoryWithResult(dbx, hdr.Name, remotePath)
+			if err != nil {
+				fail(hdr.Name, fmt.Errorf("mkdir %s: %w", remotePath, err))
+				continue
+			}
+			results = append(results, result)
+		case tar.TypeReg:
+			putOutput(opts).Status("Processing %s -> %s", hdr.Name, remotePath)
+			if opts.dryRun {
+				results = append(results, plannedPutFileResult(hdr.Name, remotePath))
+				continue
+			}
+			result, err := putTarEntry(dbx, tr, hdr, remotePath, opts)
+			if err != nil {
+				fail(hdr.Name, fmt.Errorf("%s: %w", hdr.Name, err))
+				continue
+			}
+			results = append(results, result)
+		case tar.TypeSymlink:
+			warnings = append(warnings, jsonWarning{
+				Code:    jsonWarningCodeSkippedSymlink,
+				Message: "skipped symlink in tar stream",
+				Path:    hdr.Name,
+			})
+		case tar.TypeLink:
+			warnings = append(warnings, jsonWarning{
+				Code:    jsonWarningCodeSkippedLink,
+				Message: "skipped hard link in tar stream",
+				Path:    hdr.Name,
+			})
+		default:
+			putOutput(opts).Status("Skipping %s: unsupported tar entry type %q", hdr.Name, hdr.Typeflag)
+			warnings = append(warnings, jsonWarning{
+				Code:    jsonWarningCodeSkippedUnsupportedEntry,
+				Message: fmt.Sprintf("skipped unsupported tar entry type %q", hdr.Typeflag),
+				Path:    hdr.Name,
+			})
+		}
+	}
+
+	if len(uploadErrors) > 0 && len(results) == folderResults {
+		return nil, nil, batchFailuresError("upload", uploadErrors)
+	}
+	return results, warnings, nil
+}
+
+// putTarEntry uploads the current entry of a tar stream. Small entries are
+// read into memory and sent in one request; larger ones are streamed through
+// an upload session.
+func putTarEntry(dbx filesClient, r io.Reader, hdr *tar.Header, dst string, opts putOptions) (putResult, error) {
+	action, existingMetadata, err := checkPutDestination(dbx, dst, opts.ifExists)
+	if err != nil {
+		return putResult{}, err
+	}
+	if action == putDestinationSkip {
+		reportPutSkipped(opts, dst)
+		return newPutResult(putStatusSkipped, putKindFile, hdr.Name, dst, existingMetadata)
+	}
+
+	commitInfo, err := newPutCommitInfo(dbx, dst, opts.ifExists, opts)
+	if err != nil {
+		return putResult{}, err
+	}
+	commitInfo.ClientModified = dropboxClientModified(hdr.ModTime)
+
+	var metadata *files.FileMetadata
+	if hdr.Size <= singleShotUploadSizeCutoff {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return putResult{}, fmt.Errorf("read tar stream: %w", err)
+		}
+		metadata, err = uploadSingleShot(dbx, bytes.NewReader(data), &files.UploadArg{CommitInfo: *commitInfo}, int64(len(data)), putErrorOutput(opts))
+		return putUploadResult(dbx, hdr.Name, dst, opts.ifExists, opts, commitInfo, metadata, err)
+	}
+	metadata, err = uploadStream(dbx, r, commitInfo, opts.chunkSize, putErrorOutput(opts), opts.debug)
+	return putUploadResult(dbx, hdr.Name, dst, opts.ifExists, opts, commitInfo, metadata, err)
+}
+
+// tarEntryPath cleans a tar entry name into a relative slash path. Leading
+// slashes and .. segments cannot climb out of the target folder.
+func tarEntryPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
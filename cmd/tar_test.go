@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestGetRecursiveStdoutWritesTarStream(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	contents := map[string]string{
+		"/Projects/site/index.html":   "<html>",
+		"/Projects/site/css/site.css": "body{}",
+	}
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestFolderMetadata("/Projects/site"), nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if !arg.Recursive {
+				t.Fatal("tar listing should be recursive")
+			}
+			css := putFileMetadata("/Projects/site/css/site.css", 6)
+			css.ClientModified = dropbox.DBXTime(modified)
+			index := putFileMetadata("/Projects/site/index.html", 6)
+			index.ClientModified = dropbox.DBXTime(modified)
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				index,
+				css,
+				getTestFolderMetadata("/Projects/site/css"),
+				getTestFolderMetadata("/Projects/site"),
+			}}, nil
+		},
+		downloadFn: func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			return nil, io.NopCloser(strings.NewReader(contents[arg.Path])), nil
+		},
+	})
+
+	cmd := testGetCmd()
+	_ = cmd.Flags().Set("recursive", "true")
+	var stdout, stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	if err := get(cmd, []string{"/Projects/site", "-"}); err != nil {
+		t.Fatalf("get -r - error: %v", err)
+	}
+
+	tr := tar.NewReader(&stdout)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read tar: %v", err)
+		}
+		names = append(names, hdr.Name)
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, _ := io.ReadAll(tr)
+		if string(data) != contents["/Projects/site/"+hdr.Name] {
+			t.Fatalf("%s content = %q", hdr.Name, data)
+		}
+		if !hdr.ModTime.Equal(modified) {
+			t.Fatalf("%s mtime = %s, want %s", hdr.Name, hdr.ModTime, modified)
+		}
+	}
+	if got := strings.Join(names, ","); got != "css/,css/site.css,index.html" {
+		t.Fatalf("tar entries = %s", got)
+	}
+	if strings.Contains(stdout.String(), "Archiving") || !strings.Contains(stderr.String(), "Archiving /Projects/site/index.html") {
+		t.Fatalf("stderr = %q, want progress on stderr only", stderr.String())
+	}
+}
+
+func TestGetRecursiveStdoutFailureAfterOutputIsPartialTransfer(t *testing.T) {
+	stubRetrySleep(t)
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestFolderMetadata("/src"), nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				putFileMetadata("/src/a.txt", 1),
+				putFileMetadata("/src/b.txt", 1),
+			}}, nil
+		},
+		downloadFn: func(arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			if arg.Path == "/src/b.txt" {
+				return nil, nil, errors.New("path/restricted_content/")
+			}
+			return nil, io.NopCloser(strings.NewReader("a")), nil
+		},
+	})
+
+	cmd := testGetCmd()
+	_ = cmd.Flags().Set("recursive", "true")
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	err := get(cmd, []string{"/src", "-"})
+	if code := jsonErrorCode(err); code != jsonErrorCodePartialTransfer {
+		t.Fatalf("code = %q, want partial_transfer (err %v)", code, err)
+	}
+	if details := jsonErrorDetails(err); details["path"] != "/src/b.txt" {
+		t.Fatalf("details = %#v, want failing entry path", details)
+	}
+}
+
+func TestPutUntarUploadsEntries(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeDir, Name: "./empty/", Mode: 0755})
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "./css/site.css", Mode: 0644, ModTime: modified}, "body{}")
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeSymlink, Name: "./latest", Linkname: "css"})
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeLink, Name: "./css/copy.css", Linkname: "./css/site.css"})
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeFifo, Name: "./pipe"})
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "../escape.txt", Mode: 0644, ModTime: modified}, "x")
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	uploads := map[string]string{}
+	var folders []string
+	stubFilesClient(t, &mockFilesClient{
+		createFolderV2Fn: func(arg *files.CreateFolderArg) (*files.CreateFolderResult, error) {
+			folders = append(folders, arg.Path)
+			return &files.CreateFolderResult{Metadata: getTestFolderMetadata(arg.Path)}, nil
+		},
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			data, _ := io.ReadAll(content)
+			uploads[arg.Path] = string(data)
+			if arg.ClientModified == nil || !time.Time(*arg.ClientModified).Equal(modified) {
+				t.Fatalf("%s client_modified = %v, want tar mtime", arg.Path, arg.ClientModified)
+			}
+			return putFileMetadata(arg.Path, uint64(len(data))), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := testPutJSONCmd(&stdout, &bytes.Buffer{})
+	cmd.SetIn(&archive)
+	_ = cmd.Flags().Set("untar", "true")
+	if err := put(cmd, []string{"-", "/Projects/site"}); err != nil {
+		t.Fatalf("put --untar error: %v", err)
+	}
+
+	if uploads["/Projects/site/css/site.css"] != "body{}" || uploads["/Projects/site/escape.txt"] != "x" || len(uploads) != 2 {
+		t.Fatalf("uploads = %#v", uploads)
+	}
+	if strings.Join(folders, ",") != "/Projects/site,/Projects/site/empty" {
+		t.Fatalf("folders = %v", folders)
+	}
+	got := decodePutOutputWithWarnings(t, &stdout)
+	if !got.Input.Untar || !got.Input.Stdin || got.Input.Target != "/Projects/site" {
+		t.Fatalf("input = %+v", got.Input)
+	}
+	if len(got.Results) != 4 {
+		t.Fatalf("results = %+v, want root folder, empty folder and two files", got.Results)
+	}
+	wantWarnings := []jsonWarning{
+		{Code: jsonWarningCodeSkippedSymlink, Path: "./latest"},
+		{Code: jsonWarningCodeSkippedLink, Path: "./css/copy.css"},
+		{Code: jsonWarningCodeSkippedUnsupportedEntry, Path: "./pipe"},
+	}
+	if len(got.Warnings) != len(wantWarnings) {
+		t.Fatalf("warnings = %+v, want skipped symlink, hard link, and fifo", got.Warnings)
+	}
+	for i, want := range wantWarnings {
+		if got.Warnings[i].Code != want.Code || got.Warnings[i].Path != want.Path {
+			t.Fatalf("warnings[%d] = %+v, want %s for %s", i, got.Warnings[i], want.Code, want.Path)
+		}
+	}
+}
+
+func TestPutUntarReportsFailedEntriesAsWarnings(t *testing.T) {
+	stubRetrySleep(t)
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "a.txt", Mode: 0644}, "a")
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "b.txt", Mode: 0644}, "b")
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "c.txt", Mode: 0644}, "c")
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			data, _ := io.ReadAll(content)
+			if arg.Path != "/dst/b.txt" {
+				return nil, errors.New("path/insufficient_space/")
+			}
+			return putFileMetadata(arg.Path, uint64(len(data))), nil
+		},
+	})
+
+	var stdout bytes.Buffer
+	cmd := testPutJSONCmd(&stdout, &bytes.Buffer{})
+	cmd.SetIn(&archive)
+	_ = cmd.Flags().Set("untar", "true")
+	if err := put(cmd, []string{"-", "/dst"}); err != nil {
+		t.Fatalf("put --untar error: %v", err)
+	}
+
+	got := decodePutOutputWithWarnings(t, &stdout)
+	if len(got.Results) != 2 || got.Results[1].Input.Target != "/dst/b.txt" {
+		t.Fatalf("results = %+v, want the target folder and b.txt", got.Results)
+	}
+	if len(got.Warnings) != 2 {
+		t.Fatalf("warnings = %+v, want one per failed entry", got.Warnings)
+	}
+	for i, want := range []string{"a.txt", "c.txt"} {
+		if got.Warnings[i].Code != jsonWarningCodeUploadFailed || got.Warnings[i].Path != want {
+			t.Fatalf("warnings[%d] = %+v, want upload_failed for %s", i, got.Warnings[i], want)
+		}
+	}
+}
+
+func TestPutUntarFailsWhenEveryEntryFails(t *testing.T) {
+	stubRetrySleep(t)
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "a.txt", Mode: 0644}, "a")
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "b.txt", Mode: 0644}, "b")
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			return nil, errors.New("path/insufficient_space/")
+		},
+	})
+
+	cmd := testPutCmdWithStdin(&archive)
+	_ = cmd.Flags().Set("untar", "true")
+	cmd.SetOut(io.Discard)
+	err := put(cmd, []string{"-", "/dst"})
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want both failed entries reported", err)
+	}
+	if details := jsonErrorDetails(err); details["operation"] != "upload" {
+		t.Fatalf("details = %#v, want upload operation", details)
+	}
+}
+
+func TestPutUntarDryRunListsEntries(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	writeTestTarEntry(t, tw, &tar.Header{Typeflag: tar.TypeReg, Name: "a.txt", Mode: 0644}, "a")
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, &mockFilesClient{
+		uploadFn: func(arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			t.Fatal("dry-run should not upload")
+			return nil, nil
+		},
+	})
+
+	cmd := testPutCmdWithStdin(&archive)
+	_ = cmd.Flags().Set("untar", "true")
+	_ = cmd.Flags().Set(dryRunFlagName, "true")
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	if err := put(cmd, []string{"-", "/"}); err != nil {
+		t.Fatalf("put --untar dry-run error: %v", err)
+	}
+	if got := stdout.String(); got != "Would upload a.txt to /a.txt\n" {
+		t.Fatalf("stdout = %q", got)
+	}
+}
+
+func TestPutUntarValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+	}{
+		{name: "no target", args: []string{"-"}},
+		{name: "recursive", args: []string{"-", "/dst"}, flags: map[string]string{"recursive": "true"}},
+		{name: "if-rev", args: []string{"-", "/dst"}, flags: map[string]string{"if-rev": "015f3a2b1c0d0000000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testPutCmdWithStdin(failReadReader{t: t})
+			_ = cmd.Flags().Set("untar", "true")
+			for name, value := range tt.flags {
+				_ = cmd.Flags().Set(name, value)
+			}
+			if err := put(cmd, tt.args); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+				t.Fatalf("err = %v, want invalid arguments", err)
+			}
+		})
+	}
+}
+
+func TestTarEntryPathStaysUnderTarget(t *testing.T) {
+	for name, want := range map[string]string{
+		"a/b.txt":         "a/b.txt",
+		"./a/":            "a",
+		"/etc/passwd":     "etc/passwd",
+		"../../x.txt":     "x.txt",
+		"a/../../b/c.txt": "b/c.txt",
+		"./":              "",
+	} {
+		if got := tarEntryPath(name); got != want {
+			t.Errorf("tarEntryPath(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func writeTestTarEntry(t *testing.T, tw *tar.Writer, hdr *tar.Header, content ...string) {
+	t.Helper()
+	data := strings.Join(content, "")
+	hdr.Size = int64(len(data))
+	if err := tw.WriteHeader(hdr); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+	"github.com/spf13/cobra"
+)
+
+const (
+	teamShareLinkAuditOperation       = "team_share_links_audit"
+	teamShareLinkAuditStatusListed    = "listed"
+	teamShareLinkAuditStatusCompliant = "compliant"
+	teamShareLinkAuditStatusViolation = "violation"
+)
+
+type teamShareLinkAuditInput struct {
+	Policy string `json:"policy,omitempty"`
+}
+
+// teamShareLinkAuditJSON is one shared link created by a team member, with
+// the names of the policy rules it breaks.
+type teamShareLinkAuditJSON struct {
+	Type              string   `json:"type"`
+	TeamMemberID      string   `json:"team_member_id"`
+	Email             string   `json:"email"`
+	URL               string   `json:"url"`
+	PathLower         string   `json:"path_lower,omitempty"`
+	Audience          string   `json:"audience,omitempty"`
+	AccessLevel       string   `json:"access_level,omitempty"`
+	Expires           *string  `json:"expires,omitempty"`
+	PasswordProtected bool     `json:"password_protected"`
+	AllowDownload     bool     `json:"allow_download"`
+	Violations        []string `json:"violations,omitempty"`
+}
+
+// teamShareLinkPolicy is the --policy file. A link violates a rule when the
+// rule applies to the link's audience and the link breaks one of the rule's
+// requirements.
+type teamShareLinkPolicy struct {
+	Rules []teamShareLinkPolicyRule `json:"rules"`
+}
+
+type teamShareLinkPolicyRule struct {
+	Name            string   `json:"name"`
+	Audiences       []string `json:"audiences,omitempty"`
+	Forbid          bool     `json:"forbid,omitempty"`
+	RequireExpiry   bool     `json:"require_expiry,omitempty"`
+	MaxExpiryDays   int      `json:"max_expiry_days,omitempty"`
+	RequirePassword bool     `json:"require_password,omitempty"`
+	ForbidDownload  bool     `json:"forbid_download,omitempty"`
+}
+
+func teamShareLinksAudit(cmd *cobra.Command, args []string) error {
+	policyFile, _ := cmd.Flags().GetString("policy")
+	asCSV, _ := cmd.Flags().GetBool("csv")
+	if asCSV && commandOutputFormat(cmd) != output.FormatText {
+		return invalidArgumentsErrorWithDetails("`--csv` cannot be used with `--output json`", flagsErrorDetails("csv", outputFlag))
+	}
+	var policy *teamShareLinkPolicy
+	if policyFile != "" {
+		var err error
+		if policy, err = readTeamShareLinkPolicy(policyFile); err != nil {
+			return err
+		}
+	}
+
+	members, err := listTeamMembers(teamNewFunc(config), team.NewMembersListArg())
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(teamShareLinkAuditOperation))
+	}
+
+	now := time.Now()
+	var rows []teamShareLinkAuditJSON
+	var warnings []jsonWarning
+	audited := 0
+	for _, member := range members {
+		if member.Profile == nil || member.Profile.Status == nil || member.Profile.Status.Tag != team.TeamMemberStatusActive {
+			continue
+		}
+		audited++
+		// Dropbox only lists the links of the member a team token acts as.
+		memberConfig := config
+		memberConfig.AsMemberID = member.Profile.TeamMemberId
+		links, err := listSharedLinks(newSharedLinkClient(memberConfig), sharing.NewListSharedLinksArg())
+		if err != nil {
+			warnings = append(warnings, jsonWarning{Code: jsonWarningCodeMemberAuditFailed, Message: fmt.Sprintf("list shared links of %s: %v", member.Profile.Email, err)})
+			continue
+		}
+		for _, link := range links {
+			metadata, ok := shareLinkJSONMetadataFromDropbox(link)
+			if !ok {
+				continue
+			}
+			row := newTeamShareLinkAuditRow(member.Profile, metadata)
+			if policy != nil {
+				row.Violations = policy.violations(row, now)
+			}
+			rows = append(rows, row)
+		}
+	}
+	if audited > 0 && len(warnings) == audited {
+		return commandFailedErrorfWithDetails("%s", operationErrorDetails(teamShareLinkAuditOperation), warnings[0].Message)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+	commandVerboseStatus(cmd, "Audited %d shared links of %d team members", len(rows), audited)
+
+	results := make([]jsonOperationResult, 0, len(rows))
+	for _, row := range rows {
+		status := teamShareLinkAuditStatusListed
+		if policy != nil {
+			status = teamShareLinkAuditStatusCompliant
+			if len(row.Violations) > 0 {
+				status = teamShareLinkAuditStatusViolation
+			}
+		}
+		results = append(results, newJSONOperationResult(status, row.Type, nil, row))
+	}
+	return renderOperation(cmd, teamShareLinkAuditInput{Policy: policyFile}, results, warnings, func(w io.Writer) error {
+		if asCSV {
+			return renderTeamShareLinkAuditCSV(w, rows)
+		}
+		return renderTeamShareLinkAuditTable(w, rows)
+	})
+}
+
+func newTeamShareLinkAuditRow(member *team.TeamMemberProfile, link shareLinkJSONMetadata) teamShareLinkAuditJSON {
+	row := teamShareLinkAuditJSON{
+		Type:         link.Type,
+		TeamMemberID: member.TeamMemberId,
+		Email:        member.Email,
+		URL:          link.URL,
+		PathLower:    link.PathLower,
+		Expires:      link.Expires,
+	}
+	if permissions := link.Permissions; permissions != nil {
+		row.Audience = shareLinkAudience(permissions)
+		row.AccessLevel = permissions.AccessLevel
+		row.PasswordProtected = permissions.RequirePassword || permissions.ResolvedVisibility == sharing.ResolvedVisibilityPassword || permissions.ResolvedVisibility == sharing.ResolvedVisibilityTeamAndPassword
+		row.AllowDownload = permissions.AllowDownload
+	}
+	return row
+}
+
+func readTeamShareLinkPolicy(filePath string) (*teamShareLinkPolicy, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, withJSONErrorDetails(err, flagValueErrorDetails("policy", filePath))
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+	var policy teamShareLinkPolicy
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, invalidArgumentsErrorfWithDetails("invalid policy file %s: %v", flagValueErrorDetails("policy", filePath), filePath, err)
+	}
+	if len(policy.Rules) == 0 {
+		return nil, invalidArgumentsErrorfWithDetails("policy file %s has no rules", flagValueErrorDetails("policy", filePath), filePath)
+	}
+	names := make(map[string]bool, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		switch {
+		case rule.Name == "":
+			return nil, invalidArgumentsErrorfWithDetails("policy file %s: rule %d has no name", flagValueErrorDetails("policy", filePath), filePath, i+1)
+		case names[rule.Name]:
+			return nil, invalidArgumentsErrorfWithDetails("policy file %s: duplicate rule %q", flagValueErrorDetails("policy", filePath), filePath, rule.Name)
+		case !rule.Forbid && !rule.RequireExpiry && rule.MaxExpiryDays == 0 && !rule.RequirePassword && !rule.ForbidDownload:
+			return nil, invalidArgumentsErrorfWithDetails("policy file %s: rule %q has no requirement", flagValueErrorDetails("policy", filePath), filePath, rule.Name)
+		case rule.MaxExpiryDays < 0:
+			return nil, invalidArgumentsErrorfWithDetails("policy file %s: rule %q has a negative max_expiry_days", flagValueErrorDetails("policy", filePath), filePath, rule.Name)
+		}
+		for j, value := range rule.Audiences {
+			audience, ok := teamShareLinkPolicyAudience(value)
+			if !ok {
+				return nil, invalidArgumentsErrorfWithDetails("policy file %s: rule %q has unknown audience %q: use public, team, members, or no-one", flagValueErrorDetails("policy", filePath), filePath, rule.Name, value)
+			}
+			policy.Rules[i].Audiences[j] = audience
+		}
+		names[rule.Name] = true
+	}
+	return &policy, nil
+}
+
+// teamShareLinkPolicyAudience accepts the --audience spellings, plus the
+// "no_one" spelling that audit output reports, and returns the audience tag.
+func teamShareLinkPolicyAudience(value string) (string, bool) {
+	if value == sharing.LinkAudienceNoOne {
+		return value, true
+	}
+	audience, ok := shareLinkAudienceValue(value)
+	if !ok {
+		return "", false
+	}
+	return audience.Tag, true
+}
+
+// violations returns the names of the rules a link breaks, in policy order.
+func (p *teamShareLinkPolicy) violations(link teamShareLinkAuditJSON, now time.Time) []string {
+	var broken []string
+	for _, rule := range p.Rules {
+		if rule.breaks(link, now) {
+			broken = append(broken, rule.Name)
+		}
+	}
+	return broken
+}
+
+func (r teamShareLinkPolicyRule) breaks(link teamShareLinkAuditJSON, now time.Time) bool {
+	if len(r.Audiences) > 0 && !slices.Contains(r.Audiences, link.Audience) {
+		return false
+	}
+	if r.Forbid {
+		return true
+	}
+	var expires time.Time
+	if link.Expires != nil {
+		expires, _ = time.Parse(time.RFC3339, *link.Expires)
+	}
+	if (r.RequireExpiry || r.MaxExpiryDays > 0) && expires.IsZero() {
+		return true
+	}
+	if r.MaxExpiryDays > 0 && expires.After(now.AddDate(0, 0, r.MaxExpiryDays)) {
+		return true
+	}
+	if r.RequirePassword && !link.PasswordProtected {
+		return true
+	}
+	return r.ForbidDownload && link.AllowDownload
+}
+
+func renderTeamShareLinkAuditTable(out io.Writer, rows []teamShareLinkAuditJSON) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 8, 1, ' ', 0)
+	fmtStr := "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+	fmt.Fprintf(w, fmtStr, "Member", "URL", "Path", "Audience", "Access", "Expires", "Password", "Download", "Violations")
+	for _, row := range rows {
+		fmt.Fprintf(w, fmtStr,
+			row.Email,
+			row.URL,
+			orDash(row.PathLower),
+			orDash(row.Audience),
+			orDash(row.AccessLevel),
+			orDash(teamShareLinkAuditExpires(row)),
+			yesNo(row.PasswordProtected),
+			yesNo(row.AllowDownload),
+			orDash(strings.Join(row.Violations, ",")))
+	}
+	return w.Flush()
+}
+
+func renderTeamShareLinkAuditCSV(out io.Writer, rows []teamShareLinkAuditJSON) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"email", "team_member_id", "url", "path_lower", "type", "audience", "access_level", "expires", "password_protected", "allow_download", "violations"}); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.Write([]string{
+			row.Email,
+			row.TeamMemberID,
+			row.URL,
+			row.PathLower,
+			row.Type,
+			row.Audience,
+			row.AccessLevel,
+			teamShareLinkAuditExpires(row),
+			strconv.FormatBool(row.PasswordProtected),
+			strconv.FormatBool(row.AllowDownload),
+			strings.Join(row.Violations, ";"),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func teamShareLinkAuditExpires(row teamShareLinkAuditJSON) string {
+	if row.Expires == nil {
+		return ""
+	}
+	return *row.Expires
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
+var teamShareLinksCmd = &cobra.Command{
+	Use:   "share-links",
+	Short: "Team shared link commands",
+}
+
+var teamShareLinksAuditCmd = &cobra.Command{
+	Use:   "audit [flags]",
+	Short: "Report every shared link created by team members",
+	Long: `List the shared links of every active team member with their audience,
+access level, expiry, password protection, and download permission. Requires
+a team-access token (dbxcli login team-access) that can act as team members.
+Text output is a table, or CSV with --csv.
+
+With --policy, each link is checked against the rules in a JSON policy file
+and reported as compliant or as a violation naming the broken rules:
+
+  {"rules": [
+    {"name": "public-links-expire", "audiences": ["public"], "max_expiry_days": 90},
+    {"name": "public-links-need-password", "audiences": ["public"], "require_password": true},
+    {"name": "no-open-downloads", "audiences": ["public", "team"], "forbid_download": true}
+  ]}
+
+A rule applies to links whose audience is in "audiences", or to every link
+when "audiences" is omitted. Requirements are "forbid", "require_expiry",
+"max_expiry_days", "require_password", and "forbid_download". Audiences are
+public, team, members, and no-one (or no_one); password-protected links keep
+their audience, so use "require_password" to check passwords.`,
+	Example: `  dbxcli team share-links audit
+  dbxcli team share-links audit --csv > links.csv
+  dbxcli team share-links audit --policy link-policy.json --output=json`,
+	RunE: teamShareLinksAudit,
+}
+
+func init() {
+	teamCmd.AddCommand(teamShareLinksCmd)
+	teamShareLinksCmd.AddCommand(teamShareLinksAuditCmd)
+	teamShareLinksAuditCmd.Flags().String("policy", "", "JSON policy file whose rules flag violating links")
+	teamShareLinksAuditCmd.Flags().Bool("csv", false, "Write text output as CSV")
+	setCommandAuthModes(teamShareLinksAuditCmd, authTokenTypeName(tokenTeamAccess))
+	enableStructuredOutput(teamShareLinksAuditCmd)
+}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+	"github.com/spf13/cobra"
+)
+
+func TestTeamShareLinksAuditJSONFlagsPolicyViolations(t *testing.T) {
+	soon := time.Now().AddDate(0, 0, 30)
+	stubTeamShareLinksAudit(t, map[string][]sharing.IsSharedLinkMetadata{
+		"dbmid:ada": {
+			teamAuditLink("/reports/q3.pdf", "https://example.com/public", sharing.LinkAudiencePublic, nil),
+			teamAuditLink("/reports/q2.pdf", "https://example.com/team", sharing.LinkAudienceTeam, nil),
+		},
+		"dbmid:bob": {
+			teamAuditLink("/plans/roadmap.pdf", "https://example.com/expiring", sharing.LinkAudiencePublic, &soon),
+		},
+	}, nil)
+	policy := writeTeamShareLinkPolicy(t, `{"rules": [
+		{"name": "public-links-expire", "audiences": ["public"], "max_expiry_days": 90},
+		{"name": "no-downloads", "forbid_download": true}
+	]}`)
+
+	var stdout bytes.Buffer
+	cmd := newTeamShareLinksAuditTestCommand(&stdout, nil)
+	setTeamShareLinksAuditFlags(t, cmd, map[string]string{"policy": policy, outputFlag: "json"})
+	if err := teamShareLinksAudit(cmd, nil); err != nil {
+		t.Fatalf("teamShareLinksAudit error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[teamShareLinkAuditInput, teamShareLinkAuditJSON](t, stdout.Bytes())
+	if got.Input.Policy != policy {
+		t.Fatalf("input policy = %q, want %q", got.Input.Policy, policy)
+	}
+	if len(got.Results) != 3 {
+		t.Fatalf("results = %+v, want 3 links", got.Results)
+	}
+	want := []struct {
+		status, email, url, violations string
+	}{
+		{teamShareLinkAuditStatusViolation, "ada@example.com", "https://example.com/public", "public-links-expire"},
+		{teamShareLinkAuditStatusCompliant, "ada@example.com", "https://example.com/team", ""},
+		{teamShareLinkAuditStatusCompliant, "bob@example.com", "https://example.com/expiring", ""},
+	}
+	for i, w := range want {
+		result := got.Results[i]
+		if result.Status != w.status || result.Kind != "file" || result.Result.Email != w.email || result.Result.URL != w.url || strings.Join(result.Result.Violations, ",") != w.violations {
+			t.Fatalf("result %d = %+v, want status %s, member %s, url %s, violations %q", i, result, w.status, w.email, w.url, w.violations)
+		}
+	}
+	if got.Results[0].Result.Audience != sharing.LinkAudiencePublic || got.Results[0].Result.TeamMemberID != "dbmid:ada" {
+		t.Fatalf("first result = %+v, want Ada's public link", got.Results[0].Result)
+	}
+}
+
+func TestTeamShareLinksAuditCSVWritesHeaderAndRows(t *testing.T) {
+	stubTeamShareLinksAudit(t, map[string][]sharing.IsSharedLinkMetadata{
+		"dbmid:ada": {teamAuditLink("/reports/q3.pdf", "https://example.com/public", sharing.LinkAudiencePublic, nil)},
+	}, nil)
+
+	var stdout bytes.Buffer
+	cmd := newTeamShareLinksAuditTestCommand(&stdout, nil)
+	setTeamShareLinksAuditFlags(t, cmd, map[string]string{"csv": "true"})
+	if err := teamShareLinksAudit(cmd, nil); err != nil {
+		t.Fatalf("teamShareLinksAudit error: %v", err)
+	}
+
+	want := "email,team_member_id,url,path_lower,type,audience,access_level,expires,password_protected,allow_download,violations\n" +
+		"ada@example.com,dbmid:ada,https://example.com/public,/reports/q3.pdf,file,public,,,false,false,\n"
+	if stdout.String() != want {
+		t.Fatalf("CSV output = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestTeamShareLinksAuditWarnsWhenMemberFails(t *testing.T) {
+	stubTeamShareLinksAudit(t, map[string][]sharing.IsSharedLinkMetadata{
+		"dbmid:ada": {teamAuditLink("/reports/q3.pdf", "https://example.com/public", sharing.LinkAudiencePublic, nil)},
+	}, map[string]error{"dbmid:bob": errors.New("member is locked")})
+
+	var stdout bytes.Buffer
+	cmd := newTeamShareLinksAuditTestCommand(&stdout, nil)
+	setTeamShareLinksAuditFlags(t, cmd, map[string]string{outputFlag: "json"})
+	if err := teamShareLinksAudit(cmd, nil); err != nil {
+		t.Fatalf("teamShareLinksAudit error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutputWithWarnings[teamShareLinkAuditInput, teamShareLinkAuditJSON](t, stdout.Bytes())
+	if len(got.Results) != 1 || got.Results[0].Status != teamShareLinkAuditStatusListed {
+		t.Fatalf("results = %+v, want Ada's listed link", got.Results)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != jsonWarningCodeMemberAuditFailed || !strings.Contains(got.Warnings[0].Message, "bob@example.com") {
+		t.Fatalf("warnings = %+v, want member_audit_failed for bob@example.com", got.Warnings)
+	}
+}
+
+func TestTeamShareLinksAuditFailsWhenEveryMemberFails(t *testing.T) {
+	stubTeamShareLinksAudit(t, nil, map[string]error{
+		"dbmid:ada": errors.New("member is locked"),
+		"dbmid:bob": errors.New("member is locked"),
+	})
+
+	cmd := newTeamShareLinksAuditTestCommand(nil, nil)
+	err := teamShareLinksAudit(cmd, nil)
+	if err == nil || jsonErrorCode(err) != jsonErrorCodeCommandFailed {
+		t.Fatalf("teamShareLinksAudit error = %v, want command_failed", err)
+	}
+}
+
+func TestTeamShareLinksAuditPolicyAcceptsAudienceFlagSpellings(t *testing.T) {
+	stubTeamShareLinksAudit(t, map[string][]sharing.IsSharedLinkMetadata{
+		"dbmid:ada": {
+			teamAuditLink("/reports/q3.pdf", "https://example.com/public", sharing.LinkAudiencePublic, nil),
+			teamAuditLink("/reports/q2.pdf", "https://example.com/private", sharing.LinkAudienceNoOne, nil),
+		},
+	}, nil)
+	policy := writeTeamShareLinkPolicy(t, `{"rules": [
+		{"name": "flag-spelling", "audiences": ["no-one"], "forbid": true},
+		{"name": "api-spelling", "audiences": ["no_one"], "forbid": true}
+	]}`)
+
+	var stdout bytes.Buffer
+	cmd := newTeamShareLinksAuditTestCommand(&stdout, nil)
+	setTeamShareLinksAuditFlags(t, cmd, map[string]string{"policy": policy, outputFlag: "json"})
+	if err := teamShareLinksAudit(cmd, nil); err != nil {
+		t.Fatalf("teamShareLinksAudit error: %v", err)
+	}
+
+	got := decodeShareLinkOperationOutput[teamShareLinkAuditInput, teamShareLinkAuditJSON](t, stdout.Bytes())
+	if len(got.Results) != 2 {
+		t.Fatalf("results = %+v, want 2 links", got.Results)
+	}
+	if got.Results[0].Status != teamShareLinkAuditStatusCompliant {
+		t.Fatalf("public link = %+v, want compliant", got.Results[0])
+	}
+	if got.Results[1].Status != teamShareLinkAuditStatusViolation || strings.Join(got.Results[1].Result.Violations, ",") != "flag-spelling,api-spelling" {
+		t.Fatalf("no-one link = %+v, want both rules broken", got.Results[1])
+	}
+}
+
+func TestTeamShareLinksAuditRejectsInvalidPolicy(t *testing.T) {
+	for name, body := range map[string]string{
+		"unknown field":     `{"rules": [{"name": "x", "forbid": true, "severity": "high"}]}`,
+		"no rules":          `{"rules": []}`,
+		"no requirement":    `{"rules": [{"name": "x", "audiences": ["public"]}]}`,
+		"duplicate name":    `{"rules": [{"name": "x", "forbid": true}, {"name": "x", "require_password": true}]}`,
+		"unknown audience":  `{"rules": [{"name": "x", "audiences": ["everyone"], "forbid": true}]}`,
+		"password audience": `{"rules": [{"name": "x", "audiences": ["password"], "forbid": true}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			stubTeamClient(t, &mockTeamClient{
+				membersListFn: func(arg *team.MembersListArg) (*team.MembersListResult, error) {
+					t.Fatal("MembersList called with an invalid policy")
+					return nil, nil
+				},
+			})
+			cmd := newTeamShareLinksAuditTestCommand(nil, nil)
+			setTeamShareLinksAuditFlags(t, cmd, map[string]string{"policy": writeTeamShareLinkPolicy(t, body)})
+			err := teamShareLinksAudit(cmd, nil)
+			if jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+				t.Fatalf("teamShareLinksAudit error = %v, want invalid_arguments", err)
+			}
+			if details := jsonErrorDetails(err); details["flag"] != "policy" {
+				t.Fatalf("error details = %#v, want policy flag", details)
+			}
+		})
+	}
+}
+
+func TestTeamShareLinksAuditRejectsCSVWithJSONOutput(t *testing.T) {
+	cmd := newTeamShareLinksAuditTestCommand(nil, nil)
+	setTeamShareLinksAuditFlags(t, cmd, map[string]string{"csv": "true", outputFlag: "json"})
+	if err := teamShareLinksAudit(cmd, nil); jsonErrorCode(err) != jsonErrorCodeInvalidArguments {
+		t.Fatalf("teamShareLinksAudit error = %v, want invalid_arguments", err)
+	}
+}
+
+func TestTeamShareLinksAuditUsesTeamAccessToken(t *testing.T) {
+	if got := tokenType(teamShareLinksAuditCmd); got != tokenTeamAccess {
+		t.Fatalf("tokenType = %q, want %q", got, tokenTeamAccess)
+	}
+	if got := tokenType(listMembersCmd); got != tokenTeamManage {
+		t.Fatalf("team list-members tokenType = %q, want %q", got, tokenTeamManage)
+	}
+}
+
+func stubTeamShareLinksAudit(t *testing.T, links map[string][]sharing.IsSharedLinkMetadata, failures map[string]error) {
+	t.Helper()
+	stubTeamClient(t, &mockTeamClient{
+		membersListFn: func(arg *team.MembersListArg) (*team.MembersListResult, error) {
+			invited := testTeamMember("dbmid:eve", "eve@example.com", "Eve User")
+			invited.Profile.Status.Tag = team.TeamMemberStatusInvited
+			return team.NewMembersListResult([]*team.TeamMemberInfo{
+				testTeamMember("dbmid:ada", "ada@example.com", "Ada User"),
+				testTeamMember("dbmid:bob", "bob@example.com", "Bob User"),
+				invited,
+			}, "", false), nil
+		},
+	})
+	orig := newSharedLinkClient
+	newSharedLinkClient = func(cfg dropbox.Config) sharedLinkClient {
+		memberID := cfg.AsMemberID
+		return &mockSharedLinkClient{
+			listSharedLinksFn: func(arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+				if memberID == "dbmid:eve" {
+					t.Fatal("ListSharedLinks called for an invited member")
+				}
+				if err := failures[memberID]; err != nil {
+					return nil, err
+				}
+				return sharing.NewListSharedLinksResult(links[memberID], false), nil
+			},
+		}
+	}
+	t.Cleanup(func() { newSharedLinkClient = orig })
+}
+
+func teamAuditLink(pathLower, url, audience string, expires *time.Time) *sharing.FileLinkMetadata {
+	link := sharedLinkFile(pathLower, url)
+	link.LinkPermissions = &sharing.LinkPermissions{EffectiveAudience: &sharing.LinkAudience{Tagged: dropbox.Tagged{Tag: audience}}}
+	if expires != nil {
+		expiry := dropbox.DBXTime(*expires)
+		link.Expires = &expiry
+	}
+	return link
+}
+
+func writeTeamShareLinkPolicy(t *testing.T, body string) string {
+	t.Helper()
+	policy := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(policy, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return policy
+}
+
+func setTeamShareLinksAuditFlags(t *testing.T, cmd *cobra.Command, values map[string]string) {
+	t.Helper()
+	for name, value := range values {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newTeamShareLinksAuditTestCommand(stdout, stderr *bytes.Buffer) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("policy", "", "")
+	cmd.Flags().Bool("csv", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().Bool("verbose", false, "")
+	if stdout != nil {
+		cmd.SetOut(stdout)
+	}
+	if stderr != nil {
+		cmd.SetErr(stderr)
+	}
+	return cmd
+}
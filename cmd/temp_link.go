@@ -0,0 +1,213 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	tempLinkStatusCreated = "created"
+	tempLinkStatusSkipped = "skipped"
+	tempLinkKindDownload  = "download_link"
+	tempLinkKindUpload    = "upload_link"
+
+	tempLinkOperationGet    = "get_temporary_link"
+	tempLinkOperationUpload = "get_temporary_upload_link"
+
+	// Dropbox temporary download links always expire after four hours;
+	// upload links accept a lifetime between one minute and four hours.
+	tempLinkDownloadLifetime  = 4 * time.Hour
+	tempLinkMinUploadDuration = time.Minute
+	tempLinkMaxUploadDuration = 4 * time.Hour
+)
+
+type tempLinkInput struct {
+	Path string `json:"path"`
+}
+
+type tempLinkUploadInput struct {
+	Path     string `json:"path"`
+	IfExists string `json:"if_exists"`
+	Duration string `json:"duration"`
+}
+
+// tempLinkJSON is a temporary link and when it stops working. Metadata is the
+// linked file for download links, or the existing file when an upload link
+// was skipped.
+type tempLinkJSON struct {
+	Link     string        `json:"link,omitempty"`
+	Expires  string        `json:"expires,omitempty"`
+	Metadata *jsonMetadata `json:"metadata,omitempty"`
+}
+
+func tempLinkGet(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`temp-link get` requires a `path` argument", argumentErrorDetails("path"))
+	}
+	src := newDropboxReference(args[0]).String()
+
+	dbx := filesNewFunc(config)
+	created := time.Now().UTC()
+	res, err := dbx.GetTemporaryLinkContext(currentContext(), files.NewGetTemporaryLinkArg(src))
+	if err != nil {
+		return withJSONErrorDetails(err, operationErrorDetails(tempLinkOperationGet), pathErrorDetails(src))
+	}
+
+	result := tempLinkJSON{
+		Link:    res.Link,
+		Expires: created.Add(tempLinkDownloadLifetime).Format(time.RFC3339),
+	}
+	if res.Metadata != nil {
+		metadata, err := jsonMetadataFromDropbox(res.Metadata)
+		if err != nil {
+			return err
+		}
+		result.Metadata = &metadata
+	}
+	input := tempLinkInput{Path: src}
+	return renderTempLink(cmd, input, tempLinkStatusCreated, tempLinkKindDownload, input, result)
+}
+
+func tempLinkUpload(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`temp-link upload` requires a `path` argument", argumentErrorDetails("path"))
+	}
+	dst, err := validatePath(args[0])
+	if err != nil {
+		return err
+	}
+	if dst == "" {
+		return invalidArgumentsErrorWithDetails("`temp-link upload` requires a file `path`, not the root folder", mergeJSONErrorDetails(argumentErrorDetails("path"), pathErrorDetails("/")))
+	}
+
+	ifExists, err := parsePutIfExists(cmd)
+	if err != nil {
+		return err
+	}
+	duration, _ := cmd.Flags().GetDuration("duration")
+	if duration < tempLinkMinUploadDuration || duration > tempLinkMaxUploadDuration {
+		return invalidArgumentsErrorfWithDetails("invalid --duration %s (use between %s and %s)", flagValueErrorDetails("duration", duration.String()), duration, tempLinkMinUploadDuration, tempLinkMaxUploadDuration)
+	}
+
+	input := tempLinkUploadInput{Path: dst, IfExists: ifExists, Duration: duration.String()}
+	resultInput := tempLinkInput{Path: dst}
+	details := mergeJSONErrorDetails(operationErrorDetails(tempLinkOperationUpload), pathErrorDetails(dst))
+
+	dbx := filesNewFunc(config)
+	action, existing, err := checkPutDestination(dbx, dst, ifExists)
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+	if action == putDestinationSkip {
+		var result tempLinkJSON
+		if existing != nil {
+			metadata, err := jsonMetadataFromDropbox(existing)
+			if err != nil {
+				return err
+			}
+			result.Metadata = &metadata
+		}
+		commandVerboseStatus(cmd, "Skipped %s: destination already exists", dst)
+		return renderTempLink(cmd, input, tempLinkStatusSkipped, tempLinkKindUpload, resultInput, result)
+	}
+
+	// The upload happens later, so the commit mode carries --if-exists to the
+	// server: a file created after this check still fails the upload.
+	commitInfo := files.NewCommitInfo(dst)
+	commitInfo.Mode.Tag = writeModeForIfExists(ifExists)
+	commitInfo.StrictConflict = ifExists != putIfExistsOverwrite
+	commitInfo.Autorename = ifExists == putIfExistsAutorename
+
+	arg := files.NewGetTemporaryUploadLinkArg(commitInfo)
+	arg.Duration = duration.Seconds()
+	created := time.Now().UTC()
+	res, err := dbx.GetTemporaryUploadLinkContext(currentContext(), arg)
+	if err != nil {
+		return withJSONErrorDetails(err, details)
+	}
+
+	return renderTempLink(cmd, input, tempLinkStatusCreated, tempLinkKindUpload, resultInput, tempLinkJSON{
+		Link:    res.Link,
+		Expires: created.Add(duration).Format(time.RFC3339),
+	})
+}
+
+// renderTempLink prints just the link in text mode so it can be captured
+// with $(...).
+func renderTempLink(cmd *cobra.Command, input any, status, kind string, resultInput tempLinkInput, result tempLinkJSON) error {
+	return renderOperation(cmd, input, []jsonOperationResult{
+		newJSONOperationResult(status, kind, resultInput, result),
+	}, nil, func(w io.Writer) error {
+		if result.Link == "" {
+			return nil
+		}
+		commandVerboseStatus(cmd, "Link for %s expires at %s", resultInput.Path, result.Expires)
+		_, err := fmt.Fprintln(w, result.Link)
+		return err
+	})
+}
+
+var tempLinkCmd = &cobra.Command{
+	Use:   "temp-link",
+	Short: "Temporary direct links for downloads and uploads",
+	Long: `Create short-lived URLs that let systems without Dropbox credentials
+download or upload a single file.
+
+Anyone holding a temporary link can use it until it expires, so treat the
+output like a credential and keep it out of logs.`,
+}
+
+var tempLinkGetCmd = &cobra.Command{
+	Use:   "get [flags] <path>",
+	Short: "Create a temporary download link for a file",
+	Long: `Create a direct download link for a Dropbox file.
+  - The link expires after four hours.
+  - The file content is served directly; no Dropbox account is needed.
+`,
+	Example: `  dbxcli temp-link get /Builds/app.tar.gz
+  curl -fsSL "$(dbxcli temp-link get /Builds/app.tar.gz)" -o app.tar.gz`,
+	RunE: tempLinkGet,
+}
+
+var tempLinkUploadCmd = &cobra.Command{
+	Use:   "upload [flags] <path>",
+	Short: "Create a temporary upload link for a file path",
+	Long: `Create a link that accepts one upload to a Dropbox path.
+  - Upload by POSTing the file with Content-Type: application/octet-stream.
+  - --duration sets how long the link works, from 1m to 4h (default 4h).
+  - --if-exists works like put: overwrite (default), skip, autorename, or
+    fail. skip creates no link when the file already exists; fail and skip
+    also make the later upload fail if the file appears in the meantime.
+`,
+	Example: `  dbxcli temp-link upload /Builds/app.tar.gz
+  dbxcli temp-link upload --duration 30m --if-exists fail /Inbox/report.pdf`,
+	RunE: tempLinkUpload,
+}
+
+func init() {
+	RootCmd.AddCommand(tempLinkCmd)
+	tempLinkCmd.AddCommand(tempLinkGetCmd)
+	tempLinkCmd.AddCommand(tempLinkUploadCmd)
+	tempLinkUploadCmd.Flags().Duration("duration", tempLinkMaxUploadDuration, "How long the upload link works, from 1m to 4h")
+	tempLinkUploadCmd.Flags().String("if-exists", putIfExistsOverwrite, "What to do when the destination exists: overwrite, skip, autorename, or fail")
+	enableStructuredOutput(tempLinkGetCmd)
+	enableStructuredOutput(tempLinkUploadCmd)
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+type tempLinkOutput struct {
+	Input   tempLinkUploadInput `json:"input"`
+	Results []struct {
+		Status string        `json:"status"`
+		Kind   string        `json:"kind"`
+		Input  tempLinkInput `json:"input"`
+		Result tempLinkJSON  `json:"result"`
+	} `json:"results"`
+}
+
+func TestTempLinkGetJSONOutputsLink(t *testing.T) {
+	cmd, stdout := testTempLinkCmd(map[string]string{outputFlag: "json"})
+	var called time.Time
+	stubFilesClient(t, &mockFilesClient{
+		getTemporaryLinkFn: func(arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error) {
+			if arg.Path != "/Builds/app.tar.gz" {
+				t.Fatalf("temporary link path = %q", arg.Path)
+			}
+			called = time.Now().UTC()
+			return &files.GetTemporaryLinkResult{Metadata: getTestFileMetadata(arg.Path, 10), Link: "https://dl.example/app"}, nil
+		},
+	})
+
+	before := time.Now().UTC()
+	if err := tempLinkGet(cmd, []string{"Builds/app.tar.gz"}); err != nil {
+		t.Fatalf("temp-link get error: %v", err)
+	}
+
+	got := decodeTempLinkOutput(t, stdout)
+	if len(got.Results) != 1 {
+		t.Fatalf("results = %#v, want one", got.Results)
+	}
+	result := got.Results[0]
+	if result.Status != tempLinkStatusCreated || result.Kind != tempLinkKindDownload || result.Result.Link != "https://dl.example/app" {
+		t.Fatalf("result = %#v", result)
+	}
+	if result.Result.Metadata == nil || result.Result.Metadata.PathDisplay != "/Builds/app.tar.gz" {
+		t.Fatalf("metadata = %#v", result.Result.Metadata)
+	}
+	expires, err := time.Parse(time.RFC3339, result.Result.Expires)
+	if err != nil || expires.Before(before.Add(tempLinkDownloadLifetime).Add(-time.Second)) {
+		t.Fatalf("expires = %q, want about four hours from now", result.Result.Expires)
+	}
+	if expires.After(called.Add(tempLinkDownloadLifetime)) {
+		t.Fatalf("expires = %q, want four hours from before the API call at %s", result.Result.Expires, called.Format(time.RFC3339))
+	}
+}
+
+func TestTempLinkGetTextPrintsOnlyLink(t *testing.T) {
+	cmd, stdout := testTempLinkCmd(nil)
+	stubFilesClient(t, &mockFilesClient{
+		getTemporaryLinkFn: func(arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error) {
+			return &files.GetTemporaryLinkResult{Link: "https://dl.example/app"}, nil
+		},
+	})
+
+	if err := tempLinkGet(cmd, []string{"/Builds/app.tar.gz"}); err != nil {
+		t.Fatalf("temp-link get error: %v", err)
+	}
+	if stdout.String() != "https://dl.example/app\n" {
+		t.Fatalf("stdout = %q, want only the link", stdout.String())
+	}
+}
+
+func TestTempLinkUploadJSONMapsIfExistsToCommitMode(t *testing.T) {
+	tests := []struct {
+		ifExists   string
+		mode       string
+		strict     bool
+		autorename bool
+	}{
+		{ifExists: putIfExistsOverwrite, mode: files.WriteModeOverwrite},
+		{ifExists: putIfExistsFail, mode: files.WriteModeAdd, strict: true},
+		{ifExists: putIfExistsAutorename, mode: files.WriteModeAdd, strict: true, autorename: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ifExists, func(t *testing.T) {
+			cmd, stdout := testTempLinkCmd(map[string]string{outputFlag: "json", "if-exists": tt.ifExists, "duration": "30m"})
+			var got *files.GetTemporaryUploadLinkArg
+			stubFilesClient(t, &mockFilesClient{
+				getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+					return nil, saveURLNotFoundError()
+				},
+				getTemporaryUploadLinkFn: func(arg *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error) {
+					got = arg
+					return &files.GetTemporaryUploadLinkResult{Link: "https://upload.example/abc"}, nil
+				},
+			})
+
+			if err := tempLinkUpload(cmd, []string{"/Inbox/report.pdf"}); err != nil {
+				t.Fatalf("temp-link upload error: %v", err)
+			}
+			if got == nil || got.CommitInfo.Path != "/Inbox/report.pdf" || got.Duration != 1800 {
+				t.Fatalf("upload link arg = %#v", got)
+			}
+			if info := got.CommitInfo; info.Mode.Tag != tt.mode || info.StrictConflict != tt.strict || info.Autorename != tt.autorename {
+				t.Fatalf("commit info = mode %s strict %t autorename %t", info.Mode.Tag, info.StrictConflict, info.Autorename)
+			}
+
+			output := decodeTempLinkOutput(t, stdout)
+			if output.Input.IfExists != tt.ifExists || output.Input.Duration != "30m0s" {
+				t.Fatalf("input = %#v", output.Input)
+			}
+			if len(output.Results) != 1 || output.Results[0].Kind != tempLinkKindUpload || output.Results[0].Result.Link != "https://upload.example/abc" || output.Results[0].Result.Expires == "" {
+				t.Fatalf("results = %#v", output.Results)
+			}
+		})
+	}
+}
+
+func TestTempLinkUploadSkipsExistingFile(t *testing.T) {
+	cmd, stdout := testTempLinkCmd(map[string]string{outputFlag: "json", "if-exists": putIfExistsSkip})
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestFileMetadata(arg.Path, 3), nil
+		},
+		getTemporaryUploadLinkFn: func(arg *files.GetTemporaryUploadLinkArg) (*files.GetTemporaryUploadLinkResult, error) {
+			t.Fatal("GetTemporaryUploadLinkContext should not be called")
+			return nil, nil
+		},
+	})
+
+	if err := tempLinkUpload(cmd, []string{"/Inbox/report.pdf"}); err != nil {
+		t.Fatalf("temp-link upload error: %v", err)
+	}
+	output := decodeTempLinkOutput(t, stdout)
+	if len(output.Results) != 1 || output.Results[0].Status != tempLinkStatusSkipped || output.Results[0].Result.Link != "" || output.Results[0].Result.Metadata == nil {
+		t.Fatalf("results = %#v, want skipped with existing metadata", output.Results)
+	}
+}
+
+func TestTempLinkUploadErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+		code  string
+		want  map[string]any
+	}{
+		{name: "no path", code: jsonErrorCodeInvalidArguments, want: map[string]any{"argument": "path"}},
+		{name: "root", args: []string{"/"}, code: jsonErrorCodeInvalidArguments, want: map[string]any{"path": "/"}},
+		{name: "short duration", args: []string{"/a.txt"}, flags: map[string]string{"duration": "30s"}, code: jsonErrorCodeInvalidArguments, want: map[string]any{"flag": "duration", "value": "30s"}},
+		{name: "long duration", args: []string{"/a.txt"}, flags: map[string]string{"duration": "5h"}, code: jsonErrorCodeInvalidArguments, want: map[string]any{"flag": "duration"}},
+		{name: "bad if-exists", args: []string{"/a.txt"}, flags: map[string]string{"if-exists": "replace"}, code: jsonErrorCodeInvalidArguments, want: map[string]any{"flag": "if-exists"}},
+		{name: "fail existing", args: []string{"/a.txt"}, flags: map[string]string{"if-exists": putIfExistsFail}, code: jsonErrorCodePathConflict, want: map[string]any{"path": "/a.txt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testTempLinkCmd(tt.flags)
+			stubFilesClient(t, &mockFilesClient{
+				getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+					return getTestFileMetadata(arg.Path, 1), nil
+				},
+			})
+
+			err := tempLinkUpload(cmd, tt.args)
+			if code := jsonErrorCode(err); code != tt.code {
+				t.Fatalf("code = %q, want %q (err %v)", code, tt.code, err)
+			}
+			details := jsonErrorDetails(err)
+			for key, value := range tt.want {
+				if details[key] != value {
+					t.Fatalf("details = %#v, want %s=%v", details, key, value)
+				}
+			}
+		})
+	}
+}
+
+func TestTempLinkGetAPIErrorDetails(t *testing.T) {
+	cmd, _ := testTempLinkCmd(nil)
+	stubFilesClient(t, &mockFilesClient{
+		getTemporaryLinkFn: func(arg *files.GetTemporaryLinkArg) (*files.GetTemporaryLinkResult, error) {
+			return nil, errors.New("path/not_found/")
+		},
+	})
+
+	err := tempLinkGet(cmd, []string{"/missing.txt"})
+	if details := jsonErrorDetails(err); details["operation"] != tempLinkOperationGet || details["path"] != "/missing.txt" {
+		t.Fatalf("details = %#v (err %v)", details, err)
+	}
+}
+
+func testTempLinkCmd(flags map[string]string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "temp-link"}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().Duration("duration", tempLinkMaxUploadDuration, "")
+	cmd.Flags().String("if-exists", putIfExistsOverwrite, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			panic(err)
+		}
+	}
+	return cmd, &stdout
+}
+
+func decodeTempLinkOutput(t *testing.T, stdout *bytes.Buffer) tempLinkOutput {
+	t.Helper()
+	var got tempLinkOutput
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	return got
+}
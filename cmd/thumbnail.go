@@ -0,0 +1,359 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const (
+	thumbnailKind      = "thumbnail"
+	thumbnailOperation = "thumbnail"
+
+	// thumbnailBatchSize is the most entries files/get_thumbnail_batch
+	// accepts in one call.
+	thumbnailBatchSize = 25
+)
+
+var (
+	thumbnailFormats = []string{files.ThumbnailFormatJpeg, files.ThumbnailFormatPng, files.ThumbnailFormatWebp}
+	thumbnailModes   = []string{files.ThumbnailModeStrict, files.ThumbnailModeBestfit, files.ThumbnailModeFitoneBestfit}
+	thumbnailSizes   = []string{
+		files.ThumbnailSizeW32h32,
+		files.ThumbnailSizeW64h64,
+		files.ThumbnailSizeW128h128,
+		files.ThumbnailSizeW256h256,
+		files.ThumbnailSizeW480h320,
+		files.ThumbnailSizeW640h480,
+		files.ThumbnailSizeW960h640,
+		files.ThumbnailSizeW1024h768,
+		files.ThumbnailSizeW2048h1536,
+	}
+
+	// thumbnailImageExtensions are the file types Dropbox can thumbnail. They
+	// select which children of a folder argument are requested.
+	thumbnailImageExtensions = []string{".bmp", ".gif", ".jpeg", ".jpg", ".png", ".ppm", ".tif", ".tiff", ".webp"}
+)
+
+type thumbnailInput struct {
+	Paths  []string `json:"paths"`
+	OutDir string   `json:"out_dir"`
+	Size   string   `json:"size"`
+	Format string   `json:"format"`
+	Mode   string   `json:"mode"`
+}
+
+type thumbnailOptions struct {
+	size   string
+	format string
+	mode   string
+	outDir string
+}
+
+// thumbnailRequest is one file to thumbnail and the local file it is
+// written to.
+type thumbnailRequest struct {
+	source string
+	target string
+}
+
+func thumbnail(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return invalidArgumentsErrorWithDetails("`thumbnail` requires at least one `path` argument", argumentErrorDetails("path"))
+	}
+	opts, err := parseThumbnailOptions(cmd)
+	if err != nil {
+		return err
+	}
+	toStdout := opts.outDir == "-"
+	if toStdout && commandOutputFormat(cmd) == output.FormatJSON {
+		return invalidArgumentsErrorWithDetails("`thumbnail --output=json` cannot be used with `--out-dir -`", mergeJSONErrorDetails(operationErrorDetails(thumbnailOperation), flagsErrorDetails("out-dir", "output")))
+	}
+
+	dbx := filesNewFunc(config)
+	sources, err := thumbnailSources(dbx, args)
+	if err != nil {
+		return err
+	}
+	if toStdout && len(sources) != 1 {
+		return invalidArgumentsErrorfWithDetails("`--out-dir -` writes a single thumbnail to stdout, but %d files were requested", mergeJSONErrorDetails(operationErrorDetails(thumbnailOperation), flagValueErrorDetails("out-dir", "-")), len(sources))
+	}
+
+	requests, err := thumbnailRequests(sources, opts)
+	if err != nil {
+		return err
+	}
+	if !toStdout {
+		if err := os.MkdirAll(opts.outDir, 0755); err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(thumbnailOperation), pathErrorDetails(opts.outDir))
+		}
+	}
+
+	var (
+		results  []getResult
+		warnings []jsonWarning
+		failures []error
+	)
+	for start := 0; start < len(requests); start += thumbnailBatchSize {
+		batch := requests[start:min(start+thumbnailBatchSize, len(requests))]
+		res, err := dbx.GetThumbnailBatchContext(currentContext(), files.NewGetThumbnailBatchArg(thumbnailArgs(batch, opts)))
+		if err != nil {
+			return withJSONErrorDetails(err, operationErrorDetails(thumbnailOperation), thumbnailPathsErrorDetails(batch))
+		}
+		if res == nil || len(res.Entries) != len(batch) {
+			return commandFailedErrorfWithDetails("%s: Dropbox returned an unexpected number of results", operationErrorDetails(thumbnailOperation), thumbnailOperation)
+		}
+
+		for i, entry := range res.Entries {
+			request := batch[i]
+			if entry == nil || entry.Tag != files.GetThumbnailBatchResultEntrySuccess || entry.Success == nil {
+				var failure *files.ThumbnailError
+				if entry != nil {
+					failure = entry.Failure
+				}
+				err := thumbnailEntryError(request.source, failure)
+				failures = append(failures, err)
+				warnings = append(warnings, jsonWarning{Code: jsonWarningCodeThumbnailFailed, Message: err.Error(), Path: request.source})
+				continue
+			}
+
+			data, err := base64.StdEncoding.DecodeString(entry.Success.Thumbnail)
+			if err != nil {
+				return commandFailedErrorfWithDetails("decode thumbnail for %s: %v", mergeJSONErrorDetails(operationErrorDetails(thumbnailOperation), pathErrorDetails(request.source)), request.source, err)
+			}
+			if toStdout {
+				_, err := cmd.OutOrStdout().Write(data)
+				return withJSONErrorDetails(err, operationErrorDetails(thumbnailOperation), pathErrorDetails(request.source))
+			}
+			if err := writeDownloadFile(request.target, bytes.NewReader(data)); err != nil {
+				return withJSONErrorDetails(err, operationErrorDetails(thumbnailOperation), pathErrorDetails(request.source), relocationErrorDetails(request.source, request.target))
+			}
+
+			result, err := newGetResult(getStatusDownloaded, thumbnailKind, request.source, request.target, entry.Success.Metadata)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return batchFailuresError(thumbnailOperation, failures)
+	}
+	if commandOutputFormat(cmd) == output.FormatText {
+		for _, warning := range warnings {
+			commandOutput(cmd).Warn("%s", warning.Message)
+		}
+	}
+	for _, result := range results {
+		commandVerboseStatus(cmd, "Downloaded thumbnail %s -> %s", result.Input.Source, result.Input.Target)
+	}
+
+	input := thumbnailInput{
+		Paths:  sources,
+		OutDir: opts.outDir,
+		Size:   opts.size,
+		Format: opts.format,
+		Mode:   opts.mode,
+	}
+	return renderJSONOperationOutputWithWarnings(cmd, input, getOperationResults(results), warnings)
+}
+
+func parseThumbnailOptions(cmd *cobra.Command) (thumbnailOptions, error) {
+	var opts thumbnailOptions
+	opts.size, _ = cmd.Flags().GetString("size")
+	opts.format, _ = cmd.Flags().GetString("format")
+	opts.mode, _ = cmd.Flags().GetString("mode")
+	opts.outDir, _ = cmd.Flags().GetString("out-dir")
+
+	for _, flag := range []struct {
+		name    string
+		value   string
+		allowed []string
+	}{
+		{name: "size", value: opts.size, allowed: thumbnailSizes},
+		{name: "format", value: opts.format, allowed: thumbnailFormats},
+		{name: "mode", value: opts.mode, allowed: thumbnailModes},
+	} {
+		if !slices.Contains(flag.allowed, flag.value) {
+			return thumbnailOptions{}, invalidArgumentsErrorfWithDetails("invalid --%s %q: use %s", flagValueErrorDetails(flag.name, flag.value), flag.name, flag.value, strings.Join(flag.allowed, ", "))
+		}
+	}
+	if opts.outDir == "" {
+		return thumbnailOptions{}, invalidArgumentsErrorWithDetails("`--out-dir` cannot be empty", flagErrorDetails("out-dir"))
+	}
+	return opts, nil
+}
+
+// thumbnailSources resolves the command arguments to the files to thumbnail.
+// A single folder argument expands to the images directly inside it; any
+// other arguments are requested as given.
+func thumbnailSources(dbx filesClient, args []string) ([]string, error) {
+	sources := make([]string, 0, len(args))
+	for _, arg := range args {
+		sources = append(sources, newDropboxReference(arg).String())
+	}
+	if len(sources) != 1 {
+		return sources, nil
+	}
+
+	meta, err := dbx.GetMetadataContext(currentContext(), files.NewGetMetadataArg(sources[0]))
+	if err != nil {
+		return nil, withJSONErrorDetails(fmt.Errorf("get metadata for %s: %v", sources[0], err), operationErrorDetails(thumbnailOperation), pathErrorDetails(sources[0]))
+	}
+	folder, ok := meta.(*files.FolderMetadata)
+	if !ok {
+		return sources, nil
+	}
+
+	entries, err := listThumbnailFolder(dbx, sources[0])
+	if err != nil {
+		return nil, withJSONErrorDetails(err, operationErrorDetails(thumbnailOperation), pathErrorDetails(sources[0]))
+	}
+	sources = sources[:0]
+	for _, entry := range entries {
+		file, ok := entry.(*files.FileMetadata)
+		if !ok || !slices.Contains(thumbnailImageExtensions, strings.ToLower(path.Ext(file.Name))) {
+			continue
+		}
+		sources = append(sources, file.PathDisplay)
+	}
+	if len(sources) == 0 {
+		return nil, invalidArgumentsErrorfWithDetails("%s contains no images to thumbnail", mergeJSONErrorDetails(operationErrorDetails(thumbnailOperation), pathErrorDetails(folder.PathDisplay)), folder.PathDisplay)
+	}
+	return sources, nil
+}
+
+func listThumbnailFolder(dbx filesClient, folder string) ([]files.IsMetadata, error) {
+	res, err := dbx.ListFolderContext(currentContext(), files.NewListFolderArg(folder))
+	if err != nil {
+		return nil, fmt.Errorf("list folder %s: %v", folder, err)
+	}
+	entries := res.Entries
+	for res.HasMore {
+		res, err = dbx.ListFolderContinueContext(currentContext(), files.NewListFolderContinueArg(res.Cursor))
+		if err != nil {
+			return nil, fmt.Errorf("list folder continue: %v", err)
+		}
+		entries = append(entries, res.Entries...)
+	}
+	return entries, nil
+}
+
+// thumbnailRequests assigns each source a local file named after the source
+// with the extension of the requested format. Two sources that would write the
+// same local file are rejected up front.
+func thumbnailRequests(sources []string, opts thumbnailOptions) ([]thumbnailRequest, error) {
+	requests := make([]thumbnailRequest, 0, len(sources))
+	targets := make(map[string]string, len(sources))
+	for _, source := range sources {
+		base := path.Base(source)
+		name := strings.TrimSuffix(base, path.Ext(base)) + thumbnailExtension(opts.format)
+		target := filepath.Join(opts.outDir, name)
+		key := strings.ToLower(target)
+		if previous, ok := targets[key]; ok {
+			return nil, pathConflictErrorWithPath(target, "%s and %s would both be written to %s", previous, source, target)
+		}
+		targets[key] = source
+		requests = append(requests, thumbnailRequest{source: source, target: target})
+	}
+	return requests, nil
+}
+
+func thumbnailExtension(format string) string {
+	if format == files.ThumbnailFormatJpeg {
+		return ".jpg"
+	}
+	return "." + format
+}
+
+func thumbnailArgs(requests []thumbnailRequest, opts thumbnailOptions) []*files.ThumbnailArg {
+	args := make([]*files.ThumbnailArg, 0, len(requests))
+	for _, request := range requests {
+		arg := files.NewThumbnailArg(request.source)
+		arg.Size = &files.ThumbnailSize{Tagged: dropbox.Tagged{Tag: opts.size}}
+		arg.Format = &files.ThumbnailFormat{Tagged: dropbox.Tagged{Tag: opts.format}}
+		arg.Mode = &files.ThumbnailMode{Tagged: dropbox.Tagged{Tag: opts.mode}}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// thumbnailEntryError maps a per-file batch failure to a coded error.
+func thumbnailEntryError(source string, failure *files.ThumbnailError) error {
+	details := mergeJSONErrorDetails(operationErrorDetails(thumbnailOperation), pathErrorDetails(source))
+	if failure == nil {
+		return commandFailedErrorfWithDetails("thumbnail %s: Dropbox returned no result", details, source)
+	}
+
+	summary := failure.Tag
+	reason := failure.Tag
+	code := jsonErrorCodeDropboxAPIError
+	if failure.Tag == files.ThumbnailErrorPath && failure.Path != nil {
+		summary += "/" + failure.Path.Tag
+		reason = failure.Path.Tag
+	}
+	if mapped := dropboxAPIMessageErrorCode(summary); mapped != "" {
+		code = mapped
+	}
+	details["api_summary"] = summary + "/"
+	return newCodedError(code, fmt.Errorf("thumbnail %s: %s", source, reason), details)
+}
+
+func thumbnailPathsErrorDetails(requests []thumbnailRequest) map[string]any {
+	if len(requests) != 1 {
+		return nil
+	}
+	return pathErrorDetails(requests[0].source)
+}
+
+// thumbnailCmd represents the thumbnail command
+var thumbnailCmd = &cobra.Command{
+	Use:   "thumbnail [flags] <path>...",
+	Short: "Download image thumbnails",
+	Long: `Download thumbnails of Dropbox images.
+  - Pass one or more image paths, or a single folder to thumbnail every image
+    directly inside it.
+  - Thumbnails are written to --out-dir as <name>.jpg, <name>.png, or
+    <name>.webp. Use --out-dir - to write a single thumbnail to stdout.
+  - Files Dropbox cannot thumbnail are reported as warnings; the command fails
+    only when no thumbnail could be downloaded.
+`,
+	Example: `  dbxcli thumbnail /Photos/cover.jpg
+  dbxcli thumbnail /Photos/Catalogue --size w256h256 --format webp -o ./thumbs
+  dbxcli thumbnail /Photos/cover.jpg --mode bestfit -o - > cover.jpg`,
+	RunE: thumbnail,
+}
+
+func init() {
+	RootCmd.AddCommand(thumbnailCmd)
+	thumbnailCmd.Flags().String("size", files.ThumbnailSizeW64h64, "Thumbnail size: "+strings.Join(thumbnailSizes, ", "))
+	thumbnailCmd.Flags().String("format", files.ThumbnailFormatJpeg, "Image format: "+strings.Join(thumbnailFormats, ", "))
+	thumbnailCmd.Flags().String("mode", files.ThumbnailModeStrict, "Resize mode: "+strings.Join(thumbnailModes, ", "))
+	thumbnailCmd.Flags().StringP("out-dir", "o", ".", "Local folder for thumbnails, or - for stdout")
+	enableStructuredOutput(thumbnailCmd)
+}
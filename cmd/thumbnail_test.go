@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestThumbnailJSONWritesFilesAndWarnsOnFailures(t *testing.T) {
+	outDir := filepath.Join(t.TempDir(), "thumbs")
+	cmd, stdout := testThumbnailCmd(map[string]string{
+		outputFlag: "json",
+		"size":     "w256h256",
+		"format":   "png",
+		"mode":     "bestfit",
+		"out-dir":  outDir,
+	})
+	var got *files.GetThumbnailBatchArg
+	stubFilesClient(t, &mockFilesClient{
+		getThumbnailBatchFn: func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			got = arg
+			return files.NewGetThumbnailBatchResult([]*files.GetThumbnailBatchResultEntry{
+				thumbnailBatchSuccess(arg.Entries[0].Path, "cover"),
+				thumbnailBatchFailure(files.ThumbnailErrorUnsupportedExtension),
+			}), nil
+		},
+	})
+
+	if err := thumbnail(cmd, []string{"Photos/cover.jpg", "/Photos/notes.txt"}); err != nil {
+		t.Fatalf("thumbnail error: %v", err)
+	}
+	if got == nil || len(got.Entries) != 2 || got.Entries[0].Path != "/Photos/cover.jpg" {
+		t.Fatalf("batch arg = %#v", got)
+	}
+	if arg := got.Entries[0]; arg.Size.Tag != "w256h256" || arg.Format.Tag != "png" || arg.Mode.Tag != "bestfit" {
+		t.Fatalf("thumbnail arg = size %s, format %s, mode %s", arg.Size.Tag, arg.Format.Tag, arg.Mode.Tag)
+	}
+	target := filepath.Join(outDir, "cover.png")
+	if content, err := os.ReadFile(target); err != nil || string(content) != "cover" {
+		t.Fatalf("thumbnail file = %q, %v; want cover", content, err)
+	}
+
+	var output struct {
+		Input    thumbnailInput `json:"input"`
+		Results  []getResult    `json:"results"`
+		Warnings []jsonWarning  `json:"warnings"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if strings.Join(output.Input.Paths, ",") != "/Photos/cover.jpg,/Photos/notes.txt" || output.Input.OutDir != outDir {
+		t.Fatalf("input = %#v", output.Input)
+	}
+	if len(output.Results) != 1 || output.Results[0].Kind != thumbnailKind || output.Results[0].Input.Target != target {
+		t.Fatalf("results = %#v, want one thumbnail", output.Results)
+	}
+	if len(output.Warnings) != 1 || output.Warnings[0].Code != jsonWarningCodeThumbnailFailed || output.Warnings[0].Path != "/Photos/notes.txt" {
+		t.Fatalf("warnings = %#v, want thumbnail_failed for notes.txt", output.Warnings)
+	}
+}
+
+func TestThumbnailSendsBatchesOf25(t *testing.T) {
+	cmd, _ := testThumbnailCmd(map[string]string{"out-dir": t.TempDir()})
+	var sizes []int
+	stubFilesClient(t, &mockFilesClient{
+		getThumbnailBatchFn: func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			sizes = append(sizes, len(arg.Entries))
+			entries := make([]*files.GetThumbnailBatchResultEntry, 0, len(arg.Entries))
+			for _, entry := range arg.Entries {
+				entries = append(entries, thumbnailBatchSuccess(entry.Path, "x"))
+			}
+			return files.NewGetThumbnailBatchResult(entries), nil
+		},
+	})
+
+	args := make([]string, 0, 30)
+	for i := range 30 {
+		args = append(args, fmt.Sprintf("/Photos/%02d.jpg", i))
+	}
+	if err := thumbnail(cmd, args); err != nil {
+		t.Fatalf("thumbnail error: %v", err)
+	}
+	if fmt.Sprint(sizes) != "[25 5]" {
+		t.Fatalf("batch sizes = %v, want [25 5]", sizes)
+	}
+}
+
+func TestThumbnailFolderExpandsToImages(t *testing.T) {
+	cmd, _ := testThumbnailCmd(map[string]string{"out-dir": t.TempDir()})
+	var requested []string
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return getTestFolderMetadata(arg.Path), nil
+		},
+		listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				thumbnailTestFile("/Photos/a.JPG"),
+				thumbnailTestFile("/Photos/readme.md"),
+				getTestFolderMetadata("/Photos/Raw"),
+			}, Cursor: "cursor", HasMore: true}, nil
+		},
+		listFolderContinueFn: func(arg *files.ListFolderContinueArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{thumbnailTestFile("/Photos/b.webp")}}, nil
+		},
+		getThumbnailBatchFn: func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			entries := make([]*files.GetThumbnailBatchResultEntry, 0, len(arg.Entries))
+			for _, entry := range arg.Entries {
+				requested = append(requested, entry.Path)
+				entries = append(entries, thumbnailBatchSuccess(entry.Path, "x"))
+			}
+			return files.NewGetThumbnailBatchResult(entries), nil
+		},
+	})
+
+	if err := thumbnail(cmd, []string{"/Photos"}); err != nil {
+		t.Fatalf("thumbnail error: %v", err)
+	}
+	if strings.Join(requested, ",") != "/Photos/a.JPG,/Photos/b.webp" {
+		t.Fatalf("requested = %v, want the two images", requested)
+	}
+}
+
+func TestThumbnailStdoutWritesSingleImage(t *testing.T) {
+	cmd, stdout := testThumbnailCmd(map[string]string{"out-dir": "-"})
+	stubFilesClient(t, &mockFilesClient{
+		getMetadataFn: func(arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			return thumbnailTestFile(arg.Path), nil
+		},
+		getThumbnailBatchFn: func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			return files.NewGetThumbnailBatchResult([]*files.GetThumbnailBatchResultEntry{thumbnailBatchSuccess(arg.Entries[0].Path, "jpeg-bytes")}), nil
+		},
+	})
+
+	if err := thumbnail(cmd, []string{"/Photos/cover.jpg"}); err != nil {
+		t.Fatalf("thumbnail error: %v", err)
+	}
+	if stdout.String() != "jpeg-bytes" {
+		t.Fatalf("stdout = %q, want thumbnail bytes", stdout.String())
+	}
+}
+
+func TestThumbnailAllFailuresReturnError(t *testing.T) {
+	cmd, _ := testThumbnailCmd(map[string]string{"out-dir": t.TempDir()})
+	stubFilesClient(t, &mockFilesClient{
+		getThumbnailBatchFn: func(arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			failure := thumbnailBatchFailure(files.ThumbnailErrorPath)
+			failure.Failure.Path = &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFound}}
+			return files.NewGetThumbnailBatchResult([]*files.GetThumbnailBatchResultEntry{failure, failure}), nil
+		},
+	})
+
+	err := thumbnail(cmd, []string{"/Photos/a.jpg", "/Photos/b.jpg"})
+	if code := jsonErrorCode(err); code != jsonErrorCodeNotFound {
+		t.Fatalf("code = %q, want %q (err %v)", code, jsonErrorCodeNotFound, err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestThumbnailValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+		want  map[string]any
+	}{
+		{name: "no paths", args: nil, want: map[string]any{"argument": "path"}},
+		{name: "bad size", args: []string{"/a.jpg"}, flags: map[string]string{"size": "w100h100"}, want: map[string]any{"flag": "size", "value": "w100h100"}},
+		{name: "bad format", args: []string{"/a.jpg"}, flags: map[string]string{"format": "gif"}, want: map[string]any{"flag": "format", "value": "gif"}},
+		{name: "stdout many", args: []string{"/a.jpg", "/b.jpg"}, flags: map[string]string{"out-dir": "-"}, want: map[string]any{"flag": "out-dir", "value": "-"}},
+		{name: "duplicate targets", args: []string{"/a/cover.jpg", "/b/cover.png"}, flags: map[string]string{"out-dir": "thumbs"}, want: map[string]any{"path": filepath.Join("thumbs", "cover.jpg")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := testThumbnailCmd(tt.flags)
+			stubFilesClient(t, &mockFilesClient{})
+
+			err := thumbnail(cmd, tt.args)
+			if err == nil {
+				t.Fatal("thumbnail succeeded, want error")
+			}
+			details := jsonErrorDetails(err)
+			for key, value := range tt.want {
+				if details[key] != value {
+					t.Fatalf("details = %#v, want %s=%v", details, key, value)
+				}
+			}
+		})
+	}
+}
+
+func testThumbnailCmd(flags map[string]string) (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "thumbnail"}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().String("size", files.ThumbnailSizeW64h64, "")
+	cmd.Flags().String("format", files.ThumbnailFormatJpeg, "")
+	cmd.Flags().String("mode", files.ThumbnailModeStrict, "")
+	cmd.Flags().StringP("out-dir", "o", ".", "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			panic(err)
+		}
+	}
+	return cmd, &stdout
+}
+
+func thumbnailTestFile(path string) *files.FileMetadata {
+	meta := getTestFileMetadata(path, 4)
+	meta.Name = path[strings.LastIndex(path, "/")+1:]
+	return meta
+}
+
+func thumbnailBatchSuccess(path, data string) *files.GetThumbnailBatchResultEntry {
+	return &files.GetThumbnailBatchResultEntry{
+		Tagged:  dropbox.Tagged{Tag: files.GetThumbnailBatchResultEntrySuccess},
+		Success: files.NewGetThumbnailBatchResultData(thumbnailTestFile(path), base64.StdEncoding.EncodeToString([]byte(data))),
+	}
+}
+
+func thumbnailBatchFailure(tag string) *files.GetThumbnailBatchResultEntry {
+	return &files.GetThumbnailBatchResultEntry{
+		Tagged:  dropbox.Tagged{Tag: files.GetThumbnailBatchResultEntryFailure},
+		Failure: &files.ThumbnailError{Tagged: dropbox.Tagged{Tag: tag}},
+	}
+}
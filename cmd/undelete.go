@@ -0,0 +1,388 @@
+// Copyright © 2026 Dropbox, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	pathpkg "path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dbxcli/v3/internal/output"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+const defaultUndeleteWorkers = 4
+
+type undeleteOptions struct {
+	since   *time.Time
+	match   string
+	workers int
+	dryRun  bool
+	verbose bool
+}
+
+type undeleteInput struct {
+	Path    string `json:"path"`
+	Since   string `json:"since,omitempty"`
+	Match   string `json:"match,omitempty"`
+	Workers int    `json:"workers"`
+	DryRun  bool   `json:"dry_run,omitempty"`
+}
+
+// undeleteTarget is a deleted file together with the revision that was live
+// immediately before it was deleted.
+type undeleteTarget struct {
+	path          string
+	revision      string
+	serverDeleted *time.Time
+}
+
+func undelete(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return invalidArgumentsErrorWithDetails("`undelete` requires a `folder` argument", argumentErrorDetails("folder"))
+	}
+
+	path, err := validatePath(args[0])
+	if err != nil {
+		return err
+	}
+
+	opts, err := parseUndeleteOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	dbx := filesNewFunc(config)
+	targets, err := findUndeleteTargets(dbx, path, opts)
+	if err != nil {
+		return withJSONErrorDetails(err, undeleteErrorDetails(path))
+	}
+
+	var results []restoreResult
+	var warnings []jsonWarning
+	if opts.dryRun {
+		results = make([]restoreResult, 0, len(targets))
+		for _, target := range targets {
+			results = append(results, newPlannedRestoreResult(target.path, target.revision, restoreOptions{dryRun: true}))
+		}
+	} else {
+		results, warnings, err = restoreUndeleteTargets(dbx, targets, opts)
+		if err != nil {
+			return err
+		}
+		if commandOutputFormat(cmd) == output.FormatText {
+			for _, warning := range warnings {
+				commandOutput(cmd).Warn("%s", warning.Message)
+			}
+		}
+		commandVerboseStatus(cmd, "Restored %d of %d deleted files under %s", len(results), len(targets), undeleteDisplayPath(path))
+	}
+
+	operationResults := make([]jsonOperationResult, 0, len(results))
+	for _, result := range results {
+		operationResults = append(operationResults, restoreOperationResult(result))
+	}
+
+	return renderOperation(cmd, newUndeleteInput(path, opts), operationResults, warnings, func(w io.Writer) error {
+		return renderUndeleteResults(w, results, opts)
+	})
+}
+
+func parseUndeleteOptions(cmd *cobra.Command) (undeleteOptions, error) {
+	var opts undeleteOptions
+
+	if cmd.Flags().Changed("since") {
+		value, err := cmd.Flags().GetString("since")
+		if err != nil {
+			return opts, err
+		}
+		since, err := parseRelativeTime(value, time.Now())
+		if err != nil {
+			return opts, invalidArgumentsErrorfWithDetails("invalid --since %q: use an RFC3339 timestamp or a duration such as 24h or 7d", flagValueErrorDetails("since", value), value)
+		}
+		opts.since = &since
+	}
+
+	match, err := cmd.Flags().GetString("match")
+	if err != nil {
+		return opts, err
+	}
+	if match != "" {
+		if _, err := pathpkg.Match(match, ""); err != nil {
+			return opts, invalidArgumentsErrorfWithDetails("invalid --match %q: %v", flagValueErrorDetails("match", match), match, err)
+		}
+	}
+	opts.match = match
+
+	workers, err := cmd.Flags().GetInt("workers")
+	if err != nil {
+		return opts, err
+	}
+	if workers < 1 {
+		return opts, invalidArgumentsErrorWithDetails("`--workers` must be at least 1", flagValueErrorDetails("workers", strconv.Itoa(workers)))
+	}
+	opts.workers = workers
+
+	dryRun, err := dryRunEnabled(cmd)
+	if err != nil {
+		return opts, err
+	}
+	opts.dryRun = dryRun
+	opts.verbose, _ = cmd.Flags().GetBool("verbose")
+
+	return opts, nil
+}
+
+func newUndeleteInput(path string, opts undeleteOptions) undeleteInput {
+	input := undeleteInput{
+		Path:    undeleteDisplayPath(path),
+		Match:   opts.match,
+		Workers: opts.workers,
+		DryRun:  opts.dryRun,
+	}
+	if opts.since != nil {
+		input.Since = opts.since.UTC().Format(time.RFC3339)
+	}
+	return input
+}
+
+func undeleteDisplayPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+func undeleteErrorDetails(path string) map[string]any {
+	return mergeJSONErrorDetails(operationErrorDetails("undelete"), pathErrorDetails(undeleteDisplayPath(path)))
+}
+
+// findUndeleteTargets lists path recursively, including deleted entries, and
+// resolves the last live revision of every deleted file that passes the
+// --match and --since filters. Deleted folders are skipped; files inside them
+// are listed individually and restoring them recreates their parents.
+func findUndeleteTargets(dbx filesClient, path string, opts undeleteOptions) ([]undeleteTarget, error) {
+	arg := files.NewListFolderArg(path)
+	arg.Recursive = true
+	arg.IncludeDeleted = true
+
+	res, err := dbx.ListFolderContext(currentContext(), arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []*files.DeletedMetadata
+	for {
+		for _, entry := range res.Entries {
+			item, ok := entry.(*files.DeletedMetadata)
+			if !ok || !undeleteMatches(path, item, opts.match) {
+				continue
+			}
+			deleted = append(deleted, item)
+		}
+		if !res.HasMore {
+			break
+		}
+		res, err = dbx.ListFolderContinueContext(currentContext(), files.NewListFolderContinueArg(res.Cursor))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	found := make([]undeleteTarget, len(deleted))
+	live := make([]bool, len(deleted))
+	err = forEachWorker(len(deleted), opts.workers, func(i int) error {
+		target, ok, err := lastLiveRevision(dbx, deleted[i])
+		if err != nil {
+			return withJSONErrorDetails(err, pathErrorDetails(deleted[i].PathDisplay))
+		}
+		found[i], live[i] = target, ok
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []undeleteTarget
+	for i, target := range found {
+		if !live[i] {
+			continue
+		}
+		if opts.since != nil && (target.serverDeleted == nil || target.serverDeleted.Before(*opts.since)) {
+			continue
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// undeleteMatches reports whether a deleted entry matches the --match glob.
+// Patterns without a slash match the entry name; patterns with a slash match
+// the path relative to the undelete folder. Matching is case-insensitive.
+func undeleteMatches(root string, item *files.DeletedMetadata, pattern string) bool {
+	if pattern == "" {
+		return true
+	}
+	pattern = strings.ToLower(pattern)
+	subject := strings.ToLower(item.Name)
+	if strings.Contains(pattern, "/") {
+		pathLower := item.PathLower
+		if pathLower == "" {
+			pathLower = strings.ToLower(item.PathDisplay)
+		}
+		subject = strings.TrimPrefix(strings.TrimPrefix(pathLower, strings.ToLower(root)), "/")
+		pattern = strings.TrimPrefix(pattern, "/")
+	}
+	matched, _ := pathpkg.Match(pattern, subject)
+	return matched
+}
+
+func lastLiveRevision(dbx filesClient, item *files.DeletedMetadata) (undeleteTarget, bool, error) {
+	path := item.PathDisplay
+	if path == "" {
+		path = item.PathLower
+	}
+
+	arg := files.NewListRevisionsArg(item.PathLower)
+	arg.Mode = &files.ListRevisionsMode{Tagged: dropbox.Tagged{Tag: files.ListRevisionsModePath}}
+	arg.Limit = 1
+
+	var res *files.ListRevisionsResult
+	err := retryWithBackoff(func() error {
+		var e error
+		res, e = dbx.ListRevisionsContext(currentContext(), arg)
+		return e
+	})
+	if err != nil {
+		if isListRevisionsNotFileError(err) {
+			return undeleteTarget{}, false, nil
+		}
+		return undeleteTarget{}, false, err
+	}
+	if !res.IsDeleted || len(res.Entries) == 0 || res.Entries[0] == nil {
+		return undeleteTarget{}, false, nil
+	}
+
+	target := undeleteTarget{
+		path:     path,
+		revision: res.Entries[0].Rev,
+	}
+	if res.ServerDeleted != nil {
+		deletedAt := time.Time(*res.ServerDeleted)
+		target.serverDeleted = &deletedAt
+	}
+	return target, true, nil
+}
+
+// restoreUndeleteTargets restores targets with at most opts.workers requests
+// in flight. Results keep the listing order. Files are restored
+// independently: each failure becomes a warning, and the command fails only
+// when every restore failed.
+func restoreUndeleteTargets(dbx filesClient, targets []undeleteTarget, opts undeleteOptions) ([]restoreResult, []jsonWarning, error) {
+	outcomes := make([]restoreResult, len(targets))
+	errs := make([]error, len(targets))
+	// Targets fail independently, so fn never stops the other workers.
+	_ = forEachWorker(len(targets), opts.workers, func(i int) error {
+		outcomes[i], errs[i] = restoreUndeleteTarget(dbx, targets[i])
+		return nil
+	})
+
+	results := make([]restoreResult, 0, len(targets))
+	var warnings []jsonWarning
+	var failures []error
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, err)
+			warnings = append(warnings, jsonWarning{Code: jsonWarningCodeRestoreFailed, Message: fmt.Sprintf("restore %s: %v", targets[i].path, err), Path: targets[i].path})
+			continue
+		}
+		results = append(results, outcomes[i])
+	}
+	if len(targets) > 0 && len(failures) == len(targets) {
+		return nil, nil, batchFailuresError("undelete", failures)
+	}
+	return results, warnings, nil
+}
+
+func restoreUndeleteTarget(dbx filesClient, target undeleteTarget) (restoreResult, error) {
+	arg := files.NewRestoreArg(target.path, target.revision)
+
+	var metadata *files.FileMetadata
+	err := retryWithBackoff(func() error {
+		var e error
+		metadata, e = dbx.RestoreContext(currentContext(), arg)
+		return e
+	})
+	if err != nil {
+		return restoreResult{}, withJSONErrorDetails(err, mergeJSONErrorDetails(operationErrorDetails("undelete"), pathErrorDetails(target.path), revisionErrorDetails(target.revision)))
+	}
+
+	result, err := newRestoreResult(target.path, target.revision, restoreOptions{}, metadata)
+	if err != nil {
+		return restoreResult{}, withJSONErrorDetails(err, mergeJSONErrorDetails(operationErrorDetails("undelete"), pathErrorDetails(target.path)))
+	}
+	return result, nil
+}
+
+func renderUndeleteResults(w io.Writer, results []restoreResult, opts undeleteOptions) error {
+	for _, result := range results {
+		var err error
+		switch {
+		case opts.dryRun:
+			err = renderPlannedRestoreResult(w, result)
+		case opts.verbose:
+			err = renderRestoreResult(w, result)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var undeleteCmd = &cobra.Command{
+	Use:   "undelete [flags] <folder>",
+	Short: "Restore deleted files under a folder",
+	Long: `Restore every deleted file found under a Dropbox folder.
+
+dbxcli lists <folder> recursively, including deleted entries, and restores each
+deleted file to the last revision it had before deletion. Deleted folders are
+recreated as needed when files inside them are restored.
+
+Use --since to restore only files deleted at or after a time, and --match to
+restore only files whose name matches a glob pattern. Patterns containing a
+slash match the path relative to <folder>.
+
+Files that cannot be restored are reported as warnings while the rest are
+restored; the command fails only when no file could be restored.`,
+	Example: `  dbxcli undelete /Reports
+  dbxcli undelete /Reports --since 7d --match '*.pdf'
+  dbxcli undelete /Reports --since 2026-06-01T00:00:00Z --dry-run`,
+	RunE: undelete,
+}
+
+func init() {
+	RootCmd.AddCommand(undeleteCmd)
+	enableStructuredOutput(undeleteCmd)
+	addDryRunFlag(undeleteCmd)
+	undeleteCmd.Flags().String("since", "", "Only restore files deleted at or after this RFC3339 time or duration ago (for example 24h or 7d)")
+	undeleteCmd.Flags().String("match", "", "Only restore files whose name matches this glob pattern")
+	undeleteCmd.Flags().IntP("workers", "w", defaultUndeleteWorkers, "Number of concurrent restore requests")
+}
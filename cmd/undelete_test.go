@@ -0,0 +1,477 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	pathpkg "path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/cobra"
+)
+
+func TestUndeleteArgValidation(t *testing.T) {
+	cmd, _ := testUndeleteCmd()
+	err := undelete(cmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "folder") {
+		t.Fatalf("err = %v, want folder argument error", err)
+	}
+	if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+		t.Fatalf("code = %q, want %q", code, jsonErrorCodeInvalidArguments)
+	}
+}
+
+func TestUndeleteRestoresDeletedFilesWithLastLiveRevision(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	mock := newUndeleteMock(t)
+	var listArg *files.ListFolderArg
+	mock.listFolderFn = func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+		listArg = arg
+		return undeleteListing(), nil
+	}
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	if listArg == nil || !listArg.Recursive || !listArg.IncludeDeleted || listArg.Path != "/Reports" {
+		t.Fatalf("list arg = %#v, want recursive include_deleted listing of /Reports", listArg)
+	}
+	if got := mock.restoredPaths(); strings.Join(got, ",") != "/Reports/a.pdf@rev-a,/Reports/old/b.txt@rev-b" {
+		t.Fatalf("restored = %v, want a.pdf and old/b.txt with last live revisions", got)
+	}
+	for _, arg := range mock.revisionArgs {
+		if arg.Mode == nil || arg.Mode.Tag != files.ListRevisionsModePath {
+			t.Fatalf("list revisions mode = %#v, want path", arg.Mode)
+		}
+	}
+	if got := stdout.String(); got != "" {
+		t.Fatalf("stdout = %q, want quiet success", got)
+	}
+}
+
+func TestUndeleteVerbosePrintsRestoredFiles(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set("verbose", "true"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, newUndeleteMock(t))
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	for _, want := range []string{
+		"Restored /Reports/a.pdf to revision rev-a",
+		"Restored /Reports/old/b.txt to revision rev-b",
+	} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+		}
+	}
+}
+
+func TestUndeleteMatchFiltersByNameAndRelativePath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "*.PDF", want: "/Reports/a.pdf@rev-a"},
+		{pattern: "old/*", want: "/Reports/old/b.txt@rev-b"},
+		{pattern: "*.doc", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			cmd, _ := testUndeleteCmd()
+			if err := cmd.Flags().Set("match", tt.pattern); err != nil {
+				t.Fatal(err)
+			}
+			mock := newUndeleteMock(t)
+			stubFilesClient(t, mock)
+
+			if err := undelete(cmd, []string{"/Reports"}); err != nil {
+				t.Fatalf("undelete error: %v", err)
+			}
+			if got := strings.Join(mock.restoredPaths(), ","); got != tt.want {
+				t.Fatalf("restored = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndeleteSinceSkipsOlderDeletions(t *testing.T) {
+	cmd, _ := testUndeleteCmd()
+	if err := cmd.Flags().Set("since", "2026-06-10T00:00:00Z"); err != nil {
+		t.Fatal(err)
+	}
+	mock := newUndeleteMock(t)
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	if got := strings.Join(mock.restoredPaths(), ","); got != "/Reports/a.pdf@rev-a" {
+		t.Fatalf("restored = %q, want only the recent deletion", got)
+	}
+}
+
+func TestUndeleteRejectsInvalidFlags(t *testing.T) {
+	tests := []struct {
+		flag  string
+		value string
+	}{
+		{flag: "since", value: "yesterday"},
+		{flag: "since", value: "-3d"},
+		{flag: "match", value: "[abc"},
+		{flag: "workers", value: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flag+"="+tt.value, func(t *testing.T) {
+			cmd, _ := testUndeleteCmd()
+			if err := cmd.Flags().Set(tt.flag, tt.value); err != nil {
+				t.Fatal(err)
+			}
+			stubFilesClient(t, &mockFilesClient{
+				listFolderFn: func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+					t.Fatal("ListFolder called with invalid flags")
+					return nil, nil
+				},
+			})
+
+			err := undelete(cmd, []string{"/Reports"})
+			if code := jsonErrorCode(err); code != jsonErrorCodeInvalidArguments {
+				t.Fatalf("err = %v code = %q, want invalid arguments", err, code)
+			}
+			if details := jsonErrorDetails(err); details["flag"] != tt.flag {
+				t.Fatalf("details = %#v, want flag %s", details, tt.flag)
+			}
+		})
+	}
+}
+
+func TestUndeleteDryRunTextOutputSnapshot(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set(dryRunFlagName, "true"); err != nil {
+		t.Fatal(err)
+	}
+	mock := newUndeleteMock(t)
+	mock.restoreFn = func(arg *files.RestoreArg) (*files.FileMetadata, error) {
+		t.Fatalf("Restore called during dry-run: %v", arg)
+		return nil, nil
+	}
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+
+	const want = "Would restore /Reports/a.pdf to revision rev-a\nWould restore /Reports/old/b.txt to revision rev-b\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestUndeleteJSONOutputsPerFileResults(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, newUndeleteMock(t))
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+
+	got := decodeUndeleteOutput(t, stdout)
+	if got.Input.Path != "/Reports" || got.Input.Workers != defaultUndeleteWorkers || got.Input.DryRun {
+		t.Fatalf("input = %#v, want path, default workers, no dry-run", got.Input)
+	}
+	if len(got.Results) != 2 {
+		t.Fatalf("results len = %d, want 2", len(got.Results))
+	}
+	result := got.Results[0]
+	if result.Status != restoreStatusRestored || result.Kind != restoreKindFile {
+		t.Fatalf("status/kind = %s/%s, want restored/file", result.Status, result.Kind)
+	}
+	if result.Input.Path != "/Reports/a.pdf" || result.Input.Revision != "rev-a" {
+		t.Fatalf("result input = %#v, want path and last live revision", result.Input)
+	}
+	if result.Result.Type != "file" || result.Result.PathDisplay != "/Reports/a.pdf" || result.Result.Rev != "restored-rev" {
+		t.Fatalf("metadata = %#v, want restored file metadata", result.Result)
+	}
+}
+
+func TestUndeleteJSONDryRunOutputsPlannedResults(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Flags().Set(dryRunFlagName, "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Flags().Set("match", "*.pdf"); err != nil {
+		t.Fatal(err)
+	}
+	stubFilesClient(t, newUndeleteMock(t))
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+
+	got := decodeUndeleteOutput(t, stdout)
+	if !got.Input.DryRun || got.Input.Match != "*.pdf" {
+		t.Fatalf("input = %#v, want dry_run and match", got.Input)
+	}
+	if len(got.Results) != 1 {
+		t.Fatalf("results len = %d, want 1", len(got.Results))
+	}
+	result := got.Results[0]
+	if result.Status != jsonStatusPlanned || !result.Input.DryRun || result.Result.Rev != "rev-a" {
+		t.Fatalf("result = %#v, want planned restore of rev-a", result)
+	}
+}
+
+func TestUndeleteSkipsDeletedFolders(t *testing.T) {
+	cmd, _ := testUndeleteCmd()
+	mock := newUndeleteMock(t)
+	mock.listRevisionsFn = func(arg *files.ListRevisionsArg) (*files.ListRevisionsResult, error) {
+		return nil, files.ListRevisionsAPIError{
+			EndpointError: &files.ListRevisionsError{
+				Path: &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFile}},
+			},
+		}
+	}
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	if got := mock.restoredPaths(); len(got) != 0 {
+		t.Fatalf("restored = %v, want none", got)
+	}
+}
+
+func TestUndeleteLimitsConcurrentRestores(t *testing.T) {
+	cmd, _ := testUndeleteCmd()
+	if err := cmd.Flags().Set("workers", "2"); err != nil {
+		t.Fatal(err)
+	}
+	var entries []files.IsMetadata
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		entries = append(entries, undeleteDeletedEntry("/Reports/"+name))
+	}
+	mock := newUndeleteMock(t)
+	mock.listFolderFn = func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+		return &files.ListFolderResult{Entries: entries}, nil
+	}
+	var inFlight, maxInFlight atomic.Int32
+	mock.restoreFn = func(arg *files.RestoreArg) (*files.FileMetadata, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if n <= seen || maxInFlight.CompareAndSwap(seen, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return &files.FileMetadata{Metadata: files.Metadata{PathDisplay: arg.Path}, Rev: "restored-rev"}, nil
+	}
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	if got := maxInFlight.Load(); got > 2 {
+		t.Fatalf("max concurrent restores = %d, want at most 2", got)
+	}
+}
+
+func TestUndeleteReportsRestoredFilesAndWarnsOnFailures(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set(outputFlag, "json"); err != nil {
+		t.Fatal(err)
+	}
+	mock := newUndeleteMock(t)
+	restore := mock.restoreFn
+	mock.restoreFn = func(arg *files.RestoreArg) (*files.FileMetadata, error) {
+		if arg.Path == "/Reports/a.pdf" {
+			return nil, errors.New("restore failed")
+		}
+		return restore(arg)
+	}
+	stubFilesClient(t, mock)
+
+	if err := undelete(cmd, []string{"/Reports"}); err != nil {
+		t.Fatalf("undelete error: %v", err)
+	}
+	if got := mock.restoredPaths(); strings.Join(got, ",") != "/Reports/old/b.txt@rev-b" {
+		t.Fatalf("restored = %v, want the file after the failure restored", got)
+	}
+	got := decodeUndeleteOutputWithWarnings(t, stdout)
+	if len(got.Results) != 1 || got.Results[0].Input.Path != "/Reports/old/b.txt" {
+		t.Fatalf("results = %#v, want the restored file", got.Results)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != jsonWarningCodeRestoreFailed || got.Warnings[0].Path != "/Reports/a.pdf" || !strings.Contains(got.Warnings[0].Message, "restore failed") {
+		t.Fatalf("warnings = %+v, want one restore_failed warning for a.pdf", got.Warnings)
+	}
+}
+
+func TestUndeleteFailsWhenEveryRestoreFails(t *testing.T) {
+	cmd, stdout := testUndeleteCmd()
+	if err := cmd.Flags().Set("match", "a.pdf"); err != nil {
+		t.Fatal(err)
+	}
+	mock := newUndeleteMock(t)
+	mock.restoreFn = func(arg *files.RestoreArg) (*files.FileMetadata, error) {
+		return nil, errors.New("restore failed")
+	}
+	stubFilesClient(t, mock)
+
+	err := undelete(cmd, []string{"/Reports"})
+	if err == nil {
+		t.Fatal("expected undelete error")
+	}
+	details := jsonErrorDetails(err)
+	if details["operation"] != "undelete" || details["path"] != "/Reports/a.pdf" || details["revision"] != "rev-a" {
+		t.Fatalf("details = %#v, want undelete operation, file path, and revision", details)
+	}
+	if got := stdout.String(); got != "" {
+		t.Fatalf("stdout = %q, want empty output on error", got)
+	}
+
+	cmd, _ = testUndeleteCmd()
+	if err := undelete(cmd, []string{"/Reports"}); err == nil || !strings.Contains(err.Error(), "2 operations failed") {
+		t.Fatalf("err = %v, want both failures reported", err)
+	}
+}
+
+func TestUndeleteCommandSupportsStructuredOutput(t *testing.T) {
+	if !commandSupportsStructuredOutput(undeleteCmd) {
+		t.Fatal("undelete command should support structured output")
+	}
+	if undeleteCmd.Flags().Lookup(dryRunFlagName) == nil {
+		t.Fatalf("undelete should define --%s", dryRunFlagName)
+	}
+}
+
+type undeleteMock struct {
+	*mockFilesClient
+	mu           sync.Mutex
+	restored     []string
+	revisionArgs []*files.ListRevisionsArg
+}
+
+// newUndeleteMock returns a client whose listing contains a live file and two
+// deleted files. old/b.txt was deleted on 2026-06-01; every other file was
+// deleted on 2026-06-15.
+func newUndeleteMock(t *testing.T) *undeleteMock {
+	t.Helper()
+
+	m := &undeleteMock{mockFilesClient: &mockFilesClient{}}
+	m.listFolderFn = func(arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+		return undeleteListing(), nil
+	}
+	m.listRevisionsFn = func(arg *files.ListRevisionsArg) (*files.ListRevisionsResult, error) {
+		m.mu.Lock()
+		m.revisionArgs = append(m.revisionArgs, arg)
+		m.mu.Unlock()
+
+		at := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+		if arg.Path == "/reports/old/b.txt" {
+			at = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		}
+		serverDeleted := dropbox.DBXTime(at)
+		name := arg.Path[strings.LastIndex(arg.Path, "/")+1:]
+		rev := "rev-" + strings.TrimSuffix(name, pathpkg.Ext(name))
+		return &files.ListRevisionsResult{
+			IsDeleted:     true,
+			ServerDeleted: &serverDeleted,
+			Entries:       []*files.FileMetadata{{Rev: rev}},
+		}, nil
+	}
+	m.restoreFn = func(arg *files.RestoreArg) (*files.FileMetadata, error) {
+		m.mu.Lock()
+		m.restored = append(m.restored, arg.Path+"@"+arg.Rev)
+		m.mu.Unlock()
+		return &files.FileMetadata{
+			Metadata: files.Metadata{PathDisplay: arg.Path, PathLower: strings.ToLower(arg.Path)},
+			Rev:      "restored-rev",
+		}, nil
+	}
+	return m
+}
+
+func (m *undeleteMock) restoredPaths() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	paths := append([]string{}, m.restored...)
+	sort.Strings(paths)
+	return paths
+}
+
+func undeleteListing() *files.ListFolderResult {
+	return &files.ListFolderResult{
+		Entries: []files.IsMetadata{
+			&files.FileMetadata{Metadata: files.Metadata{Name: "live.txt", PathDisplay: "/Reports/live.txt", PathLower: "/reports/live.txt"}},
+			undeleteDeletedEntry("/Reports/a.pdf"),
+			undeleteDeletedEntry("/Reports/old/b.txt"),
+		},
+	}
+}
+
+func undeleteDeletedEntry(path string) *files.DeletedMetadata {
+	return &files.DeletedMetadata{Metadata: files.Metadata{
+		Name:        path[strings.LastIndex(path, "/")+1:],
+		PathDisplay: path,
+		PathLower:   strings.ToLower(path),
+	}}
+}
+
+func testUndeleteCmd() (*cobra.Command, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{Use: "undelete"}
+	cmd.SetOut(&stdout)
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	cmd.Flags().String(outputFlag, "text", "")
+	cmd.Flags().String("since", "", "")
+	cmd.Flags().String("match", "", "")
+	cmd.Flags().Int("workers", defaultUndeleteWorkers, "")
+	addDryRunFlag(cmd)
+	return cmd, &stdout
+}
+
+type undeleteOutput struct {
+	Input    undeleteInput   `json:"input"`
+	Results  []restoreResult `json:"results"`
+	Warnings []jsonWarning   `json:"warnings"`
+}
+
+func decodeUndeleteOutput(t *testing.T, stdout *bytes.Buffer) undeleteOutput {
+	t.Helper()
+
+	got := decodeUndeleteOutputWithWarnings(t, stdout)
+	if len(got.Warnings) != 0 {
+		t.Fatalf("warnings = %+v, want empty array", got.Warnings)
+	}
+	return got
+}
+
+func decodeUndeleteOutputWithWarnings(t *testing.T, stdout *bytes.Buffer) undeleteOutput {
+	t.Helper()
+
+	var got undeleteOutput
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON output: %v\noutput: %s", err, stdout.String())
+	}
+	if got.Warnings == nil {
+		t.Fatalf("warnings = nil, want array")
+	}
+	return got
+}
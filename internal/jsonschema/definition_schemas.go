@@ -115,6 +115,7 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"runnable",
 			"schema_refs",
 			"scope_accuracy",
+			"sensitive_output",
 			"short",
 			"stdin_stdout",
 			"supports_structured_output",
@@ -136,6 +137,7 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"result_statuses":            stringArraySchema(),
 			"runnable":                   booleanSchema(),
 			"schema_refs":                schemaRef("command_schema_refs"),
+			"sensitive_output":           booleanSchema(),
 			"stdin_stdout":               schemaRef("command_stdin_stdout"),
 			"supports_structured_output": booleanSchema(),
 			"warning_codes":              stringArraySchema(),
@@ -162,18 +164,60 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"allocation": schemaRef("du_allocation"),
 		},
 	},
+	"file_lock": {
+		Required: []string{"is_lockholder", "locked", "metadata"},
+		Properties: map[string]any{
+			"metadata": schemaRef("metadata"),
+		},
+	},
 	"get_input": {
-		Required: []string{"recursive", "source", "stdout", "target"},
+		Required: []string{"recursive", "source", "stdout", "target", "zip"},
 	},
 	"get_result_input": {
 		Required: []string{"source", "target"},
 	},
+	"hash": {
+		Required: []string{"content_hash", "size"},
+	},
+	"hash_input": {
+		Required: []string{"check", "paths", "recursive"},
+	},
+	"hash_result_input": {
+		Required: []string{"path"},
+	},
 	"help_input": {
 		Required: []string{"help", "path"},
 	},
+	"lock_input": {
+		Required: []string{"path"},
+	},
 	"logout_result": {
 		Required: []string{"remote_token_revoked", "removed_saved_credentials"},
 	},
+	"file_request": {
+		Required: []string{"file_count", "is_open", "title"},
+		Properties: map[string]any{
+			"allow_late_uploads": stringEnum("one_day", "two_days", "seven_days", "thirty_days", "always"),
+		},
+	},
+	"file_request_create_input": {
+		Required: []string{"destination", "title"},
+		Properties: map[string]any{
+			"allow_late_uploads": stringEnum("one_day", "two_days", "seven_days", "thirty_days", "always"),
+		},
+	},
+	"file_request_delete_input": {
+		Required: []string{"all_closed", "ids"},
+	},
+	"file_request_input": {
+		Required: []string{"id"},
+	},
+	"file_request_update_input": {
+		Required: []string{"id"},
+		Properties: map[string]any{
+			"allow_late_uploads": stringEnum("one_day", "two_days", "seven_days", "thirty_days", "always"),
+		},
+	},
 	"ls_input": {
 		Required: []string{"include_deleted", "long", "only_deleted", "path", "recursive", "reverse"},
 		Properties: map[string]any{
@@ -184,7 +228,8 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 	"metadata": {
 		Required: []string{"type"},
 		Properties: map[string]any{
-			"type": stringEnum("deleted", "file", "folder"),
+			"property_groups": arraySchema(schemaRef("property_group")),
+			"type":            stringEnum("deleted", "file", "folder"),
 		},
 	},
 	"mkdir_input": {
@@ -206,8 +251,48 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"result": map[string]any{},
 		},
 	},
+	"path_tags": {
+		Required: []string{"path", "tags"},
+	},
+	"property_field": {
+		Required: []string{"name", "value"},
+	},
+	"property_field_template": {
+		Required: []string{"description", "name", "type"},
+	},
+	"property_group": {
+		Required: []string{"fields", "template_id"},
+		Properties: map[string]any{
+			"fields": arraySchema(schemaRef("property_field")),
+		},
+	},
+	"property_group_result": {
+		Required: []string{"fields", "path", "template_id"},
+		Properties: map[string]any{
+			"fields": arraySchema(schemaRef("property_field")),
+		},
+	},
+	"property_template": {
+		Required: []string{"description", "fields", "name", "owner", "template_id"},
+		Properties: map[string]any{
+			"fields": arraySchema(schemaRef("property_field_template")),
+			"owner":  stringEnum("team", "user"),
+		},
+	},
+	"preview_input": {
+		Required: []string{"source", "target"},
+	},
+	"props_get_input": {
+		Required: []string{"path", "templates"},
+	},
+	"props_input": {
+		Required: []string{"path", "template_id"},
+	},
+	"props_templates_input": {
+		Required: []string{"team"},
+	},
 	"put_input": {
-		Required: []string{"if_exists", "recursive", "source", "stdin", "target"},
+		Required: []string{"if_exists", "recursive", "source", "stdin", "target", "untar"},
 		Properties: map[string]any{
 			"if_exists": stringEnum("fail", "overwrite", "skip", "autorename"),
 		},
@@ -230,6 +315,23 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"time": stringEnum("client", "server"),
 		},
 	},
+	"save_url": {
+		Properties: map[string]any{
+			"metadata": schemaRef("metadata"),
+		},
+	},
+	"save_url_input": {
+		Required: []string{"if_exists", "path", "url", "wait"},
+		Properties: map[string]any{
+			"if_exists": stringEnum("autorename", "fail", "skip"),
+		},
+	},
+	"save_url_result_input": {
+		Required: []string{"path", "url"},
+	},
+	"save_url_status_input": {
+		Required: []string{"async_job_id"},
+	},
 	"search_input": {
 		Required: []string{"content", "long", "query", "reverse"},
 		Properties: map[string]any{
@@ -238,15 +340,81 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"time":     stringEnum("client", "server"),
 		},
 	},
+	"share_file_input": {
+		Required: []string{"file"},
+	},
+	"share_file_member_change": {
+		Required: []string{"file"},
+	},
+	"share_file_members_input": {
+		Required: []string{"files"},
+	},
+	"share_file_membership_input": {
+		Required: []string{"file", "members"},
+		Properties: map[string]any{
+			"access_level": stringEnum("editor", "viewer", "viewer_no_comment"),
+		},
+	},
 	"share_folder": {
 		Required: []string{"type"},
 		Properties: map[string]any{
 			"owner_display_names": stringArraySchema(),
+			"policy":              schemaRef("share_folder_policy"),
 			"type":                stringEnum("shared_folder"),
 		},
 	},
-	"share_link_create_input": {
+	"share_folder_create_input": {
 		Required: []string{"path"},
+		Properties: map[string]any{
+			"acl_update_policy":  stringEnum("editors", "owner"),
+			"member_policy":      stringEnum("anyone", "team"),
+			"shared_link_policy": stringEnum("anyone", "members", "team"),
+			"viewer_info_policy": stringEnum("disabled", "enabled"),
+		},
+	},
+	"share_folder_list_input": {
+		Required: []string{"mountable"},
+	},
+	"share_folder_member": {
+		Required: []string{"is_inherited", "same_team", "type"},
+		Properties: map[string]any{
+			"type": stringEnum("group", "invitee", "user"),
+		},
+	},
+	"share_folder_member_change": {
+		Required: []string{"shared_folder_id"},
+	},
+	"share_folder_member_input": {
+		Required: []string{"member"},
+	},
+	"share_folder_members_input": {
+		Required: []string{"folder", "shared_folder_id"},
+	},
+	"share_folder_mount_input": {
+		Required: []string{"folder", "shared_folder_id"},
+	},
+	"share_folder_membership_input": {
+		Required: []string{"folder", "members", "shared_folder_id"},
+		Properties: map[string]any{
+			"access_level": stringEnum("editor", "viewer", "viewer_no_comment"),
+		},
+	},
+	"share_folder_ref": {
+		Required: []string{"shared_folder_id"},
+	},
+	"share_folder_unshare_input": {
+		Required: []string{"folder", "leave_a_copy", "shared_folder_id"},
+	},
+	"share_folder_update_policy_input": {
+		Required: []string{"folder", "shared_folder_id"},
+		Properties: map[string]any{
+			"acl_update_policy":  stringEnum("editors", "owner"),
+			"member_policy":      stringEnum("anyone", "team"),
+			"shared_link_policy": stringEnum("anyone", "members", "team"),
+			"viewer_info_policy": stringEnum("disabled", "enabled"),
+		},
+	},
+	"share_link_create_input": {
 		Properties: map[string]any{
 			"access":   stringEnum("editor", "max", "viewer"),
 			"audience": stringEnum("members", "no-one", "public", "team"),
@@ -254,6 +422,9 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 	},
 	"share_link_download_input": {
 		Required: []string{"url"},
+		Properties: map[string]any{
+			"if_exists": stringEnum("fail", "overwrite", "skip"),
+		},
 	},
 	"share_link_download_result": {
 		Required: []string{"target"},
@@ -267,6 +438,13 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 	"share_link_list_input": {
 		Required: []string{"direct_only"},
 	},
+	"share_link_ls_input": {
+		Required: []string{"long", "recursive", "reverse", "url"},
+		Properties: map[string]any{
+			"sort": stringEnum("name", "size", "time", "type"),
+			"time": stringEnum("client", "server"),
+		},
+	},
 	"share_link_metadata": {
 		Required: []string{"type", "url"},
 		Properties: map[string]any{
@@ -285,6 +463,11 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"can_set_expiry",
 		},
 	},
+	"share_link_revoke_input": {
+		Properties: map[string]any{
+			"audience": stringEnum("members", "no_one", "public", "team"),
+		},
+	},
 	"share_link_revoke_result": {
 		Required: []string{"url"},
 		Properties: map[string]any{
@@ -297,6 +480,12 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 			"audience": stringEnum("members", "no-one", "public", "team"),
 		},
 	},
+	"tag_input": {
+		Required: []string{"path", "tag"},
+	},
+	"tag_result": {
+		Required: []string{"path", "tag"},
+	},
 	"team_group": {
 		Required: []string{"type"},
 		Properties: map[string]any{
@@ -335,6 +524,38 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 	"team_member_remove_input": {
 		Required: []string{"email"},
 	},
+	"team_share_link_audit": {
+		Required: []string{"type", "team_member_id", "email", "url", "password_protected", "allow_download"},
+		Properties: map[string]any{
+			"audience": stringEnum("members", "no_one", "password", "public", "team"),
+			"type":     stringEnum("file", "folder", "link"),
+		},
+	},
+	"temp_link": {
+		Properties: map[string]any{
+			"metadata": schemaRef("metadata"),
+		},
+	},
+	"temp_link_input": {
+		Required: []string{"path"},
+	},
+	"temp_link_upload_input": {
+		Required: []string{"duration", "if_exists", "path"},
+		Properties: map[string]any{
+			"if_exists": stringEnum("autorename", "fail", "overwrite", "skip"),
+		},
+	},
+	"thumbnail_input": {
+		Required: []string{"format", "mode", "out_dir", "paths", "size"},
+		Properties: map[string]any{
+			"format": stringEnum("jpeg", "png", "webp"),
+			"mode":   stringEnum("bestfit", "fitone_bestfit", "strict"),
+			"size":   stringEnum("w1024h768", "w128h128", "w2048h1536", "w256h256", "w32h32", "w480h320", "w640h480", "w64h64", "w960h640"),
+		},
+	},
+	"undelete_input": {
+		Required: []string{"path", "workers"},
+	},
 	"version": {
 		Required: []string{"sdk_version", "spec_version", "version"},
 	},
@@ -342,13 +563,13 @@ var commandDefinitionSchemas = map[string]definitionSchemaConfig{
 
 func defaultPropertySchema(field string) map[string]any {
 	switch field {
-	case "aliases", "auth_modes", "conflicts", "dropbox_scopes", "enum", "enum_values", "groups", "owner_display_names", "required", "result_kinds", "result_statuses", "warning_codes", "x-conflicts":
+	case "aliases", "auth_modes", "conflicts", "dropbox_scopes", "enum", "enum_values", "export_options", "files", "groups", "ids", "members", "owner_display_names", "paths", "removed_fields", "required", "result_kinds", "result_statuses", "tags", "templates", "violations", "warning_codes", "x-conflicts":
 		return stringArraySchema()
-	case "allocated", "limit", "member_count", "num_licensed_users", "num_provisioned_users", "size", "used", "user_within_team_space_allocated", "user_within_team_space_used_cached":
+	case "allocated", "file_count", "limit", "member_count", "num_licensed_users", "num_provisioned_users", "size", "used", "user_within_team_space_allocated", "user_within_team_space_used_cached", "workers":
 		return integerSchema()
-	case "additionalProperties", "allow_comments", "allow_download", "can_allow_download", "can_disallow_download", "can_remove_expiry", "can_remove_password", "can_revoke", "can_set_expiry", "can_set_password", "can_use_extended_sharing_controls", "content", "deleted", "direct_only", "disabled", "disallow_download", "dry_run", "email_verified", "force", "help", "include_deleted", "inherited", "is_directory_restricted", "is_inside_team_folder", "is_paired", "is_team_folder", "is_teammate", "long", "may_prompt", "only_deleted", "parents", "password", "permanent", "recursive", "refreshable", "remote_token_revoked", "remove_expiration", "remove_password", "removed_saved_credentials", "require_password", "reverse", "runnable", "sensitive", "stdin", "stdout", "stream_dash", "supports_structured_output", "variadic", "writeOnly", "writes_binary_stdout", "x-inherited", "x-may-prompt", "x-sensitive", "x-stream-dash":
+	case "additionalProperties", "all_closed", "allow_comments", "allow_download", "can_allow_download", "can_disallow_download", "can_remove_expiry", "can_remove_password", "can_revoke", "can_set_expiry", "can_set_password", "can_use_extended_sharing_controls", "check", "close", "closed", "content", "decrypt", "deleted", "direct_only", "disabled", "disallow_download", "dry_run", "email_verified", "encrypt", "expired", "force", "help", "include_deleted", "inherited", "is_directory_restricted", "is_inherited", "is_open", "is_inside_team_folder", "is_lockholder", "is_paired", "is_team_folder", "is_teammate", "keep_copy", "leave_a_copy", "locked", "long", "may_prompt", "mountable", "no_password", "only_deleted", "open", "parents", "password", "password_protected", "permanent", "quiet", "recursive", "refreshable", "remote_token_revoked", "remove_expiration", "remove_password", "removed_saved_credentials", "require_password", "remove_deadline", "reverse", "runnable", "same_team", "sensitive", "stdin", "stdout", "stream_dash", "supports_structured_output", "team", "untar", "variadic", "wait", "writeOnly", "writes_binary_stdout", "x-inherited", "x-may-prompt", "x-sensitive", "x-stream-dash", "zip":
 		return booleanSchema()
-	case "client_modified", "expires", "invited_on", "joined_on", "server_modified", "suspended_on", "time_invited":
+	case "client_modified", "created", "deadline", "expires", "if_unchanged_since", "invited_on", "joined_on", "server_modified", "since", "suspended_on", "time_invited":
 		return dateTimeStringSchema()
 	default:
 		return stringSchema()
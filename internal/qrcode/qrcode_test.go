@@ -0,0 +1,189 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomonMatchesHelloWorldExample(t *testing.T) {
+	// "HELLO WORLD" at version 1-M, from the ISO/IEC 18004 tutorial example.
+	data := []byte{0x20, 0x5B, 0x0B, 0x78, 0xD1, 0x72, 0xDC, 0x4D, 0x43, 0x40, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+	want := []byte{0xC4, 0x23, 0x27, 0x77, 0xEB, 0xD7, 0xE7, 0xE2, 0x5D, 0x17}
+	if got := reedSolomonRemainder(data, reedSolomonDivisor(len(want))); !bytes.Equal(got, want) {
+		t.Fatalf("ECC = % X, want % X", got, want)
+	}
+}
+
+func TestFormatAndVersionBitsMatchStandardTables(t *testing.T) {
+	for mask, want := range []int{
+		0b101010000010010, 0b101000100100101, 0b101111001111100, 0b101101101001011,
+		0b100010111111001, 0b100000011001110, 0b100111110010111, 0b100101010100000,
+	} {
+		if got := formatBits(mask); got != want {
+			t.Fatalf("formatBits(%d) = %015b, want %015b", mask, got, want)
+		}
+	}
+	for version, want := range map[int]int{7: 0x07C94, 10: 0x0A4D3, 40: 0x28C69} {
+		if got := versionBits(version); got != want {
+			t.Fatalf("versionBits(%d) = %05X, want %05X", version, got, want)
+		}
+	}
+}
+
+func TestDataCodewordCapacityMatchesStandard(t *testing.T) {
+	for version, want := range map[int]int{1: 16, 2: 28, 7: 124, 10: 216, 20: 669, 40: 2334} {
+		if got := numDataCodewords(version); got != want {
+			t.Fatalf("numDataCodewords(%d) = %d, want %d", version, got, want)
+		}
+	}
+}
+
+func TestAlignmentPatternPositionsMatchStandard(t *testing.T) {
+	for version, want := range map[int][]int{
+		1:  nil,
+		2:  {6, 18},
+		7:  {6, 22, 38},
+		22: {6, 26, 50, 74, 98},
+		32: {6, 34, 60, 86, 112, 138},
+		40: {6, 30, 58, 86, 114, 142, 170},
+	} {
+		got := alignmentPatternPositions(version)
+		if len(got) != len(want) {
+			t.Fatalf("alignmentPatternPositions(%d) = %v, want %v", version, got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("alignmentPatternPositions(%d) = %v, want %v", version, got, want)
+			}
+		}
+	}
+}
+
+func TestEncodePicksSmallestVersion(t *testing.T) {
+	for text, want := range map[string]int{
+		strings.Repeat("a", 14):   1,
+		strings.Repeat("a", 15):   2,
+		strings.Repeat("a", 213):  10,
+		strings.Repeat("a", 2331): 40,
+	} {
+		code, err := Encode(text)
+		if err != nil {
+			t.Fatalf("Encode(%d bytes) error: %v", len(text), err)
+		}
+		if code.Version != want || code.Size != want*4+17 {
+			t.Fatalf("Encode(%d bytes) version = %d size %d, want version %d", len(text), code.Version, code.Size, want)
+		}
+	}
+	if _, err := Encode(strings.Repeat("a", 2332)); !errors.Is(err, ErrTooLong) {
+		t.Fatalf("Encode(2332 bytes) error = %v, want ErrTooLong", err)
+	}
+}
+
+func TestEncodeRoundTripsCodewords(t *testing.T) {
+	text := "https://www.dropbox.com/scl/fi/abc123/report.pdf?rlkey=xyz789&dl=0"
+	code, err := Encode(text)
+	if err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+
+	// Read the format information back, unmask, and compare the codewords.
+	mask := -1
+	for candidate := range 8 {
+		bits := formatBits(candidate)
+		matches := true
+		for i := range 6 {
+			matches = matches && code.Dark(8, i) == bit(bits, i)
+		}
+		if matches {
+			mask = candidate
+		}
+	}
+	if mask < 0 {
+		t.Fatal("format information does not match any mask")
+	}
+	code.applyMask(mask)
+	want := addErrorCorrection(encodeData([]byte(text), code.Version), code.Version)
+	got := make([]byte, len(want))
+	i := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range code.Size {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = code.Size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if !code.isFunction[y][x] && i < len(got)*8 {
+					if code.Dark(x, y) {
+						got[i>>3] |= 1 << (7 - i&7)
+					}
+					i++
+				}
+			}
+		}
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("codewords = % X, want % X", got, want)
+	}
+}
+
+func TestWriteTerminalDrawsHalfBlocks(t *testing.T) {
+	code, err := Encode("https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := code.WriteTerminal(&out); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	width := code.Size + 2*TerminalQuietZone
+	if len(lines) != (width+1)/2 {
+		t.Fatalf("lines = %d, want %d", len(lines), (width+1)/2)
+	}
+	for _, line := range lines {
+		if n := len([]rune(line)); n != width {
+			t.Fatalf("line width = %d, want %d", n, width)
+		}
+	}
+	if lines[0] != strings.Repeat("█", width) {
+		t.Fatalf("first line = %q, want quiet zone", lines[0])
+	}
+}
+
+func TestWriteImages(t *testing.T) {
+	code, err := Encode("https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pngOut bytes.Buffer
+	if err := code.WritePNG(&pngOut, 4); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&pngOut)
+	if err != nil {
+		t.Fatalf("decode PNG: %v", err)
+	}
+	size := (code.Size + 2*ImageQuietZone) * 4
+	if bounds := img.Bounds(); bounds.Dx() != size || bounds.Dy() != size {
+		t.Fatalf("PNG bounds = %v, want %dx%d", bounds, size, size)
+	}
+	if r, _, _, _ := img.At(ImageQuietZone*4, ImageQuietZone*4).RGBA(); r != 0 {
+		t.Fatal("finder pattern corner is not dark")
+	}
+
+	var svgOut bytes.Buffer
+	if err := code.WriteSVG(&svgOut); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(svgOut.String(), `viewBox="0 0 33 33"`) || !strings.Contains(svgOut.String(), "M4,4h1v1h-1z") {
+		t.Fatalf("SVG output = %s", svgOut.String())
+	}
+}
@@ -0,0 +1,87 @@
+package qrcode
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+const (
+	// TerminalQuietZone is the light border drawn around terminal output.
+	// Scanners read a two-module border reliably on screen.
+	TerminalQuietZone = 2
+	// ImageQuietZone is the four-module border the standard requires.
+	ImageQuietZone = 4
+	// DefaultPNGScale is the PNG size of one module, in pixels.
+	DefaultPNGScale = 8
+)
+
+// WriteTerminal draws the symbol with Unicode half blocks, two module rows
+// per line. Block characters are light modules and spaces are dark ones, so
+// the code reads correctly on the light-on-dark colors of most terminals.
+func (c *Code) WriteTerminal(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	border := TerminalQuietZone
+	for y := -border; y < c.Size+border; y += 2 {
+		for x := -border; x < c.Size+border; x++ {
+			top, bottom := !c.Dark(x, y), !c.Dark(x, y+1)
+			if y+1 >= c.Size+border {
+				bottom = false
+			}
+			switch {
+			case top && bottom:
+				bw.WriteString("█")
+			case top:
+				bw.WriteString("▀")
+			case bottom:
+				bw.WriteString("▄")
+			default:
+				bw.WriteByte(' ')
+			}
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// WritePNG encodes the symbol as a black-and-white PNG with scale pixels per
+// module.
+func (c *Code) WritePNG(w io.Writer, scale int) error {
+	if scale < 1 {
+		return fmt.Errorf("invalid QR code scale %d", scale)
+	}
+	size := (c.Size + 2*ImageQuietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for py := range size {
+		for px := range size {
+			shade := color.Gray{Y: 0xFF}
+			if c.Dark(px/scale-ImageQuietZone, py/scale-ImageQuietZone) {
+				shade = color.Gray{Y: 0x00}
+			}
+			img.SetGray(px, py, shade)
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// WriteSVG writes the symbol as a scalable SVG image with one unit per module.
+func (c *Code) WriteSVG(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	size := c.Size + 2*ImageQuietZone
+	fmt.Fprintf(bw, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+"\n", size, size)
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="#FFFFFF"/>`+"\n")
+	bw.WriteString(`<path fill="#000000" d="`)
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.Dark(x, y) {
+				fmt.Fprintf(bw, "M%d,%dh1v1h-1z", x+ImageQuietZone, y+ImageQuietZone)
+			}
+		}
+	}
+	bw.WriteString("\"/>\n</svg>\n")
+	return bw.Flush()
+}
@@ -33,7 +33,7 @@ const destructiveLevelNone = "none"
 
 var stdinStdoutNotes = map[string]string{
 	"get":                 "Use `-` as the local target to write downloaded file bytes to stdout; diagnostics go to stderr.",
-	"put":                 "Use `-` as the local source to upload from stdin; stdin is spooled to a temporary file before upload.",
+	"put":                 "Use `-` as the local source to upload from stdin; stdin is streamed to Dropbox in chunks without a local temporary file.",
 	"share-link download": "Use `-` as the target for file shared links to write bytes to stdout; folder shared links require `--recursive` and cannot be written to stdout.",
 }
 